@@ -13,6 +13,11 @@ func (h *routeAdmin) handleAuthRoute(prefixPath string) {
 	api := h.Route.Group(prefixPath)
 
 	api.POST("/login", h.Login)
+	api.POST("/forgot-password", h.ForgotPassword)
+	api.POST("/reset-password", h.ResetPassword)
+	api.POST("/refresh-token", h.RefreshToken)
+	api.POST("/logout", h.Middleware.AuthAdmin(), h.Logout)
+	api.POST("/logout-all", h.Middleware.AuthAdmin(), h.LogoutAllDevices)
 	api.GET("/profile", h.Middleware.AuthAdmin(), h.GetProfile)
 }
 
@@ -36,7 +41,7 @@ func (h *routeAdmin) Login(c *gin.Context) {
 		return
 	}
 
-	response := h.Usecase.Login(ctx, payload)
+	response := h.Usecase.Login(ctx, payload, c.ClientIP())
 	c.JSON(response.Status, response)
 }
 
@@ -58,3 +63,113 @@ func (h *routeAdmin) GetProfile(c *gin.Context) {
 	response := h.Usecase.GetProfile(ctx, claim)
 	c.JSON(response.Status, response)
 }
+
+// ForgotPassword
+//
+// @Summary Forgot Password Admin
+// @Description Forgot Password Admin
+// @Tags Auth-Admin
+// @Accept json
+// @Produce json
+// @Param payload body request.ForgotPasswordRequest true "Forgot Password Admin"
+// @Success 200 {object} helpers.Response
+// @Router /admin/auth/forgot-password [post]
+func (h *routeAdmin) ForgotPassword(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	payload := request.ForgotPasswordRequest{}
+	err := c.ShouldBindJSON(&payload)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, helpers.NewResponse(http.StatusBadRequest, "Invalid json data", nil, nil))
+		return
+	}
+
+	response := h.Usecase.ForgotPassword(ctx, payload)
+	c.JSON(response.Status, response)
+}
+
+// ResetPassword
+//
+// @Summary Reset Password Admin
+// @Description Reset Password Admin
+// @Tags Auth-Admin
+// @Accept json
+// @Produce json
+// @Param payload body request.ResetPasswordRequest true "Reset Password Admin"
+// @Success 200 {object} helpers.Response
+// @Router /admin/auth/reset-password [post]
+func (h *routeAdmin) ResetPassword(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	payload := request.ResetPasswordRequest{}
+	err := c.ShouldBindJSON(&payload)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, helpers.NewResponse(http.StatusBadRequest, "Invalid json data", nil, nil))
+		return
+	}
+
+	response := h.Usecase.ResetPassword(ctx, payload)
+	c.JSON(response.Status, response)
+}
+
+// RefreshToken
+//
+// @Summary Refresh Token Admin
+// @Description Refresh Token Admin, the refresh token is rotated on every call
+// @Tags Auth-Admin
+// @Accept json
+// @Produce json
+// @Param payload body request.RefreshTokenRequest true "Refresh Token Admin"
+// @Success 200 {object} helpers.Response
+// @Router /admin/auth/refresh-token [post]
+func (h *routeAdmin) RefreshToken(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	payload := request.RefreshTokenRequest{}
+	err := c.ShouldBindJSON(&payload)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, helpers.NewResponse(http.StatusBadRequest, "Invalid json data", nil, nil))
+		return
+	}
+
+	response := h.Usecase.RefreshToken(ctx, payload)
+	c.JSON(response.Status, response)
+}
+
+// Logout
+//
+// @Summary Logout Admin
+// @Description Logout Admin from current device
+// @Tags Auth-Admin
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Success 200 {object} helpers.Response
+// @Router /admin/auth/logout [post]
+func (h *routeAdmin) Logout(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	claim := c.MustGet("user_data").(jwt_helpers.AdminJWTClaims)
+
+	response := h.Usecase.Logout(ctx, claim)
+	c.JSON(response.Status, response)
+}
+
+// LogoutAllDevices
+//
+// @Summary Logout All Devices Admin
+// @Description Logout Admin from all devices
+// @Tags Auth-Admin
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Success 200 {object} helpers.Response
+// @Router /admin/auth/logout-all [post]
+func (h *routeAdmin) LogoutAllDevices(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	claim := c.MustGet("user_data").(jwt_helpers.AdminJWTClaims)
+
+	response := h.Usecase.LogoutAllDevices(ctx, claim)
+	c.JSON(response.Status, response)
+}
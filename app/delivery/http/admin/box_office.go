@@ -0,0 +1,84 @@
+package admin_http
+
+import (
+	"app/domain/request"
+	"app/helpers"
+	jwt_helpers "app/helpers/jwt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+func (h *routeAdmin) handleBoxOfficeRoute(prefixPath string) {
+	api := h.Route.Group(prefixPath)
+
+	api.GET("/tickets", h.Middleware.AuthAdmin(), h.GetBoxOfficeTicketsList)
+	api.POST("/sales", h.Middleware.AuthAdmin(), h.CreateBoxOfficeSale)
+	api.GET("/cash-up", h.Middleware.AuthAdmin(), h.GetBoxOfficeCashUpReport)
+}
+
+// GetBoxOfficeTicketsList
+//
+// @Summary Get Box Office Tickets List
+// @Description Get today's tickets at the admin's venue that can be sold at the box office
+// @Tags BoxOffice-Admin
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Success 200 {object} helpers.Response
+// @Router /admin/box-office/tickets [get]
+func (h *routeAdmin) GetBoxOfficeTicketsList(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	claim := c.MustGet("user_data").(jwt_helpers.AdminJWTClaims)
+
+	response := h.Usecase.GetBoxOfficeTicketsList(ctx, claim)
+	c.JSON(response.Status, response)
+}
+
+// CreateBoxOfficeSale
+//
+// @Summary Create Box Office Sale
+// @Description Sell today's ticket at the admin's venue, ticket purchase codes are issued right away
+// @Tags BoxOffice-Admin
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param payload body request.BoxOfficeSaleRequest true "Create Box Office Sale"
+// @Success 200 {object} helpers.Response
+// @Router /admin/box-office/sales [post]
+func (h *routeAdmin) CreateBoxOfficeSale(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	claim := c.MustGet("user_data").(jwt_helpers.AdminJWTClaims)
+	payload := request.BoxOfficeSaleRequest{}
+	err := c.ShouldBindJSON(&payload)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, helpers.NewResponse(http.StatusBadRequest, "Invalid json data", nil, nil))
+		return
+	}
+
+	response := h.Usecase.CreateBoxOfficeSale(ctx, claim, payload)
+	c.JSON(response.Status, response)
+}
+
+// GetBoxOfficeCashUpReport
+//
+// @Summary Get Box Office Cash Up Report
+// @Description Get end-of-shift cash up report of the logged in admin
+// @Tags BoxOffice-Admin
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param date query string false "Shift date in format YYYY-MM-DD, default today"
+// @Success 200 {object} helpers.Response
+// @Router /admin/box-office/cash-up [get]
+func (h *routeAdmin) GetBoxOfficeCashUpReport(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	claim := c.MustGet("user_data").(jwt_helpers.AdminJWTClaims)
+	queryParam := c.Request.URL.Query()
+
+	response := h.Usecase.GetBoxOfficeCashUpReport(ctx, claim, queryParam)
+	c.JSON(response.Status, response)
+}
@@ -22,4 +22,6 @@ func NewAdminRouteHandler(usecase domain.AdminAppUsecase, ginEngine *gin.Engine,
 
 	handler.handleAuthRoute("/auth")
 	handler.handleTicketPurchaseRoute("/ticket-purchases")
+	handler.handleBoxOfficeRoute("/box-office")
+	handler.handleMatchRoute("/matches")
 }
@@ -0,0 +1,170 @@
+package admin_http
+
+import (
+	"app/domain/request"
+	"app/helpers"
+	jwt_helpers "app/helpers/jwt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+func (h *routeAdmin) handleMatchRoute(prefixPath string) {
+	api := h.Route.Group(prefixPath)
+
+	api.GET("", h.Middleware.AuthAdmin(), h.GetMatchesList)
+	api.PUT("/:id/status", h.Middleware.AuthAdmin(), h.UpdateMatchStatus)
+	api.GET("/:id/events", h.Middleware.AuthAdmin(), h.GetMatchEventsList)
+	api.POST("/:id/events", h.Middleware.AuthAdmin(), h.CreateMatchEvent)
+	api.PUT("/:id/events/:eventId", h.Middleware.AuthAdmin(), h.UpdateMatchEvent)
+	api.DELETE("/:id/events/:eventId", h.Middleware.AuthAdmin(), h.DeleteMatchEvent)
+}
+
+// GetMatchesList
+//
+// @Summary Get Matches List
+// @Description Get today's matches at the admin's venue
+// @Tags Match-Admin
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Success 200 {object} helpers.Response
+// @Router /admin/matches [get]
+func (h *routeAdmin) GetMatchesList(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	claim := c.MustGet("user_data").(jwt_helpers.AdminJWTClaims)
+
+	response := h.Usecase.GetMatchesList(ctx, claim)
+	c.JSON(response.Status, response)
+}
+
+// UpdateMatchStatus
+//
+// @Summary Update Match Status
+// @Description Start or finish a match at the admin's venue
+// @Tags Match-Admin
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param id path string true "Match ID"
+// @Param payload body request.MatchStatusUpdateRequest true "Update Match Status"
+// @Success 200 {object} helpers.Response
+// @Router /admin/matches/{id}/status [put]
+func (h *routeAdmin) UpdateMatchStatus(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	claim := c.MustGet("user_data").(jwt_helpers.AdminJWTClaims)
+	id := c.Param("id")
+	payload := request.MatchStatusUpdateRequest{}
+	err := c.ShouldBindJSON(&payload)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, helpers.NewResponse(http.StatusBadRequest, "Invalid json data", nil, nil))
+		return
+	}
+
+	response := h.Usecase.UpdateMatchStatus(ctx, claim, id, payload)
+	c.JSON(response.Status, response)
+}
+
+// GetMatchEventsList
+//
+// @Summary Get Match Events List
+// @Description Get events of a match at the admin's venue ordered by minute
+// @Tags Match-Admin
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param id path string true "Match ID"
+// @Success 200 {object} helpers.Response
+// @Router /admin/matches/{id}/events [get]
+func (h *routeAdmin) GetMatchEventsList(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	claim := c.MustGet("user_data").(jwt_helpers.AdminJWTClaims)
+	id := c.Param("id")
+
+	response := h.Usecase.GetMatchEventsList(ctx, claim, id)
+	c.JSON(response.Status, response)
+}
+
+// CreateMatchEvent
+//
+// @Summary Create Match Event
+// @Description Record a match event, the match score is derived from the events
+// @Tags Match-Admin
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param id path string true "Match ID"
+// @Param payload body request.MatchEventRequest true "Create Match Event"
+// @Success 201 {object} helpers.Response
+// @Router /admin/matches/{id}/events [post]
+func (h *routeAdmin) CreateMatchEvent(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	claim := c.MustGet("user_data").(jwt_helpers.AdminJWTClaims)
+	id := c.Param("id")
+	payload := request.MatchEventRequest{}
+	err := c.ShouldBindJSON(&payload)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, helpers.NewResponse(http.StatusBadRequest, "Invalid json data", nil, nil))
+		return
+	}
+
+	response := h.Usecase.CreateMatchEvent(ctx, claim, id, payload)
+	c.JSON(response.Status, response)
+}
+
+// UpdateMatchEvent
+//
+// @Summary Update Match Event
+// @Description Correct a match event, the match score is derived again
+// @Tags Match-Admin
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param id path string true "Match ID"
+// @Param eventId path string true "Match Event ID"
+// @Param payload body request.MatchEventRequest true "Update Match Event"
+// @Success 200 {object} helpers.Response
+// @Router /admin/matches/{id}/events/{eventId} [put]
+func (h *routeAdmin) UpdateMatchEvent(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	claim := c.MustGet("user_data").(jwt_helpers.AdminJWTClaims)
+	id := c.Param("id")
+	eventId := c.Param("eventId")
+	payload := request.MatchEventRequest{}
+	err := c.ShouldBindJSON(&payload)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, helpers.NewResponse(http.StatusBadRequest, "Invalid json data", nil, nil))
+		return
+	}
+
+	response := h.Usecase.UpdateMatchEvent(ctx, claim, id, eventId, payload)
+	c.JSON(response.Status, response)
+}
+
+// DeleteMatchEvent
+//
+// @Summary Delete Match Event
+// @Description Remove a wrongly recorded match event, the match score is derived again
+// @Tags Match-Admin
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param id path string true "Match ID"
+// @Param eventId path string true "Match Event ID"
+// @Success 200 {object} helpers.Response
+// @Router /admin/matches/{id}/events/{eventId} [delete]
+func (h *routeAdmin) DeleteMatchEvent(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	claim := c.MustGet("user_data").(jwt_helpers.AdminJWTClaims)
+	id := c.Param("id")
+	eventId := c.Param("eventId")
+
+	response := h.Usecase.DeleteMatchEvent(ctx, claim, id, eventId)
+	c.JSON(response.Status, response)
+}
@@ -3,6 +3,7 @@ package admin_http
 import (
 	"app/domain/request"
 	"app/helpers"
+	jwt_helpers "app/helpers/jwt"
 	"net/http"
 
 	"github.com/gin-gonic/gin"
@@ -28,7 +29,9 @@ func (h *routeAdmin) handleTicketPurchaseRoute(prefixPath string) {
 func (h *routeAdmin) GetTicketPurchasesListIsUsedToday(c *gin.Context) {
 	ctx := c.Request.Context()
 
-	response := h.Usecase.GetListTicketPurchasesIsUsedToday(ctx)
+	claim := c.MustGet("user_data").(jwt_helpers.AdminJWTClaims)
+
+	response := h.Usecase.GetListTicketPurchasesIsUsedToday(ctx, claim)
 	c.JSON(response.Status, response)
 }
 
@@ -53,6 +56,8 @@ func (h *routeAdmin) Scan(c *gin.Context) {
 		return
 	}
 
-	response := h.Usecase.ScanTicketPurchase(ctx, payload)
+	claim := c.MustGet("user_data").(jwt_helpers.AdminJWTClaims)
+
+	response := h.Usecase.ScanTicketPurchase(ctx, claim, payload)
 	c.JSON(response.Status, response)
 }
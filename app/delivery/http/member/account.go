@@ -0,0 +1,85 @@
+package member_http
+
+import (
+	"app/domain/request"
+	"app/helpers"
+	jwt_helpers "app/helpers/jwt"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+func (h *routeMember) handleAccountRoute(prefixPath string) {
+	api := h.Route.Group(prefixPath)
+
+	api.GET("/export", h.Middleware.AuthMember(), h.Middleware.NotImpersonated(), h.ExportAccountData)
+	api.DELETE("", h.Middleware.AuthMember(), h.DeleteAccount)
+}
+
+// ExportAccountData
+//
+// @Summary Export Account Data Member
+// @Description Export profile, purchases, ticket purchases and voting logs, use format=zip to download a zip archive
+// @Tags Account-Member
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Produce application/zip
+// @Param format query string false "json or zip"
+// @Success 200 {object} helpers.Response
+// @Router /member/account/export [get]
+func (h *routeMember) ExportAccountData(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	claim := c.MustGet("user_data").(jwt_helpers.MemberJWTClaims)
+
+	response := h.Usecase.ExportAccountData(ctx, claim)
+	if response.Status != http.StatusOK || c.Query("format") != "zip" {
+		c.JSON(response.Status, response)
+		return
+	}
+
+	// one json file per section
+	files := make(map[string]interface{})
+	for name, data := range response.Data.(map[string]interface{}) {
+		files[name+".json"] = data
+	}
+	archive, err := helpers.ZipJSONFiles(files)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil))
+		return
+	}
+
+	filename := fmt.Sprintf("account-data-%s.zip", time.Now().Format("20060102150405"))
+	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%q", filename))
+	c.Data(http.StatusOK, "application/zip", archive)
+}
+
+// DeleteAccount
+//
+// @Summary Delete Account Member
+// @Description Delete and anonymize the member account, purchase records are kept without personal data
+// @Tags Account-Member
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param payload body request.DeleteAccountRequest true "Delete Account Member"
+// @Success 200 {object} helpers.Response
+// @Router /member/account [delete]
+func (h *routeMember) DeleteAccount(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	claim := c.MustGet("user_data").(jwt_helpers.MemberJWTClaims)
+
+	payload := request.DeleteAccountRequest{}
+	err := c.ShouldBindJSON(&payload)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, helpers.NewResponse(http.StatusBadRequest, "Invalid json data", nil, nil))
+		return
+	}
+
+	response := h.Usecase.DeleteAccount(ctx, claim, payload)
+	c.JSON(response.Status, response)
+}
@@ -16,7 +16,17 @@ func (h *routeMember) handleAuthRoute(prefixPath string) {
 	api.POST("/verify-email", h.VerifyEmail)
 	api.POST("/resend-email-verification", h.ResendEmailVerification)
 	api.POST("/login", h.Login)
+	api.POST("/forgot-password", h.ForgotPassword)
+	api.POST("/reset-password", h.ResetPassword)
+	api.POST("/refresh-token", h.RefreshToken)
+	api.POST("/logout", h.Middleware.AuthMember(), h.Logout)
+	api.POST("/logout-all", h.Middleware.AuthMember(), h.LogoutAllDevices)
 	api.GET("/profile", h.Middleware.AuthMember(), h.GetProfile)
+	api.PUT("/profile", h.Middleware.AuthMember(), h.UpdateProfile)
+	api.PUT("/change-password", h.Middleware.AuthMember(), h.ChangePassword)
+	api.PUT("/change-email", h.Middleware.AuthMember(), h.ChangeEmail)
+	api.GET("/oidc/authorize", h.OIDCAuthorize)
+	api.POST("/oidc/callback", h.OIDCCallback)
 }
 
 // Register
@@ -111,7 +121,7 @@ func (h *routeMember) Login(c *gin.Context) {
 		return
 	}
 
-	response := h.Usecase.Login(ctx, payload)
+	response := h.Usecase.Login(ctx, payload, c.ClientIP())
 	c.JSON(response.Status, response)
 }
 
@@ -133,3 +143,194 @@ func (h *routeMember) GetProfile(c *gin.Context) {
 	response := h.Usecase.GetProfile(ctx, claim)
 	c.JSON(response.Status, response)
 }
+
+// ForgotPassword
+//
+// @Summary Forgot Password Member
+// @Description Forgot Password Member
+// @Tags Auth-Member
+// @Accept json
+// @Produce json
+// @Param payload body request.ForgotPasswordRequest true "Forgot Password Member"
+// @Success 200 {object} helpers.Response
+// @Router /member/auth/forgot-password [post]
+func (h *routeMember) ForgotPassword(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	payload := request.ForgotPasswordRequest{}
+	err := c.ShouldBindJSON(&payload)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, helpers.NewResponse(http.StatusBadRequest, "Invalid json data", nil, nil))
+		return
+	}
+
+	response := h.Usecase.ForgotPassword(ctx, payload)
+	c.JSON(response.Status, response)
+}
+
+// ResetPassword
+//
+// @Summary Reset Password Member
+// @Description Reset Password Member
+// @Tags Auth-Member
+// @Accept json
+// @Produce json
+// @Param payload body request.ResetPasswordRequest true "Reset Password Member"
+// @Success 200 {object} helpers.Response
+// @Router /member/auth/reset-password [post]
+func (h *routeMember) ResetPassword(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	payload := request.ResetPasswordRequest{}
+	err := c.ShouldBindJSON(&payload)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, helpers.NewResponse(http.StatusBadRequest, "Invalid json data", nil, nil))
+		return
+	}
+
+	response := h.Usecase.ResetPassword(ctx, payload)
+	c.JSON(response.Status, response)
+}
+
+// RefreshToken
+//
+// @Summary Refresh Token Member
+// @Description Refresh Token Member, the refresh token is rotated on every call
+// @Tags Auth-Member
+// @Accept json
+// @Produce json
+// @Param payload body request.RefreshTokenRequest true "Refresh Token Member"
+// @Success 200 {object} helpers.Response
+// @Router /member/auth/refresh-token [post]
+func (h *routeMember) RefreshToken(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	payload := request.RefreshTokenRequest{}
+	err := c.ShouldBindJSON(&payload)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, helpers.NewResponse(http.StatusBadRequest, "Invalid json data", nil, nil))
+		return
+	}
+
+	response := h.Usecase.RefreshToken(ctx, payload)
+	c.JSON(response.Status, response)
+}
+
+// Logout
+//
+// @Summary Logout Member
+// @Description Logout Member from current device
+// @Tags Auth-Member
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Success 200 {object} helpers.Response
+// @Router /member/auth/logout [post]
+func (h *routeMember) Logout(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	claim := c.MustGet("user_data").(jwt_helpers.MemberJWTClaims)
+
+	response := h.Usecase.Logout(ctx, claim)
+	c.JSON(response.Status, response)
+}
+
+// LogoutAllDevices
+//
+// @Summary Logout All Devices Member
+// @Description Logout Member from all devices
+// @Tags Auth-Member
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Success 200 {object} helpers.Response
+// @Router /member/auth/logout-all [post]
+func (h *routeMember) LogoutAllDevices(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	claim := c.MustGet("user_data").(jwt_helpers.MemberJWTClaims)
+
+	response := h.Usecase.LogoutAllDevices(ctx, claim)
+	c.JSON(response.Status, response)
+}
+
+// UpdateProfile
+//
+// @Summary Update Profile Member
+// @Description Update Profile Member, phone accepts 08xxx, 628xxx or +628xxx
+// @Tags Auth-Member
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param payload body request.MemberProfileUpdateRequest true "Update Profile Member"
+// @Success 200 {object} helpers.Response
+// @Router /member/auth/profile [put]
+func (h *routeMember) UpdateProfile(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	claim := c.MustGet("user_data").(jwt_helpers.MemberJWTClaims)
+
+	payload := request.MemberProfileUpdateRequest{}
+	err := c.ShouldBindJSON(&payload)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, helpers.NewResponse(http.StatusBadRequest, "Invalid json data", nil, nil))
+		return
+	}
+
+	response := h.Usecase.UpdateProfile(ctx, claim, payload)
+	c.JSON(response.Status, response)
+}
+
+// ChangePassword
+//
+// @Summary Change Password Member
+// @Description Change Password Member, other devices are logged out
+// @Tags Auth-Member
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param payload body request.ChangePasswordRequest true "Change Password Member"
+// @Success 200 {object} helpers.Response
+// @Router /member/auth/change-password [put]
+func (h *routeMember) ChangePassword(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	claim := c.MustGet("user_data").(jwt_helpers.MemberJWTClaims)
+
+	payload := request.ChangePasswordRequest{}
+	err := c.ShouldBindJSON(&payload)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, helpers.NewResponse(http.StatusBadRequest, "Invalid json data", nil, nil))
+		return
+	}
+
+	response := h.Usecase.ChangePassword(ctx, claim, payload)
+	c.JSON(response.Status, response)
+}
+
+// ChangeEmail
+//
+// @Summary Change Email Member
+// @Description Change Email Member, the new email is used after it is verified
+// @Tags Auth-Member
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param payload body request.ChangeEmailRequest true "Change Email Member"
+// @Success 200 {object} helpers.Response
+// @Router /member/auth/change-email [put]
+func (h *routeMember) ChangeEmail(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	claim := c.MustGet("user_data").(jwt_helpers.MemberJWTClaims)
+
+	payload := request.ChangeEmailRequest{}
+	err := c.ShouldBindJSON(&payload)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, helpers.NewResponse(http.StatusBadRequest, "Invalid json data", nil, nil))
+		return
+	}
+
+	response := h.Usecase.ChangeEmail(ctx, claim, payload)
+	c.JSON(response.Status, response)
+}
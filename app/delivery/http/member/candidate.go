@@ -13,7 +13,7 @@ func (h *routeMember) handleCandidateRoute(prefixPath string) {
 	api := h.Route.Group(prefixPath)
 
 	api.GET("", h.Middleware.OptionalAuthMember(), h.GetCandidateList)
-	api.POST("/vote", h.Middleware.AuthMember(), h.CandidateVote)
+	api.POST("/vote", h.Middleware.AuthMember(), h.Middleware.VerifiedMember(), h.CandidateVote)
 }
 
 // GetCandidateList
@@ -21,11 +21,15 @@ func NewMemberRouteHandler(usecase domain.MemberAppUsecase, ginEngine *gin.Engin
 	}
 
 	handler.handleAuthRoute("/auth")
+	handler.handleAccountRoute("/account")
 	handler.handleVotingRoute("/votings")
 	handler.handleCandidateRoute("/candidates")
 	handler.handlePurchaseRoute("/purchases")
 	handler.handleSeasonRoute("/seasons")
 	handler.handleTicketRoute("/tickets")
 	handler.handleSeriesRoute("/series")
+	handler.handleStandingRoute("/standings")
+	handler.handlePlayerStatsRoute("/player-stats")
 	handler.handleTicketPurchaseRoute("/ticket-purchases")
+	handler.handleRefundRoute("/refunds")
 }
@@ -0,0 +1,49 @@
+package member_http
+
+import (
+	"app/domain/request"
+	"app/helpers"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// OIDCAuthorize
+//
+// @Summary OIDC Authorize Member
+// @Description Start social login, redirect the browser to the returned authorization url
+// @Tags Auth-Member
+// @Accept json
+// @Produce json
+// @Success 200 {object} helpers.Response
+// @Router /member/auth/oidc/authorize [get]
+func (h *routeMember) OIDCAuthorize(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	response := h.Usecase.OIDCAuthorize(ctx)
+	c.JSON(response.Status, response)
+}
+
+// OIDCCallback
+//
+// @Summary OIDC Callback Member
+// @Description Finish social login with the code and state returned by the provider
+// @Tags Auth-Member
+// @Accept json
+// @Produce json
+// @Param payload body request.OIDCCallbackRequest true "OIDC Callback Member"
+// @Success 200 {object} helpers.Response
+// @Router /member/auth/oidc/callback [post]
+func (h *routeMember) OIDCCallback(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	payload := request.OIDCCallbackRequest{}
+	err := c.ShouldBindJSON(&payload)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, helpers.NewResponse(http.StatusBadRequest, "Invalid json data", nil, nil))
+		return
+	}
+
+	response := h.Usecase.OIDCCallback(ctx, payload)
+	c.JSON(response.Status, response)
+}
@@ -0,0 +1,35 @@
+package member_http
+
+import (
+	"github.com/gin-gonic/gin"
+)
+
+func (h *routeMember) handlePlayerStatsRoute(prefixPath string) {
+	api := h.Route.Group(prefixPath)
+
+	api.GET("", h.GetPlayerStatsList)
+}
+
+// GetPlayerStatsList
+// @Summary Get Player Stats List
+// @Description Get season team players ranked by a stat from the recorded events of live and finished matches, like top scorers, top assists or best goalkeepers
+// @Tags PlayerStats-Member
+// @Accept json
+// @Produce json
+// @Param seasonId query string false "Season ID, default the active season"
+// @Param seriesId query string false "Series ID"
+// @Param seasonTeamId query string false "Season Team ID"
+// @Param position query string false "Player position"
+// @Param sort query string false "Rank by goal, assist, save, yellowCard, redCard, card or minute, default goal"
+// @Param page query int false "Page"
+// @Param limit query int false "Limit"
+// @Success 200 {object} helpers.Response
+// @Router /member/player-stats [get]
+func (h *routeMember) GetPlayerStatsList(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	query := c.Request.URL.Query()
+
+	response := h.Usecase.GetPlayerStatsList(ctx, query)
+	c.JSON(response.Status, response)
+}
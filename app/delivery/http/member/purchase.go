@@ -14,8 +14,10 @@ func (h *routeMember) handlePurchaseRoute(prefixPath string) {
 
 	api.GET("", h.Middleware.AuthMember(), h.GetPurchasesList)
 	api.GET("/:id", h.Middleware.AuthMember(), h.GetPurchaseDetail)
-	api.POST("", h.Middleware.AuthMember(), h.CreatePurchase)
-	api.POST("/packages", h.Middleware.AuthMember(), h.CreatePackagePurchase)
+	api.POST("", h.Middleware.AuthMember(), h.Middleware.VerifiedMember(), h.CreatePurchase)
+	api.POST("/packages", h.Middleware.AuthMember(), h.Middleware.VerifiedMember(), h.CreatePackagePurchase)
+	api.POST("/season-pass", h.Middleware.AuthMember(), h.Middleware.VerifiedMember(), h.CreateSeasonPassPurchase)
+	api.POST("/:id/reschedule-refund", h.Middleware.AuthMember(), h.RequestRescheduleRefund)
 }
 
 // GetPurchasesList
@@ -114,3 +116,58 @@ func (h *routeMember) CreatePackagePurchase(c *gin.Context) {
 	response := h.Usecase.CreatePackagePurchase(ctx, claim, payload)
 	c.JSON(response.Status, response)
 }
+
+// CreateSeasonPassPurchase
+//
+//	@Summary		Create season pass purchase
+//	@Description	Create season pass purchase, product ID is the season ID
+//	@Tags			Purchase-Member
+//	@Security		BearerAuth
+//	@Accept			json
+//	@Produce		json
+//	@Param			payload	body	request.CreatePurchaseRequest	true	"Create season pass purchase"
+//	@Success		200		{object}	helpers.Response
+//	@Router			/member/purchases/season-pass [post]
+func (h *routeMember) CreateSeasonPassPurchase(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	claim := c.MustGet("user_data").(jwt_helpers.MemberJWTClaims)
+	var payload request.CreatePurchaseRequest
+	err := c.ShouldBindJSON(&payload)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, helpers.NewResponse(http.StatusBadRequest, "Invalid json data", nil, nil))
+		return
+	}
+
+	response := h.Usecase.CreateSeasonPassPurchase(ctx, claim, payload)
+	c.JSON(response.Status, response)
+}
+
+// RequestRescheduleRefund
+//
+//	@Summary		Request refund for rescheduled ticket day
+//	@Description	Give up tickets of a rescheduled ticket day for a refund while the opt in window is open
+//	@Tags			Purchase-Member
+//	@Security		BearerAuth
+//	@Accept			json
+//	@Produce		json
+//	@Param			id		path	string	true	"Purchase ID"
+//	@Param			payload	body	request.RescheduleRefundRequest	true	"Request refund"
+//	@Success		201		{object}	helpers.Response
+//	@Router			/member/purchases/{id}/reschedule-refund [post]
+func (h *routeMember) RequestRescheduleRefund(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	claim := c.MustGet("user_data").(jwt_helpers.MemberJWTClaims)
+	var payload request.RescheduleRefundRequest
+	err := c.ShouldBindJSON(&payload)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, helpers.NewResponse(http.StatusBadRequest, "Invalid json data", nil, nil))
+		return
+	}
+
+	id := c.Param("id")
+
+	response := h.Usecase.RequestRescheduleRefund(ctx, claim, id, payload)
+	c.JSON(response.Status, response)
+}
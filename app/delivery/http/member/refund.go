@@ -0,0 +1,38 @@
+package member_http
+
+import (
+	jwt_helpers "app/helpers/jwt"
+
+	"github.com/gin-gonic/gin"
+)
+
+func (h *routeMember) handleRefundRoute(prefixPath string) {
+	api := h.Route.Group(prefixPath)
+
+	api.GET("", h.Middleware.AuthMember(), h.GetRefundsList)
+}
+
+// GetRefundsList
+//
+//	@Summary		Get refunds list
+//	@Description	Get refunds list of logged in member
+//	@Tags			Refund-Member
+//	@Security		BearerAuth
+//	@Accept			json
+//	@Produce		json
+//	@Param			purchaseId	query	string	false	"Purchase ID"
+//	@Param			page	query	int	false	"Page"
+//	@Param			limit	query	int	false	"Limit"
+//	@Param			sort	query	string	false	"Sort"
+//	@Param			dir		query	string	false	"Direction asc or desc"
+//	@Success		200		{object}	helpers.Response
+//	@Router			/member/refunds [get]
+func (h *routeMember) GetRefundsList(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	claim := c.MustGet("user_data").(jwt_helpers.MemberJWTClaims)
+	queryParam := c.Request.URL.Query()
+
+	response := h.Usecase.GetRefundsList(ctx, claim, queryParam)
+	c.JSON(response.Status, response)
+}
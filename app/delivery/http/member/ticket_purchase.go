@@ -1,7 +1,10 @@
 package member_http
 
 import (
+	"app/domain/request"
+	"app/helpers"
 	jwt_helpers "app/helpers/jwt"
+	"net/http"
 
 	"github.com/gin-gonic/gin"
 )
@@ -10,6 +13,7 @@ func (h *routeMember) handleTicketPurchaseRoute(prefixPath string) {
 	api := h.Route.Group(prefixPath)
 
 	api.GET("", h.Middleware.AuthMember(), h.GetTicketPurchasesList)
+	api.POST("/:id/reissue", h.Middleware.AuthMember(), h.ReissueTicketPurchase)
 }
 
 // GetTicketPurchasesList
@@ -35,3 +39,31 @@ func (h *routeMember) GetTicketPurchasesList(c *gin.Context) {
 	response := h.Usecase.GetTicketPurchasesList(ctx, claim, queryParam)
 	c.JSON(response.Status, response)
 }
+
+// ReissueTicketPurchase
+//
+//	@Summary		Reissue ticket purchase
+//	@Description	Revoke the current QR code of a ticket purchase and email a new one
+//	@Tags			TicketPurchase-Member
+//	@Security		BearerAuth
+//	@Accept			json
+//	@Produce		json
+//	@Param			id		path	string	true	"Ticket Purchase ID"
+//	@Param			payload	body	request.TicketPurchaseReissueRequest	true	"Reissue ticket purchase"
+//	@Success		200		{object}	helpers.Response
+//	@Router			/member/ticket-purchases/{id}/reissue [post]
+func (h *routeMember) ReissueTicketPurchase(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	id := c.Param("id")
+	claim := c.MustGet("user_data").(jwt_helpers.MemberJWTClaims)
+	payload := request.TicketPurchaseReissueRequest{}
+	err := c.ShouldBindJSON(&payload)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, helpers.NewResponse(http.StatusBadRequest, "Invalid json data", nil, nil))
+		return
+	}
+
+	response := h.Usecase.ReissueTicketPurchase(ctx, claim, id, payload)
+	c.JSON(response.Status, response)
+}
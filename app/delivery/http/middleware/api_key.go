@@ -0,0 +1,126 @@
+package middleware
+
+import (
+	mongo_model "app/domain/model/mongo"
+	"app/helpers"
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// apiKeyRateWindow the rate limit of a key is counted per fixed window of this length
+const apiKeyRateWindow = time.Minute
+
+// AuthAPIKey authenticates a partner by the X-API-Key header, the key must carry every given scope
+func (m *appMiddleware) AuthAPIKey(scopes ...mongo_model.APIKeyScope) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		// get key from header
+		requestKey := c.Request.Header.Get("X-API-Key")
+		if requestKey == "" {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, helpers.NewResponse(
+				http.StatusUnauthorized,
+				"Unauthorized: Missing X-API-Key Header",
+				nil,
+				nil,
+			))
+			return
+		}
+
+		// check key, only its hash is stored
+		apiKey, err := m.mongoDbRepo.FetchOneAPIKey(c.Request.Context(), map[string]interface{}{
+			"keyHash": helpers.HashToken(requestKey),
+		})
+		if err != nil {
+			c.AbortWithStatusJSON(http.StatusInternalServerError, helpers.NewResponse(
+				http.StatusInternalServerError,
+				err.Error(),
+				nil,
+				nil,
+			))
+			return
+		}
+		if apiKey == nil {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, helpers.NewResponse(
+				http.StatusUnauthorized,
+				"Unauthorized: Invalid API Key",
+				nil,
+				nil,
+			))
+			return
+		}
+
+		// check status
+		now := time.Now()
+		switch apiKey.GetStatus(now) {
+		case mongo_model.APIKeyStatusRevoked:
+			c.AbortWithStatusJSON(http.StatusUnauthorized, helpers.NewResponse(
+				http.StatusUnauthorized,
+				"Unauthorized: API Key Revoked",
+				nil,
+				nil,
+			))
+			return
+		case mongo_model.APIKeyStatusExpired:
+			c.AbortWithStatusJSON(http.StatusUnauthorized, helpers.NewResponse(
+				http.StatusUnauthorized,
+				"Unauthorized: API Key Expired",
+				nil,
+				nil,
+			))
+			return
+		}
+
+		// check scopes
+		for _, scope := range scopes {
+			if !apiKey.HasScope(scope) {
+				c.AbortWithStatusJSON(http.StatusForbidden, helpers.NewResponse(
+					http.StatusForbidden,
+					"Forbidden: Missing Scope "+string(scope),
+					nil,
+					nil,
+				))
+				return
+			}
+		}
+
+		// count the request, the window restarts once it is older than apiKeyRateWindow
+		apiKey, err = m.mongoDbRepo.IncrementAPIKeyUsage(c.Request.Context(), apiKey.ID.Hex(), now, now.Add(-apiKeyRateWindow))
+		if err != nil {
+			c.AbortWithStatusJSON(http.StatusInternalServerError, helpers.NewResponse(
+				http.StatusInternalServerError,
+				err.Error(),
+				nil,
+				nil,
+			))
+			return
+		}
+
+		// check rate limit
+		remaining := apiKey.RateLimit - apiKey.RateCount
+		if remaining < 0 {
+			remaining = 0
+		}
+		c.Header("X-RateLimit-Limit", strconv.Itoa(apiKey.RateLimit))
+		c.Header("X-RateLimit-Remaining", strconv.Itoa(remaining))
+		if apiKey.RateCount > apiKey.RateLimit {
+			retryAfter := apiKeyRateWindow
+			if apiKey.RateWindowStart != nil {
+				retryAfter = apiKey.RateWindowStart.Add(apiKeyRateWindow).Sub(now)
+			}
+			c.Header("Retry-After", strconv.Itoa(int(math.Ceil(retryAfter.Seconds()))))
+			c.AbortWithStatusJSON(http.StatusTooManyRequests, helpers.NewResponse(
+				http.StatusTooManyRequests,
+				"Too Many Requests: Rate Limit Exceeded",
+				nil,
+				nil,
+			))
+			return
+		}
+
+		c.Set("api_key", *apiKey)
+		c.Next()
+	}
+}
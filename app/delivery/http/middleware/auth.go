@@ -1,6 +1,7 @@
 package middleware
 
 import (
+	mongo_model "app/domain/model/mongo"
 	"app/helpers"
 	jwt_helpers "app/helpers/jwt"
 	"errors"
@@ -11,7 +12,8 @@ import (
 	"github.com/golang-jwt/jwt/v5"
 )
 
-func (m *appMiddleware) AuthSuperadmin() gin.HandlerFunc {
+// AuthSuperadmin the route is allowed when the token carries any of the given permissions, none means any superadmin
+func (m *appMiddleware) AuthSuperadmin(permissions ...mongo_model.Permission) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		// get token from header
 		requestToken := c.Request.Header.Get("Authorization")
@@ -93,8 +95,47 @@ func (m *appMiddleware) AuthSuperadmin() gin.HandlerFunc {
 			return
 		}
 
-		// set claims to context
+		// reject revoked sessions
+		user, message, err := m.validateSession(c.Request.Context(), mongo_model.ActorRoleSuperadmin, claims.UserID, claims.SessionID, claims.TokenVersion)
+		if err != nil {
+			c.AbortWithStatusJSON(http.StatusInternalServerError, helpers.NewResponse(
+				http.StatusInternalServerError,
+				err.Error(),
+				nil,
+				nil,
+			))
+			return
+		}
+		if message != "" {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, helpers.NewResponse(
+				http.StatusUnauthorized,
+				message,
+				nil,
+				nil,
+			))
+			return
+		}
+
+		// check permission of the route
+		if len(permissions) > 0 && !hasAnyPermission(claims.Permissions, permissions) {
+			c.AbortWithStatusJSON(http.StatusForbidden, helpers.NewResponse(
+				http.StatusForbidden,
+				"Forbidden: Missing Permission",
+				nil,
+				nil,
+			))
+			return
+		}
+
+		// set claims to context, the actor is read by the usecase when writing the audit log
 		c.Set("user_data", *claims)
+		c.Request = c.Request.WithContext(helpers.WithAuditActor(c.Request.Context(), helpers.AuditActor{
+			ID:        claims.UserID,
+			Name:      user.Name,
+			Role:      string(mongo_model.ActorRoleSuperadmin),
+			IP:        c.ClientIP(),
+			UserAgent: c.Request.UserAgent(),
+		}))
 		c.Next()
 	}
 }
@@ -181,12 +222,44 @@ func (m *appMiddleware) AuthAdmin() gin.HandlerFunc {
 			return
 		}
 
-		// set claims to context
+		// reject revoked sessions
+		user, message, err := m.validateSession(c.Request.Context(), mongo_model.ActorRoleAdmin, claims.UserID, claims.SessionID, claims.TokenVersion)
+		if err != nil {
+			c.AbortWithStatusJSON(http.StatusInternalServerError, helpers.NewResponse(
+				http.StatusInternalServerError,
+				err.Error(),
+				nil,
+				nil,
+			))
+			return
+		}
+		if message != "" {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, helpers.NewResponse(
+				http.StatusUnauthorized,
+				message,
+				nil,
+				nil,
+			))
+			return
+		}
+
+		// set claims to context, the actor is read by the usecase when writing the audit log
 		c.Set("user_data", *claims)
+		c.Request = c.Request.WithContext(helpers.WithAuditActor(c.Request.Context(), helpers.AuditActor{
+			ID:        claims.UserID,
+			Name:      user.Name,
+			Role:      string(mongo_model.ActorRoleAdmin),
+			IP:        c.ClientIP(),
+			UserAgent: c.Request.UserAgent(),
+		}))
 		c.Next()
 	}
 }
 
+// ErrorCodeImpersonationReadOnly lets the frontend tell a refused impersonated request apart
+const ErrorCodeImpersonationReadOnly = "IMPERSONATION_READ_ONLY"
+
+// AuthMember an impersonated token issued to a superadmin is only allowed on read routes
 func (m *appMiddleware) AuthMember() gin.HandlerFunc {
 	return func(c *gin.Context) {
 		// get token from header
@@ -269,8 +342,43 @@ func (m *appMiddleware) AuthMember() gin.HandlerFunc {
 			return
 		}
 
-		// set claims to context
+		// reject revoked sessions
+		user, message, err := m.validateSession(c.Request.Context(), mongo_model.ActorRoleMember, claims.UserID, claims.SessionID, claims.TokenVersion)
+		if err != nil {
+			c.AbortWithStatusJSON(http.StatusInternalServerError, helpers.NewResponse(
+				http.StatusInternalServerError,
+				err.Error(),
+				nil,
+				nil,
+			))
+			return
+		}
+		if message != "" {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, helpers.NewResponse(
+				http.StatusUnauthorized,
+				message,
+				nil,
+				nil,
+			))
+			return
+		}
+
+		// impersonation is read only
+		if claims.IsImpersonated() && c.Request.Method != http.MethodGet && c.Request.Method != http.MethodHead {
+			c.AbortWithStatusJSON(http.StatusForbidden, helpers.NewResponse(
+				http.StatusForbidden,
+				"Forbidden: Impersonation Is Read Only",
+				nil,
+				map[string]any{
+					"code": ErrorCodeImpersonationReadOnly,
+				},
+			))
+			return
+		}
+
+		// set claims and the loaded member to context
 		c.Set("user_data", *claims)
+		c.Set("member_data", user.Member)
 		c.Next()
 	}
 }
@@ -351,8 +459,30 @@ func (m *appMiddleware) OptionalAuthMember() gin.HandlerFunc {
 			return
 		}
 
-		// set claims to context
+		// reject revoked sessions
+		user, message, err := m.validateSession(c.Request.Context(), mongo_model.ActorRoleMember, claims.UserID, claims.SessionID, claims.TokenVersion)
+		if err != nil {
+			c.AbortWithStatusJSON(http.StatusInternalServerError, helpers.NewResponse(
+				http.StatusInternalServerError,
+				err.Error(),
+				nil,
+				nil,
+			))
+			return
+		}
+		if message != "" {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, helpers.NewResponse(
+				http.StatusUnauthorized,
+				message,
+				nil,
+				nil,
+			))
+			return
+		}
+
+		// set claims and the loaded member to context
 		c.Set("user_data", *claims)
+		c.Set("member_data", user.Member)
 		c.Next()
 	}
 }
@@ -385,3 +515,12 @@ func (m *appMiddleware) AuthXendit() gin.HandlerFunc {
 		c.Next()
 	}
 }
+
+func hasAnyPermission(granted []string, required []mongo_model.Permission) bool {
+	for _, permission := range required {
+		if mongo_model.HasPermission(granted, permission) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,33 @@
+package middleware
+
+import (
+	"app/helpers"
+	jwt_helpers "app/helpers/jwt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ErrorCodeImpersonationForbidden lets the frontend tell a route closed to impersonation apart
+const ErrorCodeImpersonationForbidden = "IMPERSONATION_FORBIDDEN"
+
+// NotImpersonated must run after AuthMember, it rejects impersonated tokens on read routes
+// exposing personal data or credentials that support staff must not take away
+func (m *appMiddleware) NotImpersonated() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		claim := c.MustGet("user_data").(jwt_helpers.MemberJWTClaims)
+		if claim.IsImpersonated() {
+			c.AbortWithStatusJSON(http.StatusForbidden, helpers.NewResponse(
+				http.StatusForbidden,
+				"Forbidden: Not Allowed While Impersonating",
+				nil,
+				map[string]any{
+					"code": ErrorCodeImpersonationForbidden,
+				},
+			))
+			return
+		}
+
+		c.Next()
+	}
+}
@@ -1,6 +1,8 @@
 package middleware
 
 import (
+	"app/domain"
+	mongo_model "app/domain/model/mongo"
 	jwt_helpers "app/helpers/jwt"
 	"io"
 	"os"
@@ -9,14 +11,16 @@ import (
 )
 
 type appMiddleware struct {
+	mongoDbRepo         domain.MongoDbRepo
 	secretKeySuperadmin string
 	secretKeyAdmin      string
 	secretKeyMember     string
 	xenditCallbackToken string
 }
 
-func NewAppMiddleware() AppMiddleware {
+func NewAppMiddleware(mongoDbRepo domain.MongoDbRepo) AppMiddleware {
 	return &appMiddleware{
+		mongoDbRepo:         mongoDbRepo,
 		secretKeySuperadmin: jwt_helpers.GetJWTSecretKeySuperadmin(),
 		secretKeyAdmin:      jwt_helpers.GetJWTSecretKeyAdmin(),
 		secretKeyMember:     jwt_helpers.GetJWTSecretKeyMember(),
@@ -25,11 +29,14 @@ func NewAppMiddleware() AppMiddleware {
 }
 
 type AppMiddleware interface {
-	AuthSuperadmin() gin.HandlerFunc
+	AuthSuperadmin(permissions ...mongo_model.Permission) gin.HandlerFunc
 	AuthAdmin() gin.HandlerFunc
 	AuthMember() gin.HandlerFunc
 	OptionalAuthMember() gin.HandlerFunc
+	VerifiedMember() gin.HandlerFunc
+	NotImpersonated() gin.HandlerFunc
 	AuthXendit() gin.HandlerFunc
+	AuthAPIKey(scopes ...mongo_model.APIKeyScope) gin.HandlerFunc
 	Logger(writer io.Writer) gin.HandlerFunc
 	Recovery() gin.HandlerFunc
 }
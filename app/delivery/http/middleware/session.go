@@ -0,0 +1,85 @@
+package middleware
+
+import (
+	mongo_model "app/domain/model/mongo"
+	"context"
+	"time"
+)
+
+// sessionUser is the account loaded while validating the session, so the next middlewares do not fetch it again
+type sessionUser struct {
+	// Name is written to the audit log
+	Name string
+	// Member is only set for the member role
+	Member *mongo_model.Member
+}
+
+// validateSession make sure the token is not revoked by logout, logout all devices or password reset,
+// returns the unauthorized message when it is, otherwise the user of the session
+func (m *appMiddleware) validateSession(ctx context.Context, role mongo_model.ActorRole, userID, sessionID string, tokenVersion int) (user sessionUser, message string, err error) {
+	// check token version of the user
+	var userTokenVersion int
+	switch role {
+	case mongo_model.ActorRoleSuperadmin:
+		superadmin, err := m.mongoDbRepo.FetchOneSuperadmin(ctx, map[string]interface{}{
+			"id": userID,
+		})
+		if err != nil {
+			return user, "", err
+		}
+		if superadmin == nil {
+			return user, "Unauthorized: User Not Found", nil
+		}
+		userTokenVersion = superadmin.TokenVersion
+		user.Name = superadmin.Name
+	case mongo_model.ActorRoleAdmin:
+		admin, err := m.mongoDbRepo.FetchOneAdmin(ctx, map[string]interface{}{
+			"id": userID,
+		})
+		if err != nil {
+			return user, "", err
+		}
+		if admin == nil {
+			return user, "Unauthorized: User Not Found", nil
+		}
+		if admin.IsDisabled {
+			return user, "Unauthorized: Account Disabled", nil
+		}
+		userTokenVersion = admin.TokenVersion
+		user.Name = admin.Name
+	case mongo_model.ActorRoleMember:
+		member, err := m.mongoDbRepo.FetchOneMember(ctx, map[string]interface{}{
+			"id": userID,
+		})
+		if err != nil {
+			return user, "", err
+		}
+		if member == nil {
+			return user, "Unauthorized: User Not Found", nil
+		}
+		userTokenVersion = member.TokenVersion
+		user.Name = member.Name
+		user.Member = member
+	}
+	if userTokenVersion != tokenVersion {
+		return user, "Unauthorized: Session Revoked", nil
+	}
+
+	// check session of the device
+	if sessionID == "" {
+		return user, "Unauthorized: Session Revoked", nil
+	}
+	session, err := m.mongoDbRepo.FetchOneAuthSession(ctx, map[string]interface{}{
+		"id":     sessionID,
+		"role":   role,
+		"userId": userID,
+	})
+	if err != nil {
+		return user, "", err
+	}
+	if session == nil || !session.IsActive(time.Now()) {
+		return user, "Unauthorized: Session Revoked", nil
+	}
+
+	return user, "", nil
+}
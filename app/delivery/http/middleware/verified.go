@@ -0,0 +1,33 @@
+package middleware
+
+import (
+	mongo_model "app/domain/model/mongo"
+	"app/helpers"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ErrorCodeEmailNotVerified lets the frontend tell this refusal apart and prompt email verification
+const ErrorCodeEmailNotVerified = "EMAIL_NOT_VERIFIED"
+
+// VerifiedMember must run after AuthMember, it rejects members whose email is not verified yet
+func (m *appMiddleware) VerifiedMember() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		// the member was loaded by AuthMember
+		member, _ := c.MustGet("member_data").(*mongo_model.Member)
+		if member == nil || !member.IsVerified {
+			c.AbortWithStatusJSON(http.StatusForbidden, helpers.NewResponse(
+				http.StatusForbidden,
+				"Forbidden: Email Not Verified",
+				nil,
+				map[string]any{
+					"code": ErrorCodeEmailNotVerified,
+				},
+			))
+			return
+		}
+
+		c.Next()
+	}
+}
@@ -0,0 +1,34 @@
+package partner_http
+
+import (
+	mongo_model "app/domain/model/mongo"
+
+	"github.com/gin-gonic/gin"
+)
+
+func (h *routePartner) handleAttendanceRoute(prefixPath string) {
+	api := h.Route.Group(prefixPath)
+
+	api.GET("", h.Middleware.AuthAPIKey(mongo_model.APIKeyScopeAttendanceRead), h.GetAttendanceList)
+}
+
+// GetAttendanceList
+//
+// @Summary Get Attendance List
+// @Description Get sold, issued and checked in count of the ticket days of a day
+// @Tags Attendance-Partner
+// @Security ApiKeyAuth
+// @Accept json
+// @Produce json
+// @Param date query string false "Date YYYY-MM-DD, default today"
+// @Param ticketId query string false "Ticket ID, overrides date"
+// @Success 200 {object} helpers.Response
+// @Router /partner/attendance [get]
+func (h *routePartner) GetAttendanceList(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	query := c.Request.URL.Query()
+
+	response := h.Usecase.GetAttendanceList(ctx, query)
+	c.JSON(response.Status, response)
+}
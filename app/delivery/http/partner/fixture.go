@@ -0,0 +1,39 @@
+package partner_http
+
+import (
+	mongo_model "app/domain/model/mongo"
+
+	"github.com/gin-gonic/gin"
+)
+
+func (h *routePartner) handleFixtureRoute(prefixPath string) {
+	api := h.Route.Group(prefixPath)
+
+	api.GET("", h.Middleware.AuthAPIKey(mongo_model.APIKeyScopeFixturesRead), h.GetFixturesList)
+}
+
+// GetFixturesList
+//
+// @Summary Get Fixtures List
+// @Description Get ticket days with their matches, venues and teams
+// @Tags Fixture-Partner
+// @Security ApiKeyAuth
+// @Accept json
+// @Produce json
+// @Param seriesId query string false "Series ID"
+// @Param startDate query string false "Start date YYYY-MM-DD"
+// @Param endDate query string false "End date YYYY-MM-DD"
+// @Param page query int false "Page"
+// @Param limit query int false "Limit"
+// @Param sort query string false "Sort"
+// @Param dir query string false "Direction asc or desc"
+// @Success 200 {object} helpers.Response
+// @Router /partner/fixtures [get]
+func (h *routePartner) GetFixturesList(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	query := c.Request.URL.Query()
+
+	response := h.Usecase.GetFixturesList(ctx, query)
+	c.JSON(response.Status, response)
+}
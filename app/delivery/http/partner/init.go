@@ -0,0 +1,26 @@
+package partner_http
+
+import (
+	"app/app/delivery/http/middleware"
+	"app/domain"
+
+	"github.com/gin-gonic/gin"
+)
+
+type routePartner struct {
+	Usecase    domain.PartnerAppUsecase
+	Route      *gin.RouterGroup
+	Middleware middleware.AppMiddleware
+}
+
+func NewPartnerRouteHandler(usecase domain.PartnerAppUsecase, ginEngine *gin.Engine, middleware middleware.AppMiddleware) {
+	handler := &routePartner{
+		Usecase:    usecase,
+		Route:      ginEngine.Group("/partner"),
+		Middleware: middleware,
+	}
+
+	handler.handleFixtureRoute("/fixtures")
+	handler.handleAttendanceRoute("/attendance")
+	handler.handleStandingRoute("/standings")
+}
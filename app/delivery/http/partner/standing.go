@@ -0,0 +1,35 @@
+package partner_http
+
+import (
+	mongo_model "app/domain/model/mongo"
+
+	"github.com/gin-gonic/gin"
+)
+
+func (h *routePartner) handleStandingRoute(prefixPath string) {
+	api := h.Route.Group(prefixPath)
+
+	api.GET("", h.Middleware.AuthAPIKey(mongo_model.APIKeyScopeStandingsRead), h.GetStandingsList)
+}
+
+// GetStandingsList
+//
+// @Summary Get Standings List
+// @Description Get standings of a season, default the active season. The table of the whole season has an empty series id and group
+// @Tags Standing-Partner
+// @Security ApiKeyAuth
+// @Accept json
+// @Produce json
+// @Param seasonId query string false "Season ID, default the active season"
+// @Param seriesId query string false "Series ID, empty for the whole season"
+// @Param group query string false "Group, empty for every group"
+// @Success 200 {object} helpers.Response
+// @Router /partner/standings [get]
+func (h *routePartner) GetStandingsList(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	query := c.Request.URL.Query()
+
+	response := h.Usecase.GetStandingsList(ctx, query)
+	c.JSON(response.Status, response)
+}
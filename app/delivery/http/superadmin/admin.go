@@ -0,0 +1,189 @@
+package superadmin_http
+
+import (
+	mongo_model "app/domain/model/mongo"
+	"app/domain/request"
+	"app/helpers"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+func (h *routeSuperadmin) handleAdminRoute(prefixPath string) {
+	api := h.Route.Group(prefixPath)
+
+	api.GET("", h.Middleware.AuthSuperadmin(mongo_model.PermissionAdminsView), h.GetAdminsList)
+	api.GET("/:id", h.Middleware.AuthSuperadmin(mongo_model.PermissionAdminsView), h.GetAdminDetail)
+	api.POST("", h.Middleware.AuthSuperadmin(mongo_model.PermissionAdminsManage), h.CreateAdmin)
+	api.PUT("/:id", h.Middleware.AuthSuperadmin(mongo_model.PermissionAdminsManage), h.UpdateAdmin)
+	api.PUT("/:id/status", h.Middleware.AuthSuperadmin(mongo_model.PermissionAdminsManage), h.UpdateAdminStatus)
+	api.POST("/:id/resend-invite", h.Middleware.AuthSuperadmin(mongo_model.PermissionAdminsManage), h.ResendAdminInvite)
+	api.DELETE("/:id", h.Middleware.AuthSuperadmin(mongo_model.PermissionAdminsManage), h.DeleteAdmin)
+}
+
+// GetAdminsList
+//
+// @Summary Get Admins List
+// @Description Get Admins List
+// @Tags Admin-Superadmin
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param search query string false "Search by name, username or email"
+// @Param venueId query string false "Filter by venue ID"
+// @Param isDisabled query bool false "Filter by disabled status"
+// @Param page query int false "Page"
+// @Param limit query int false "Limit"
+// @Param sort query string false "Sort"
+// @Param dir query string false "Direction asc or desc"
+// @Success 200 {object} helpers.Response
+// @Router /superadmin/admins [get]
+func (h *routeSuperadmin) GetAdminsList(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	query := c.Request.URL.Query()
+
+	response := h.Usecase.GetAdminsList(ctx, query)
+	c.JSON(response.Status, response)
+}
+
+// GetAdminDetail
+//
+// @Summary Get Admin Detail
+// @Description Get Admin Detail
+// @Tags Admin-Superadmin
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param id path string true "Admin ID"
+// @Success 200 {object} helpers.Response
+// @Router /superadmin/admins/{id} [get]
+func (h *routeSuperadmin) GetAdminDetail(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	id := c.Param("id")
+
+	response := h.Usecase.GetAdminDetail(ctx, id)
+	c.JSON(response.Status, response)
+}
+
+// CreateAdmin
+//
+// @Summary Create Admin
+// @Description Create Admin
+// @Tags Admin-Superadmin
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param payload body request.AdminCreateRequest true "Create Admin"
+// @Success 201 {object} helpers.Response
+// @Router /superadmin/admins [post]
+func (h *routeSuperadmin) CreateAdmin(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	payload := request.AdminCreateRequest{}
+	err := c.ShouldBindJSON(&payload)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, helpers.NewResponse(http.StatusBadRequest, "Invalid json data", nil, nil))
+		return
+	}
+
+	response := h.Usecase.CreateAdmin(ctx, payload)
+	c.JSON(response.Status, response)
+}
+
+// UpdateAdmin
+//
+// @Summary Update Admin
+// @Description Update Admin
+// @Tags Admin-Superadmin
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param id path string true "Admin ID"
+// @Param payload body request.AdminUpdateRequest true "Update Admin"
+// @Success 200 {object} helpers.Response
+// @Router /superadmin/admins/{id} [put]
+func (h *routeSuperadmin) UpdateAdmin(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	payload := request.AdminUpdateRequest{}
+	err := c.ShouldBindJSON(&payload)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, helpers.NewResponse(http.StatusBadRequest, "Invalid json data", nil, nil))
+		return
+	}
+
+	id := c.Param("id")
+
+	response := h.Usecase.UpdateAdmin(ctx, id, payload)
+	c.JSON(response.Status, response)
+}
+
+// UpdateAdminStatus
+//
+// @Summary Update Admin Status
+// @Description Enable or disable Admin, a disabled admin is logged out from every device
+// @Tags Admin-Superadmin
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param id path string true "Admin ID"
+// @Param payload body request.AdminStatusUpdateRequest true "Update Admin Status"
+// @Success 200 {object} helpers.Response
+// @Router /superadmin/admins/{id}/status [put]
+func (h *routeSuperadmin) UpdateAdminStatus(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	payload := request.AdminStatusUpdateRequest{}
+	err := c.ShouldBindJSON(&payload)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, helpers.NewResponse(http.StatusBadRequest, "Invalid json data", nil, nil))
+		return
+	}
+
+	id := c.Param("id")
+
+	response := h.Usecase.UpdateAdminStatus(ctx, id, payload)
+	c.JSON(response.Status, response)
+}
+
+// ResendAdminInvite
+//
+// @Summary Resend Admin Invite
+// @Description Resend password setup invite email to Admin
+// @Tags Admin-Superadmin
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param id path string true "Admin ID"
+// @Success 200 {object} helpers.Response
+// @Router /superadmin/admins/{id}/resend-invite [post]
+func (h *routeSuperadmin) ResendAdminInvite(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	id := c.Param("id")
+
+	response := h.Usecase.ResendAdminInvite(ctx, id)
+	c.JSON(response.Status, response)
+}
+
+// DeleteAdmin
+//
+// @Summary Delete Admin
+// @Description Delete Admin
+// @Tags Admin-Superadmin
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param id path string true "Admin ID"
+// @Success 200 {object} helpers.Response
+// @Router /superadmin/admins/{id} [delete]
+func (h *routeSuperadmin) DeleteAdmin(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	id := c.Param("id")
+
+	response := h.Usecase.DeleteAdmin(ctx, id)
+	c.JSON(response.Status, response)
+}
@@ -0,0 +1,160 @@
+package superadmin_http
+
+import (
+	mongo_model "app/domain/model/mongo"
+	"app/domain/request"
+	"app/helpers"
+	jwt_helpers "app/helpers/jwt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+func (h *routeSuperadmin) handleAPIKeyRoute(prefixPath string) {
+	api := h.Route.Group(prefixPath)
+
+	api.GET("/scopes", h.Middleware.AuthSuperadmin(mongo_model.PermissionAPIKeysManage), h.GetAPIKeyScopesList)
+	api.GET("", h.Middleware.AuthSuperadmin(mongo_model.PermissionAPIKeysManage), h.GetAPIKeysList)
+	api.GET("/:id", h.Middleware.AuthSuperadmin(mongo_model.PermissionAPIKeysManage), h.GetAPIKeyDetail)
+	api.POST("", h.Middleware.AuthSuperadmin(mongo_model.PermissionAPIKeysManage), h.CreateAPIKey)
+	api.PUT("/:id", h.Middleware.AuthSuperadmin(mongo_model.PermissionAPIKeysManage), h.UpdateAPIKey)
+	api.POST("/:id/revoke", h.Middleware.AuthSuperadmin(mongo_model.PermissionAPIKeysManage), h.RevokeAPIKey)
+}
+
+// GetAPIKeyScopesList
+//
+// @Summary Get API Key Scopes List
+// @Description Get every scope a partner api key can be given
+// @Tags API-Key-Superadmin
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Success 200 {object} helpers.Response
+// @Router /superadmin/api-keys/scopes [get]
+func (h *routeSuperadmin) GetAPIKeyScopesList(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	response := h.Usecase.GetAPIKeyScopesList(ctx)
+	c.JSON(response.Status, response)
+}
+
+// GetAPIKeysList
+//
+// @Summary Get API Keys List
+// @Description Get API Keys List
+// @Tags API-Key-Superadmin
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param search query string false "Search by name or prefix"
+// @Param scope query string false "Scope"
+// @Param status query string false "Status active, expired or revoked"
+// @Param page query int false "Page"
+// @Param limit query int false "Limit"
+// @Param sort query string false "Sort"
+// @Param dir query string false "Direction asc or desc"
+// @Success 200 {object} helpers.Response
+// @Router /superadmin/api-keys [get]
+func (h *routeSuperadmin) GetAPIKeysList(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	query := c.Request.URL.Query()
+
+	response := h.Usecase.GetAPIKeysList(ctx, query)
+	c.JSON(response.Status, response)
+}
+
+// GetAPIKeyDetail
+//
+// @Summary Get API Key Detail
+// @Description Get API Key Detail
+// @Tags API-Key-Superadmin
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param id path string true "API Key ID"
+// @Success 200 {object} helpers.Response
+// @Router /superadmin/api-keys/{id} [get]
+func (h *routeSuperadmin) GetAPIKeyDetail(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	id := c.Param("id")
+
+	response := h.Usecase.GetAPIKeyDetail(ctx, id)
+	c.JSON(response.Status, response)
+}
+
+// CreateAPIKey
+//
+// @Summary Create API Key
+// @Description Create API Key, the plain key is only returned in this response
+// @Tags API-Key-Superadmin
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param payload body request.APIKeyRequest true "Create API Key"
+// @Success 201 {object} helpers.Response
+// @Router /superadmin/api-keys [post]
+func (h *routeSuperadmin) CreateAPIKey(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	claim := c.MustGet("user_data").(jwt_helpers.SuperadminJWTClaims)
+
+	payload := request.APIKeyRequest{}
+	err := c.ShouldBindJSON(&payload)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, helpers.NewResponse(http.StatusBadRequest, "Invalid json data", nil, nil))
+		return
+	}
+
+	response := h.Usecase.CreateAPIKey(ctx, claim, payload)
+	c.JSON(response.Status, response)
+}
+
+// UpdateAPIKey
+//
+// @Summary Update API Key
+// @Description Update name, scopes, rate limit and expiry of an API Key
+// @Tags API-Key-Superadmin
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param id path string true "API Key ID"
+// @Param payload body request.APIKeyRequest true "Update API Key"
+// @Success 200 {object} helpers.Response
+// @Router /superadmin/api-keys/{id} [put]
+func (h *routeSuperadmin) UpdateAPIKey(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	id := c.Param("id")
+
+	payload := request.APIKeyRequest{}
+	err := c.ShouldBindJSON(&payload)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, helpers.NewResponse(http.StatusBadRequest, "Invalid json data", nil, nil))
+		return
+	}
+
+	response := h.Usecase.UpdateAPIKey(ctx, id, payload)
+	c.JSON(response.Status, response)
+}
+
+// RevokeAPIKey
+//
+// @Summary Revoke API Key
+// @Description Revoke API Key, it is rejected from the next request
+// @Tags API-Key-Superadmin
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param id path string true "API Key ID"
+// @Success 200 {object} helpers.Response
+// @Router /superadmin/api-keys/{id}/revoke [post]
+func (h *routeSuperadmin) RevokeAPIKey(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	id := c.Param("id")
+
+	response := h.Usecase.RevokeAPIKey(ctx, id)
+	c.JSON(response.Status, response)
+}
@@ -0,0 +1,64 @@
+package superadmin_http
+
+import (
+	mongo_model "app/domain/model/mongo"
+
+	"github.com/gin-gonic/gin"
+)
+
+func (h *routeSuperadmin) handleAuditLogRoute(prefixPath string) {
+	api := h.Route.Group(prefixPath)
+
+	api.GET("", h.Middleware.AuthSuperadmin(mongo_model.PermissionAuditLogsView), h.GetAuditLogsList)
+	api.GET("/:id", h.Middleware.AuthSuperadmin(mongo_model.PermissionAuditLogsView), h.GetAuditLogDetail)
+}
+
+// GetAuditLogsList
+//
+// @Summary Get Audit Logs
+// @Description Search superadmin and admin mutations, newest first
+// @Tags Audit-Log-Superadmin
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param entityType query string false "Entity type, e.g. ticket, season, admin"
+// @Param entityId query string false "Entity ID"
+// @Param action query string false "Action, e.g. create, update, delete"
+// @Param actorId query string false "Actor user ID"
+// @Param actorRole query string false "Actor role superadmin or admin"
+// @Param startDate query string false "Start date YYYY-MM-DD"
+// @Param endDate query string false "End date YYYY-MM-DD"
+// @Param page query int false "Page"
+// @Param limit query int false "Limit"
+// @Param sort query string false "Sort"
+// @Param dir query string false "Direction asc or desc"
+// @Success 200 {object} helpers.Response
+// @Router /superadmin/audit-logs [get]
+func (h *routeSuperadmin) GetAuditLogsList(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	query := c.Request.URL.Query()
+
+	response := h.Usecase.GetAuditLogsList(ctx, query)
+	c.JSON(response.Status, response)
+}
+
+// GetAuditLogDetail
+//
+// @Summary Get Audit Log Detail
+// @Description Get Audit Log Detail
+// @Tags Audit-Log-Superadmin
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param id path string true "Audit Log ID"
+// @Success 200 {object} helpers.Response
+// @Router /superadmin/audit-logs/{id} [get]
+func (h *routeSuperadmin) GetAuditLogDetail(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	id := c.Param("id")
+
+	response := h.Usecase.GetAuditLogDetail(ctx, id)
+	c.JSON(response.Status, response)
+}
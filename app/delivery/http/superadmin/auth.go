@@ -13,7 +13,17 @@ func (h *routeSuperadmin) handleAuthRoute(prefixPath string) {
 	api := h.Route.Group(prefixPath)
 
 	api.POST("/login", h.Login)
+	api.POST("/refresh-token", h.RefreshToken)
+	api.POST("/logout", h.Middleware.AuthSuperadmin(), h.Logout)
+	api.POST("/logout-all", h.Middleware.AuthSuperadmin(), h.LogoutAllDevices)
 	api.GET("/profile", h.Middleware.AuthSuperadmin(), h.GetProfile)
+
+	api.POST("/2fa/verify", h.VerifyTwoFactor)
+	api.POST("/2fa/setup-challenge", h.SetupTwoFactorChallenge)
+	api.POST("/2fa/setup", h.Middleware.AuthSuperadmin(), h.SetupTwoFactor)
+	api.POST("/2fa/enable", h.Middleware.AuthSuperadmin(), h.EnableTwoFactor)
+	api.POST("/2fa/disable", h.Middleware.AuthSuperadmin(), h.DisableTwoFactor)
+	api.POST("/2fa/recovery-codes", h.Middleware.AuthSuperadmin(), h.RegenerateRecoveryCodes)
 }
 
 // Login
@@ -37,7 +47,7 @@ func (h *routeSuperadmin) Login(c *gin.Context) {
 		return
 	}
 
-	response := h.Usecase.Login(ctx, payload)
+	response := h.Usecase.Login(ctx, payload, c.ClientIP())
 	c.JSON(response.Status, response)
 }
 
@@ -59,3 +69,65 @@ func (h *routeSuperadmin) GetProfile(c *gin.Context) {
 	response := h.Usecase.GetProfile(ctx, claim)
 	c.JSON(response.Status, response)
 }
+
+// RefreshToken
+//
+// @Summary Refresh Token Superadmin
+// @Description Refresh Token Superadmin, the refresh token is rotated on every call
+// @Tags Auth-Superadmin
+// @Accept json
+// @Produce json
+// @Param payload body request.RefreshTokenRequest true "Refresh Token Superadmin"
+// @Success 200 {object} helpers.Response
+// @Router /superadmin/auth/refresh-token [post]
+func (h *routeSuperadmin) RefreshToken(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	payload := request.RefreshTokenRequest{}
+	err := c.ShouldBindJSON(&payload)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, helpers.NewResponse(http.StatusBadRequest, "Invalid json data", nil, nil))
+		return
+	}
+
+	response := h.Usecase.RefreshToken(ctx, payload)
+	c.JSON(response.Status, response)
+}
+
+// Logout
+//
+// @Summary Logout Superadmin
+// @Description Logout Superadmin from current device
+// @Tags Auth-Superadmin
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Success 200 {object} helpers.Response
+// @Router /superadmin/auth/logout [post]
+func (h *routeSuperadmin) Logout(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	claim := c.MustGet("user_data").(jwt_helpers.SuperadminJWTClaims)
+
+	response := h.Usecase.Logout(ctx, claim)
+	c.JSON(response.Status, response)
+}
+
+// LogoutAllDevices
+//
+// @Summary Logout All Devices Superadmin
+// @Description Logout Superadmin from all devices
+// @Tags Auth-Superadmin
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Success 200 {object} helpers.Response
+// @Router /superadmin/auth/logout-all [post]
+func (h *routeSuperadmin) LogoutAllDevices(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	claim := c.MustGet("user_data").(jwt_helpers.SuperadminJWTClaims)
+
+	response := h.Usecase.LogoutAllDevices(ctx, claim)
+	c.JSON(response.Status, response)
+}
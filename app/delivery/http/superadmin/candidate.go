@@ -1,6 +1,7 @@
 package superadmin_http
 
 import (
+	mongo_model "app/domain/model/mongo"
 	"app/domain/request"
 	"app/helpers"
 	"net/http"
@@ -11,11 +12,11 @@ import (
 func (h *routeSuperadmin) handleCandidateRoute(prefixPath string) {
 	api := h.Route.Group(prefixPath)
 
-	api.GET("", h.Middleware.AuthSuperadmin(), h.GetCandidateList)
-	api.GET("/:id", h.Middleware.AuthSuperadmin(), h.GetCandidateDetail)
-	api.POST("", h.Middleware.AuthSuperadmin(), h.CreateCandidate)
-	api.PUT("/:id", h.Middleware.AuthSuperadmin(), h.UpdateCandidate)
-	api.DELETE("/:id", h.Middleware.AuthSuperadmin(), h.DeleteCandidate)
+	api.GET("", h.Middleware.AuthSuperadmin(mongo_model.PermissionVotingsView), h.GetCandidateList)
+	api.GET("/:id", h.Middleware.AuthSuperadmin(mongo_model.PermissionVotingsView), h.GetCandidateDetail)
+	api.POST("", h.Middleware.AuthSuperadmin(mongo_model.PermissionVotingsManage), h.CreateCandidate)
+	api.PUT("/:id", h.Middleware.AuthSuperadmin(mongo_model.PermissionVotingsManage), h.UpdateCandidate)
+	api.DELETE("/:id", h.Middleware.AuthSuperadmin(mongo_model.PermissionVotingsManage), h.DeleteCandidate)
 }
 
 // GetCandidateList
@@ -64,7 +65,7 @@ func (h *routeSuperadmin) GetCandidateDetail(c *gin.Context) {
 // CreateCandidate
 //
 // @Summary Create Candidate
-// @Description Create a new candidate
+// @Description Create a new candidate, without performance it is pulled from the recorded matches of the voting series
 // @Tags Candidate-Superadmin
 // @Security BearerAuth
 // @Accept json
@@ -90,7 +91,7 @@ func (h *routeSuperadmin) CreateCandidate(c *gin.Context) {
 // UpdateCandidate
 //
 // @Summary Update Candidate
-// @Description Update an existing candidate by ID
+// @Description Update an existing candidate by ID, without performance it is pulled from the recorded matches of the voting series
 // @Tags Candidate-Superadmin
 // @Security BearerAuth
 // @Accept json
@@ -1,11 +1,15 @@
 package superadmin_http
 
-import "github.com/gin-gonic/gin"
+import (
+	mongo_model "app/domain/model/mongo"
+
+	"github.com/gin-gonic/gin"
+)
 
 func (h *routeSuperadmin) handleDashboardRoute(prefixPath string) {
 	api := h.Route.Group(prefixPath)
 
-	api.GET("", h.Middleware.AuthSuperadmin(), h.GetDashboard)
+	api.GET("", h.Middleware.AuthSuperadmin(mongo_model.PermissionDashboardView), h.GetDashboard)
 }
 
 // GetDashboard
@@ -21,6 +21,13 @@ func NewSuperadminRouteHandler(usecase domain.SuperadminAppUsecase, ginEngine *g
 	}
 
 	handler.handleAuthRoute("/auth")
+	handler.handleAdminRoute("/admins")
+	handler.handleRoleRoute("/roles")
+	handler.handleSuperadminRoute("/users")
+	handler.handleLoginAttemptRoute("/login-attempts")
+	handler.handleAuditLogRoute("/audit-logs")
+	handler.handleAPIKeyRoute("/api-keys")
+	handler.handleMemberRoute("/members")
 	handler.handleSeasonRoute("/seasons")
 	handler.handleVenueRoute("/venues")
 	handler.handleTeamRoute("/teams")
@@ -29,8 +36,13 @@ func NewSuperadminRouteHandler(usecase domain.SuperadminAppUsecase, ginEngine *g
 	handler.handleSeasonTeamPlayerRoute("/season-team-players")
 	handler.handleSeriesRoute("/series")
 	handler.handleTicketRoute("/tickets")
+	handler.handleMatchRoute("/matches")
+	handler.handleStandingRoute("/standings")
 	handler.handleVotingRoute("/votings")
 	handler.handleCandidateRoute("/candidates")
 	handler.handlePurchaseRoute("/purchases")
+	handler.handleTicketPurchaseRoute("/ticket-purchases")
+	handler.handleTicketCancellationJobRoute("/ticket-cancellation-jobs")
+	handler.handleRefundRoute("/refunds")
 	handler.handleDashboardRoute("/dashboard")
 }
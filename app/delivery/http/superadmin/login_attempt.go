@@ -0,0 +1,64 @@
+package superadmin_http
+
+import (
+	mongo_model "app/domain/model/mongo"
+	jwt_helpers "app/helpers/jwt"
+
+	"github.com/gin-gonic/gin"
+)
+
+func (h *routeSuperadmin) handleLoginAttemptRoute(prefixPath string) {
+	api := h.Route.Group(prefixPath)
+
+	api.GET("", h.Middleware.AuthSuperadmin(mongo_model.PermissionLoginAttemptsView), h.GetLoginAttemptsList)
+	api.POST("/:id/unlock", h.Middleware.AuthSuperadmin(mongo_model.PermissionLoginAttemptsManage), h.UnlockLoginAttempt)
+}
+
+// GetLoginAttemptsList
+//
+// @Summary Get Login Attempts
+// @Description Get failed login counters per account and ip
+// @Tags Login-Attempt-Superadmin
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param search query string false "Search by email, username or ip"
+// @Param role query string false "Role superadmin, admin or member"
+// @Param type query string false "Type account or ip"
+// @Param isLocked query bool false "Only currently locked"
+// @Param page query int false "Page"
+// @Param limit query int false "Limit"
+// @Param sort query string false "Sort"
+// @Param dir query string false "Direction asc or desc"
+// @Success 200 {object} helpers.Response
+// @Router /superadmin/login-attempts [get]
+func (h *routeSuperadmin) GetLoginAttemptsList(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	query := c.Request.URL.Query()
+
+	response := h.Usecase.GetLoginAttemptsList(ctx, query)
+	c.JSON(response.Status, response)
+}
+
+// UnlockLoginAttempt
+//
+// @Summary Unlock Login
+// @Description Clear the backoff and lockout of an account or ip
+// @Tags Login-Attempt-Superadmin
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param id path string true "Login Attempt ID"
+// @Success 200 {object} helpers.Response
+// @Router /superadmin/login-attempts/{id}/unlock [post]
+func (h *routeSuperadmin) UnlockLoginAttempt(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	claim := c.MustGet("user_data").(jwt_helpers.SuperadminJWTClaims)
+
+	id := c.Param("id")
+
+	response := h.Usecase.UnlockLoginAttempt(ctx, claim, id)
+	c.JSON(response.Status, response)
+}
@@ -0,0 +1,164 @@
+package superadmin_http
+
+import (
+	mongo_model "app/domain/model/mongo"
+	"app/domain/request"
+	"app/helpers"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+func (h *routeSuperadmin) handleMatchRoute(prefixPath string) {
+	api := h.Route.Group(prefixPath)
+
+	api.GET("", h.Middleware.AuthSuperadmin(mongo_model.PermissionMatchesView), h.GetMatchesList)
+	api.GET("/:id", h.Middleware.AuthSuperadmin(mongo_model.PermissionMatchesView), h.GetMatchDetail)
+	api.GET("/:id/events", h.Middleware.AuthSuperadmin(mongo_model.PermissionMatchesView), h.GetMatchEventsList)
+	api.POST("", h.Middleware.AuthSuperadmin(mongo_model.PermissionMatchesManage), h.CreateMatch)
+	api.PUT("/:id", h.Middleware.AuthSuperadmin(mongo_model.PermissionMatchesManage), h.UpdateMatch)
+	api.DELETE("/:id", h.Middleware.AuthSuperadmin(mongo_model.PermissionMatchesManage), h.DeleteMatch)
+}
+
+// GetMatchesList
+//
+// @Summary Get Matches List
+// @Description Get Matches List
+// @Tags Match-Superadmin
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param seasonId query string false "Season ID"
+// @Param seriesId query string false "Series ID"
+// @Param ticketId query string false "Ticket ID"
+// @Param seasonTeamId query string false "Season Team ID, home or away"
+// @Param status query string false "Status scheduled, live, finished or postponed"
+// @Param startDate query string false "Kickoff start date YYYY-MM-DD"
+// @Param endDate query string false "Kickoff end date YYYY-MM-DD"
+// @Param page query int false "Page"
+// @Param limit query int false "Limit"
+// @Param sort query string false "Sort"
+// @Param dir query string false "Direction asc or desc"
+// @Success 200 {object} helpers.Response
+// @Router /superadmin/matches [get]
+func (h *routeSuperadmin) GetMatchesList(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	query := c.Request.URL.Query()
+
+	response := h.Usecase.GetMatchesList(ctx, query)
+	c.JSON(response.Status, response)
+}
+
+// GetMatchDetail
+//
+// @Summary Get Match Detail
+// @Description Get Match Detail
+// @Tags Match-Superadmin
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param id path string true "Match ID"
+// @Success 200 {object} helpers.Response
+// @Router /superadmin/matches/{id} [get]
+func (h *routeSuperadmin) GetMatchDetail(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	id := c.Param("id")
+
+	response := h.Usecase.GetMatchDetail(ctx, id)
+	c.JSON(response.Status, response)
+}
+
+// GetMatchEventsList
+//
+// @Summary Get Match Events List
+// @Description Get recorded events of a match ordered by minute
+// @Tags Match-Superadmin
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param id path string true "Match ID"
+// @Success 200 {object} helpers.Response
+// @Router /superadmin/matches/{id}/events [get]
+func (h *routeSuperadmin) GetMatchEventsList(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	id := c.Param("id")
+
+	response := h.Usecase.GetMatchEventsList(ctx, id)
+	c.JSON(response.Status, response)
+}
+
+// CreateMatch
+//
+// @Summary Create Match
+// @Description Create Match, a match with ticket id is added to the ticket day
+// @Tags Match-Superadmin
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param payload body request.MatchRequest true "Create Match"
+// @Success 201 {object} helpers.Response
+// @Router /superadmin/matches [post]
+func (h *routeSuperadmin) CreateMatch(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	payload := request.MatchRequest{}
+	err := c.ShouldBindJSON(&payload)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, helpers.NewResponse(http.StatusBadRequest, "Invalid json data", nil, nil))
+		return
+	}
+
+	response := h.Usecase.CreateMatch(ctx, payload)
+	c.JSON(response.Status, response)
+}
+
+// UpdateMatch
+//
+// @Summary Update Match
+// @Description Update teams, kickoff, status and score of a Match, the ticket day is kept
+// @Tags Match-Superadmin
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param id path string true "Match ID"
+// @Param payload body request.MatchRequest true "Update Match"
+// @Success 200 {object} helpers.Response
+// @Router /superadmin/matches/{id} [put]
+func (h *routeSuperadmin) UpdateMatch(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	id := c.Param("id")
+
+	payload := request.MatchRequest{}
+	err := c.ShouldBindJSON(&payload)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, helpers.NewResponse(http.StatusBadRequest, "Invalid json data", nil, nil))
+		return
+	}
+
+	response := h.Usecase.UpdateMatch(ctx, id, payload)
+	c.JSON(response.Status, response)
+}
+
+// DeleteMatch
+//
+// @Summary Delete Match
+// @Description Delete Match, it is removed from its ticket day
+// @Tags Match-Superadmin
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param id path string true "Match ID"
+// @Success 200 {object} helpers.Response
+// @Router /superadmin/matches/{id} [delete]
+func (h *routeSuperadmin) DeleteMatch(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	id := c.Param("id")
+
+	response := h.Usecase.DeleteMatch(ctx, id)
+	c.JSON(response.Status, response)
+}
@@ -0,0 +1,47 @@
+package superadmin_http
+
+import (
+	mongo_model "app/domain/model/mongo"
+	"app/domain/request"
+	"app/helpers"
+	jwt_helpers "app/helpers/jwt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+func (h *routeSuperadmin) handleMemberRoute(prefixPath string) {
+	api := h.Route.Group(prefixPath)
+
+	api.POST("/:id/impersonate", h.Middleware.AuthSuperadmin(mongo_model.PermissionMembersImpersonate), h.ImpersonateMember)
+}
+
+// ImpersonateMember
+//
+// @Summary Impersonate Member
+// @Description Issue a short lived read only member token to see the member app as the member
+// @Tags Member-Superadmin
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param id path string true "Member ID"
+// @Param payload body request.MemberImpersonateRequest true "Impersonate Member"
+// @Success 200 {object} helpers.Response
+// @Router /superadmin/members/{id}/impersonate [post]
+func (h *routeSuperadmin) ImpersonateMember(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	claim := c.MustGet("user_data").(jwt_helpers.SuperadminJWTClaims)
+
+	id := c.Param("id")
+
+	payload := request.MemberImpersonateRequest{}
+	err := c.ShouldBindJSON(&payload)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, helpers.NewResponse(http.StatusBadRequest, "Invalid json data", nil, nil))
+		return
+	}
+
+	response := h.Usecase.ImpersonateMember(ctx, claim, id, payload)
+	c.JSON(response.Status, response)
+}
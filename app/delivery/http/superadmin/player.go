@@ -1,6 +1,7 @@
 package superadmin_http
 
 import (
+	mongo_model "app/domain/model/mongo"
 	"app/domain/request"
 	"app/helpers"
 	"net/http"
@@ -11,11 +12,11 @@ import (
 func (h *routeSuperadmin) handlePlayerRoute(prefixPath string) {
 	api := h.Route.Group(prefixPath)
 
-	api.GET("", h.Middleware.AuthSuperadmin(), h.GetPlayersList)
-	api.GET("/:id", h.Middleware.AuthSuperadmin(), h.GetPlayerDetail)
-	api.POST("", h.Middleware.AuthSuperadmin(), h.CreatePlayer)
-	api.PUT("/:id", h.Middleware.AuthSuperadmin(), h.UpdatePlayer)
-	api.DELETE("/:id", h.Middleware.AuthSuperadmin(), h.DeletePlayer)
+	api.GET("", h.Middleware.AuthSuperadmin(mongo_model.PermissionPlayersView), h.GetPlayersList)
+	api.GET("/:id", h.Middleware.AuthSuperadmin(mongo_model.PermissionPlayersView), h.GetPlayerDetail)
+	api.POST("", h.Middleware.AuthSuperadmin(mongo_model.PermissionPlayersManage), h.CreatePlayer)
+	api.PUT("/:id", h.Middleware.AuthSuperadmin(mongo_model.PermissionPlayersManage), h.UpdatePlayer)
+	api.DELETE("/:id", h.Middleware.AuthSuperadmin(mongo_model.PermissionPlayersManage), h.DeletePlayer)
 }
 
 // GetPlayersList
@@ -1,11 +1,15 @@
 package superadmin_http
 
-import "github.com/gin-gonic/gin"
+import (
+	mongo_model "app/domain/model/mongo"
+
+	"github.com/gin-gonic/gin"
+)
 
 func (h *routeSuperadmin) handlePurchaseRoute(prefixPath string) {
 	api := h.Route.Group(prefixPath)
 
-	api.GET("", h.Middleware.AuthSuperadmin(), h.GetPurchasesList)
+	api.GET("", h.Middleware.AuthSuperadmin(mongo_model.PermissionPurchasesView), h.GetPurchasesList)
 }
 
 // GetPurchasesList
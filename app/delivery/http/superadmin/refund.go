@@ -0,0 +1,76 @@
+package superadmin_http
+
+import (
+	mongo_model "app/domain/model/mongo"
+	"app/domain/request"
+	"app/helpers"
+	jwt_helpers "app/helpers/jwt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+func (h *routeSuperadmin) handleRefundRoute(prefixPath string) {
+	api := h.Route.Group(prefixPath)
+
+	api.GET("", h.Middleware.AuthSuperadmin(mongo_model.PermissionRefundsView), h.GetRefundsList)
+	api.PUT("/:id/status", h.Middleware.AuthSuperadmin(mongo_model.PermissionRefundsManage), h.UpdateRefundStatus)
+}
+
+// GetRefundsList
+//
+// @Summary Get Refunds
+// @Description Get Refunds
+// @Tags Refund-Superadmin
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param purchaseId query string false "Purchase ID"
+// @Param ticketId query string false "Ticket ID"
+// @Param memberId query string false "Member ID"
+// @Param jobId query string false "Ticket Cancellation Job ID"
+// @Param reason query string false "Reason"
+// @Param status query int false "Status 1 pending, 2 processed, 3 rejected"
+// @Param page query int false "Page"
+// @Param limit query int false "Limit"
+// @Param sort query string false "Sort"
+// @Param dir query string false "Direction asc or desc"
+// @Success 200 {object} helpers.Response
+// @Router /superadmin/refunds [get]
+func (h *routeSuperadmin) GetRefundsList(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	query := c.Request.URL.Query()
+
+	response := h.Usecase.GetRefundsList(ctx, query)
+	c.JSON(response.Status, response)
+}
+
+// UpdateRefundStatus
+//
+// @Summary Update Refund Status
+// @Description Mark pending refund as processed or rejected
+// @Tags Refund-Superadmin
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param id path string true "Refund ID"
+// @Param payload body request.RefundStatusUpdateRequest true "Update Refund Status"
+// @Success 200 {object} helpers.Response
+// @Router /superadmin/refunds/{id}/status [put]
+func (h *routeSuperadmin) UpdateRefundStatus(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	payload := request.RefundStatusUpdateRequest{}
+	err := c.ShouldBindJSON(&payload)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, helpers.NewResponse(http.StatusBadRequest, "Invalid json data", nil, nil))
+		return
+	}
+
+	claim := c.MustGet("user_data").(jwt_helpers.SuperadminJWTClaims)
+	id := c.Param("id")
+
+	response := h.Usecase.UpdateRefundStatus(ctx, claim, id, payload)
+	c.JSON(response.Status, response)
+}
@@ -0,0 +1,160 @@
+package superadmin_http
+
+import (
+	mongo_model "app/domain/model/mongo"
+	"app/domain/request"
+	"app/helpers"
+	jwt_helpers "app/helpers/jwt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+func (h *routeSuperadmin) handleRoleRoute(prefixPath string) {
+	api := h.Route.Group(prefixPath)
+
+	api.GET("/permissions", h.Middleware.AuthSuperadmin(mongo_model.PermissionRolesManage), h.GetPermissionsList)
+	api.GET("", h.Middleware.AuthSuperadmin(mongo_model.PermissionRolesManage, mongo_model.PermissionUsersManage), h.GetRolesList)
+	api.GET("/:id", h.Middleware.AuthSuperadmin(mongo_model.PermissionRolesManage), h.GetRoleDetail)
+	api.POST("", h.Middleware.AuthSuperadmin(mongo_model.PermissionRolesManage), h.CreateRole)
+	api.PUT("/:id", h.Middleware.AuthSuperadmin(mongo_model.PermissionRolesManage), h.UpdateRole)
+	api.DELETE("/:id", h.Middleware.AuthSuperadmin(mongo_model.PermissionRolesManage), h.DeleteRole)
+}
+
+// GetPermissionsList
+//
+// @Summary Get Permissions List
+// @Description Get every permission a role can be given
+// @Tags Role-Superadmin
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Success 200 {object} helpers.Response
+// @Router /superadmin/roles/permissions [get]
+func (h *routeSuperadmin) GetPermissionsList(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	response := h.Usecase.GetPermissionsList(ctx)
+	c.JSON(response.Status, response)
+}
+
+// GetRolesList
+//
+// @Summary Get Roles List
+// @Description Get Roles List
+// @Tags Role-Superadmin
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param search query string false "Search by name"
+// @Param page query int false "Page"
+// @Param limit query int false "Limit"
+// @Param sort query string false "Sort"
+// @Param dir query string false "Direction asc or desc"
+// @Success 200 {object} helpers.Response
+// @Router /superadmin/roles [get]
+func (h *routeSuperadmin) GetRolesList(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	query := c.Request.URL.Query()
+
+	response := h.Usecase.GetRolesList(ctx, query)
+	c.JSON(response.Status, response)
+}
+
+// GetRoleDetail
+//
+// @Summary Get Role Detail
+// @Description Get Role Detail
+// @Tags Role-Superadmin
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param id path string true "Role ID"
+// @Success 200 {object} helpers.Response
+// @Router /superadmin/roles/{id} [get]
+func (h *routeSuperadmin) GetRoleDetail(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	id := c.Param("id")
+
+	response := h.Usecase.GetRoleDetail(ctx, id)
+	c.JSON(response.Status, response)
+}
+
+// CreateRole
+//
+// @Summary Create Role
+// @Description Create Role
+// @Tags Role-Superadmin
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param payload body request.RoleRequest true "Create Role"
+// @Success 201 {object} helpers.Response
+// @Router /superadmin/roles [post]
+func (h *routeSuperadmin) CreateRole(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	claim := c.MustGet("user_data").(jwt_helpers.SuperadminJWTClaims)
+
+	payload := request.RoleRequest{}
+	err := c.ShouldBindJSON(&payload)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, helpers.NewResponse(http.StatusBadRequest, "Invalid json data", nil, nil))
+		return
+	}
+
+	response := h.Usecase.CreateRole(ctx, claim, payload)
+	c.JSON(response.Status, response)
+}
+
+// UpdateRole
+//
+// @Summary Update Role
+// @Description Update Role, the sessions of its users are ended so they log in again with the new permissions
+// @Tags Role-Superadmin
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param id path string true "Role ID"
+// @Param payload body request.RoleRequest true "Update Role"
+// @Success 200 {object} helpers.Response
+// @Router /superadmin/roles/{id} [put]
+func (h *routeSuperadmin) UpdateRole(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	claim := c.MustGet("user_data").(jwt_helpers.SuperadminJWTClaims)
+
+	id := c.Param("id")
+
+	payload := request.RoleRequest{}
+	err := c.ShouldBindJSON(&payload)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, helpers.NewResponse(http.StatusBadRequest, "Invalid json data", nil, nil))
+		return
+	}
+
+	response := h.Usecase.UpdateRole(ctx, claim, id, payload)
+	c.JSON(response.Status, response)
+}
+
+// DeleteRole
+//
+// @Summary Delete Role
+// @Description Delete Role, only when no user has it
+// @Tags Role-Superadmin
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param id path string true "Role ID"
+// @Success 200 {object} helpers.Response
+// @Router /superadmin/roles/{id} [delete]
+func (h *routeSuperadmin) DeleteRole(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	id := c.Param("id")
+
+	response := h.Usecase.DeleteRole(ctx, id)
+	c.JSON(response.Status, response)
+}
@@ -1,6 +1,7 @@
 package superadmin_http
 
 import (
+	mongo_model "app/domain/model/mongo"
 	"app/domain/request"
 	"app/helpers"
 	"net/http"
@@ -11,13 +12,15 @@ import (
 func (h *routeSuperadmin) handleSeasonRoute(prefixPath string) {
 	api := h.Route.Group(prefixPath)
 
-	api.GET("", h.Middleware.AuthSuperadmin(), h.GetSeasonsList)
-	api.GET("/:id", h.Middleware.AuthSuperadmin(), h.GetSeasonDetail)
-	api.GET("/active", h.Middleware.AuthSuperadmin(), h.GetActiveSeasonDetail)
-	api.POST("", h.Middleware.AuthSuperadmin(), h.CreateSeason)
-	api.PUT("/:id", h.Middleware.AuthSuperadmin(), h.UpdateSeason)
-	api.DELETE("/:id", h.Middleware.AuthSuperadmin(), h.DeleteSeason)
-	api.PUT("/:id/status", h.Middleware.AuthSuperadmin(), h.UpdateSeasonStatus)
+	api.GET("", h.Middleware.AuthSuperadmin(mongo_model.PermissionSeasonsView), h.GetSeasonsList)
+	api.GET("/:id", h.Middleware.AuthSuperadmin(mongo_model.PermissionSeasonsView), h.GetSeasonDetail)
+	api.GET("/active", h.Middleware.AuthSuperadmin(mongo_model.PermissionSeasonsView), h.GetActiveSeasonDetail)
+	api.POST("", h.Middleware.AuthSuperadmin(mongo_model.PermissionSeasonsManage), h.CreateSeason)
+	api.PUT("/:id", h.Middleware.AuthSuperadmin(mongo_model.PermissionSeasonsManage), h.UpdateSeason)
+	api.DELETE("/:id", h.Middleware.AuthSuperadmin(mongo_model.PermissionSeasonsManage), h.DeleteSeason)
+	api.PUT("/:id/status", h.Middleware.AuthSuperadmin(mongo_model.PermissionSeasonsManage), h.UpdateSeasonStatus)
+	api.PUT("/:id/pass", h.Middleware.AuthSuperadmin(mongo_model.PermissionSeasonsManage), h.UpdateSeasonPass)
+	api.PUT("/:id/standing-rule", h.Middleware.AuthSuperadmin(mongo_model.PermissionSeasonsManage), h.UpdateSeasonStandingRule)
 }
 
 // GetSeasonsList
@@ -185,3 +188,59 @@ func (h *routeSuperadmin) UpdateSeasonStatus(c *gin.Context) {
 	response := h.Usecase.UpdateSeasonStatus(ctx, id, payload)
 	c.JSON(response.Status, response)
 }
+
+// UpdateSeasonPass
+//
+// @Summary Update Season Pass
+// @Description Update Season Pass price, stock and availability
+// @Tags Season-Superadmin
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param id path string true "Season ID"
+// @Param payload body request.SeasonPassUpdateRequest true "Update Season Pass"
+// @Success 200 {object} helpers.Response
+// @Router /superadmin/seasons/{id}/pass [put]
+func (h *routeSuperadmin) UpdateSeasonPass(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	payload := request.SeasonPassUpdateRequest{}
+	err := c.ShouldBindJSON(&payload)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, helpers.NewResponse(http.StatusBadRequest, "Invalid json data", nil, nil))
+		return
+	}
+
+	id := c.Param("id")
+
+	response := h.Usecase.UpdateSeasonPass(ctx, id, payload)
+	c.JSON(response.Status, response)
+}
+
+// UpdateSeasonStandingRule
+//
+// @Summary Update Season Standing Rule
+// @Description Update points and tie breakers of the season standings, the standings are computed again
+// @Tags Season-Superadmin
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param id path string true "Season ID"
+// @Param payload body request.StandingRuleRequest true "Update Season Standing Rule"
+// @Success 200 {object} helpers.Response
+// @Router /superadmin/seasons/{id}/standing-rule [put]
+func (h *routeSuperadmin) UpdateSeasonStandingRule(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	payload := request.StandingRuleRequest{}
+	err := c.ShouldBindJSON(&payload)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, helpers.NewResponse(http.StatusBadRequest, "Invalid json data", nil, nil))
+		return
+	}
+
+	id := c.Param("id")
+
+	response := h.Usecase.UpdateSeasonStandingRule(ctx, id, payload)
+	c.JSON(response.Status, response)
+}
@@ -1,6 +1,7 @@
 package superadmin_http
 
 import (
+	mongo_model "app/domain/model/mongo"
 	"app/domain/request"
 	"app/helpers"
 	"net/http"
@@ -11,11 +12,12 @@ import (
 func (h *routeSuperadmin) handleSeasonTeamRoute(prefixPath string) {
 	api := h.Route.Group(prefixPath)
 
-	api.GET("", h.Middleware.AuthSuperadmin(), h.GetSeasonTeamsList)
-	api.GET("/:id", h.Middleware.AuthSuperadmin(), h.GetSeasonTeamDetail)
-	api.POST("", h.Middleware.AuthSuperadmin(), h.CreateSeasonTeam)
-	api.DELETE("/:id", h.Middleware.AuthSuperadmin(), h.DeleteSeasonTeam)
-	api.POST("/manage", h.Middleware.AuthSuperadmin(), h.ManageSeasonTeam)
+	api.GET("", h.Middleware.AuthSuperadmin(mongo_model.PermissionTeamsView), h.GetSeasonTeamsList)
+	api.GET("/:id", h.Middleware.AuthSuperadmin(mongo_model.PermissionTeamsView), h.GetSeasonTeamDetail)
+	api.POST("", h.Middleware.AuthSuperadmin(mongo_model.PermissionTeamsManage), h.CreateSeasonTeam)
+	api.PUT("/:id/group", h.Middleware.AuthSuperadmin(mongo_model.PermissionTeamsManage), h.UpdateSeasonTeamGroup)
+	api.DELETE("/:id", h.Middleware.AuthSuperadmin(mongo_model.PermissionTeamsManage), h.DeleteSeasonTeam)
+	api.POST("/manage", h.Middleware.AuthSuperadmin(mongo_model.PermissionTeamsManage), h.ManageSeasonTeam)
 }
 
 // GetSeasonTeamsList
@@ -63,6 +65,34 @@ func (h *routeSuperadmin) GetSeasonTeamDetail(c *gin.Context) {
 	c.JSON(response.Status, response)
 }
 
+// UpdateSeasonTeamGroup
+//
+// @Summary Update Season Team Group
+// @Description Put a season team in a group, each group gets its own standings
+// @Tags SeasonTeam-Superadmin
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param id path string true "Season Team ID"
+// @Param payload body request.SeasonTeamGroupUpdateRequest true "Update Season Team Group"
+// @Success 200 {object} helpers.Response
+// @Router /superadmin/season-teams/{id}/group [put]
+func (h *routeSuperadmin) UpdateSeasonTeamGroup(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	payload := request.SeasonTeamGroupUpdateRequest{}
+	err := c.ShouldBindJSON(&payload)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, helpers.NewResponse(http.StatusBadRequest, "Invalid json data", nil, nil))
+		return
+	}
+
+	id := c.Param("id")
+
+	response := h.Usecase.UpdateSeasonTeamGroup(ctx, id, payload)
+	c.JSON(response.Status, response)
+}
+
 // CreateSeasonTeam
 //
 // @Summary Create Season Team
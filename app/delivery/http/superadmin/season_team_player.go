@@ -1,6 +1,7 @@
 package superadmin_http
 
 import (
+	mongo_model "app/domain/model/mongo"
 	"app/domain/request"
 	"app/helpers"
 	"net/http"
@@ -11,12 +12,12 @@ import (
 func (h *routeSuperadmin) handleSeasonTeamPlayerRoute(prefixPath string) {
 	api := h.Route.Group(prefixPath)
 
-	api.GET("", h.Middleware.AuthSuperadmin(), h.GetSeasonTeamPlayersList)
-	api.GET("/position-list", h.Middleware.AuthSuperadmin(), h.GetSeasonTeamPlayersPositionList)
-	api.GET("/:id", h.Middleware.AuthSuperadmin(), h.GetSeasonTeamPlayerDetail)
-	api.POST("", h.Middleware.AuthSuperadmin(), h.CreateSeasonTeamPlayer)
-	api.PUT("/:id", h.Middleware.AuthSuperadmin(), h.UpdateSeasonTeamPlayer)
-	api.DELETE("/:id", h.Middleware.AuthSuperadmin(), h.DeleteSeasonTeamPlayer)
+	api.GET("", h.Middleware.AuthSuperadmin(mongo_model.PermissionPlayersView), h.GetSeasonTeamPlayersList)
+	api.GET("/position-list", h.Middleware.AuthSuperadmin(mongo_model.PermissionPlayersView), h.GetSeasonTeamPlayersPositionList)
+	api.GET("/:id", h.Middleware.AuthSuperadmin(mongo_model.PermissionPlayersView), h.GetSeasonTeamPlayerDetail)
+	api.POST("", h.Middleware.AuthSuperadmin(mongo_model.PermissionPlayersManage), h.CreateSeasonTeamPlayer)
+	api.PUT("/:id", h.Middleware.AuthSuperadmin(mongo_model.PermissionPlayersManage), h.UpdateSeasonTeamPlayer)
+	api.DELETE("/:id", h.Middleware.AuthSuperadmin(mongo_model.PermissionPlayersManage), h.DeleteSeasonTeamPlayer)
 }
 
 // GetSeasonTeamPlayersList
@@ -1,6 +1,7 @@
 package superadmin_http
 
 import (
+	mongo_model "app/domain/model/mongo"
 	"app/domain/request"
 	"app/helpers"
 	"net/http"
@@ -11,11 +12,11 @@ import (
 func (h *routeSuperadmin) handleSeriesRoute(prefixPath string) {
 	api := h.Route.Group(prefixPath)
 
-	api.GET("", h.Middleware.AuthSuperadmin(), h.GetSeriesList)
-	api.GET("/:id", h.Middleware.AuthSuperadmin(), h.GetSeriesDetail)
-	api.POST("", h.Middleware.AuthSuperadmin(), h.CreateSeries)
-	api.PUT("/:id", h.Middleware.AuthSuperadmin(), h.UpdateSeries)
-	api.DELETE("/:id", h.Middleware.AuthSuperadmin(), h.DeleteSeries)
+	api.GET("", h.Middleware.AuthSuperadmin(mongo_model.PermissionSeriesView), h.GetSeriesList)
+	api.GET("/:id", h.Middleware.AuthSuperadmin(mongo_model.PermissionSeriesView), h.GetSeriesDetail)
+	api.POST("", h.Middleware.AuthSuperadmin(mongo_model.PermissionSeriesManage), h.CreateSeries)
+	api.PUT("/:id", h.Middleware.AuthSuperadmin(mongo_model.PermissionSeriesManage), h.UpdateSeries)
+	api.DELETE("/:id", h.Middleware.AuthSuperadmin(mongo_model.PermissionSeriesManage), h.DeleteSeries)
 }
 
 // GetSeriesList
@@ -0,0 +1,64 @@
+package superadmin_http
+
+import (
+	mongo_model "app/domain/model/mongo"
+	"app/domain/request"
+	"app/helpers"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+func (h *routeSuperadmin) handleStandingRoute(prefixPath string) {
+	api := h.Route.Group(prefixPath)
+
+	api.GET("", h.Middleware.AuthSuperadmin(mongo_model.PermissionMatchesView), h.GetStandingsList)
+	api.POST("/recompute", h.Middleware.AuthSuperadmin(mongo_model.PermissionMatchesManage), h.RecomputeStandings)
+}
+
+// GetStandingsList
+//
+// @Summary Get Standings List
+// @Description Get standings of a season, the table of the whole season has an empty series id and group
+// @Tags Standing-Superadmin
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param seasonId query string true "Season ID"
+// @Param seriesId query string false "Series ID, empty for the whole season"
+// @Param group query string false "Group, empty for every group"
+// @Success 200 {object} helpers.Response
+// @Router /superadmin/standings [get]
+func (h *routeSuperadmin) GetStandingsList(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	query := c.Request.URL.Query()
+
+	response := h.Usecase.GetStandingsList(ctx, query)
+	c.JSON(response.Status, response)
+}
+
+// RecomputeStandings
+//
+// @Summary Recompute Standings
+// @Description Compute the standings of a season again from its finished matches
+// @Tags Standing-Superadmin
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param payload body request.StandingRecomputeRequest true "Recompute Standings"
+// @Success 200 {object} helpers.Response
+// @Router /superadmin/standings/recompute [post]
+func (h *routeSuperadmin) RecomputeStandings(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	payload := request.StandingRecomputeRequest{}
+	err := c.ShouldBindJSON(&payload)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, helpers.NewResponse(http.StatusBadRequest, "Invalid json data", nil, nil))
+		return
+	}
+
+	response := h.Usecase.RecomputeStandings(ctx, payload)
+	c.JSON(response.Status, response)
+}
@@ -0,0 +1,74 @@
+package superadmin_http
+
+import (
+	mongo_model "app/domain/model/mongo"
+	"app/domain/request"
+	"app/helpers"
+	jwt_helpers "app/helpers/jwt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+func (h *routeSuperadmin) handleSuperadminRoute(prefixPath string) {
+	api := h.Route.Group(prefixPath)
+
+	api.GET("", h.Middleware.AuthSuperadmin(mongo_model.PermissionUsersManage), h.GetSuperadminsList)
+	api.PUT("/:id/role", h.Middleware.AuthSuperadmin(mongo_model.PermissionUsersManage), h.UpdateSuperadminRole)
+}
+
+// GetSuperadminsList
+//
+// @Summary Get Back Office Users List
+// @Description Get Back Office Users List
+// @Tags User-Superadmin
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param search query string false "Search by name or email"
+// @Param roleId query string false "Filter by role ID"
+// @Param isOwner query bool false "Filter users without role"
+// @Param page query int false "Page"
+// @Param limit query int false "Limit"
+// @Param sort query string false "Sort"
+// @Param dir query string false "Direction asc or desc"
+// @Success 200 {object} helpers.Response
+// @Router /superadmin/users [get]
+func (h *routeSuperadmin) GetSuperadminsList(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	query := c.Request.URL.Query()
+
+	response := h.Usecase.GetSuperadminsList(ctx, query)
+	c.JSON(response.Status, response)
+}
+
+// UpdateSuperadminRole
+//
+// @Summary Update Back Office User Role
+// @Description Assign a role, an empty role id makes the user an owner, the user is logged out
+// @Tags User-Superadmin
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param id path string true "User ID"
+// @Param payload body request.SuperadminRoleUpdateRequest true "Update Back Office User Role"
+// @Success 200 {object} helpers.Response
+// @Router /superadmin/users/{id}/role [put]
+func (h *routeSuperadmin) UpdateSuperadminRole(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	claim := c.MustGet("user_data").(jwt_helpers.SuperadminJWTClaims)
+
+	id := c.Param("id")
+
+	payload := request.SuperadminRoleUpdateRequest{}
+	err := c.ShouldBindJSON(&payload)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, helpers.NewResponse(http.StatusBadRequest, "Invalid json data", nil, nil))
+		return
+	}
+
+	response := h.Usecase.UpdateSuperadminRole(ctx, claim, id, payload)
+	c.JSON(response.Status, response)
+}
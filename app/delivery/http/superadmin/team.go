@@ -1,6 +1,7 @@
 package superadmin_http
 
 import (
+	mongo_model "app/domain/model/mongo"
 	"app/domain/request"
 	"app/helpers"
 	"net/http"
@@ -11,11 +12,11 @@ import (
 func (h *routeSuperadmin) handleTeamRoute(prefixPath string) {
 	api := h.Route.Group(prefixPath)
 
-	api.GET("", h.Middleware.AuthSuperadmin(), h.GetTeamsList)
-	api.GET("/:id", h.Middleware.AuthSuperadmin(), h.GetTeamDetail)
-	api.POST("", h.Middleware.AuthSuperadmin(), h.CreateTeam)
-	api.PUT("/:id", h.Middleware.AuthSuperadmin(), h.UpdateTeam)
-	api.DELETE("/:id", h.Middleware.AuthSuperadmin(), h.DeleteTeam)
+	api.GET("", h.Middleware.AuthSuperadmin(mongo_model.PermissionTeamsView), h.GetTeamsList)
+	api.GET("/:id", h.Middleware.AuthSuperadmin(mongo_model.PermissionTeamsView), h.GetTeamDetail)
+	api.POST("", h.Middleware.AuthSuperadmin(mongo_model.PermissionTeamsManage), h.CreateTeam)
+	api.PUT("/:id", h.Middleware.AuthSuperadmin(mongo_model.PermissionTeamsManage), h.UpdateTeam)
+	api.DELETE("/:id", h.Middleware.AuthSuperadmin(mongo_model.PermissionTeamsManage), h.DeleteTeam)
 }
 
 // GetTeamsList
@@ -1,8 +1,10 @@
 package superadmin_http
 
 import (
+	mongo_model "app/domain/model/mongo"
 	"app/domain/request"
 	"app/helpers"
+	jwt_helpers "app/helpers/jwt"
 	"net/http"
 
 	"github.com/gin-gonic/gin"
@@ -11,10 +13,12 @@ import (
 func (h *routeSuperadmin) handleTicketRoute(route string) {
 	api := h.Route.Group(route)
 
-	api.GET("", h.Middleware.AuthSuperadmin(), h.GetTicketsList)
-	api.GET("/:id", h.Middleware.AuthSuperadmin(), h.GetTicketDetail)
-	api.POST("", h.Middleware.AuthSuperadmin(), h.CreateOrUpdateTicket)
-	api.DELETE("/:id", h.Middleware.AuthSuperadmin(), h.DeleteTicket)
+	api.GET("", h.Middleware.AuthSuperadmin(mongo_model.PermissionTicketsView), h.GetTicketsList)
+	api.GET("/:id", h.Middleware.AuthSuperadmin(mongo_model.PermissionTicketsView), h.GetTicketDetail)
+	api.POST("", h.Middleware.AuthSuperadmin(mongo_model.PermissionTicketsManage), h.CreateOrUpdateTicket)
+	api.DELETE("/:id", h.Middleware.AuthSuperadmin(mongo_model.PermissionTicketsManage), h.DeleteTicket)
+	api.POST("/:id/cancel", h.Middleware.AuthSuperadmin(mongo_model.PermissionTicketsManage), h.CancelTicket)
+	api.POST("/:id/reschedule", h.Middleware.AuthSuperadmin(mongo_model.PermissionTicketsManage), h.RescheduleTicket)
 }
 
 // GetTicketsList
@@ -105,3 +109,61 @@ func (h *routeSuperadmin) DeleteTicket(c *gin.Context) {
 	response := h.Usecase.DeleteTicket(ctx, id)
 	c.JSON(response.Status, response)
 }
+
+// CancelTicket
+//
+// @Summary Cancel Ticket Day
+// @Description Cancel a ticket day, void its ticket purchases and queue refunds in background
+// @Tags Ticket-Superadmin
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param id path string true "Ticket ID"
+// @Param payload body request.TicketCancelRequest true "Cancel Ticket Day"
+// @Success 202 {object} helpers.Response
+// @Router /superadmin/tickets/{id}/cancel [post]
+func (h *routeSuperadmin) CancelTicket(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	payload := request.TicketCancelRequest{}
+	err := c.ShouldBindJSON(&payload)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, helpers.NewResponse(http.StatusBadRequest, "Invalid json data", nil, nil))
+		return
+	}
+
+	claim := c.MustGet("user_data").(jwt_helpers.SuperadminJWTClaims)
+	id := c.Param("id")
+
+	response := h.Usecase.CancelTicket(ctx, claim, id, payload)
+	c.JSON(response.Status, response)
+}
+
+// RescheduleTicket
+//
+// @Summary Reschedule Ticket Day
+// @Description Move a ticket day to another date, existing tickets stay valid and holders are notified
+// @Tags Ticket-Superadmin
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param id path string true "Ticket ID"
+// @Param payload body request.TicketRescheduleRequest true "Reschedule Ticket Day"
+// @Success 200 {object} helpers.Response
+// @Router /superadmin/tickets/{id}/reschedule [post]
+func (h *routeSuperadmin) RescheduleTicket(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	payload := request.TicketRescheduleRequest{}
+	err := c.ShouldBindJSON(&payload)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, helpers.NewResponse(http.StatusBadRequest, "Invalid json data", nil, nil))
+		return
+	}
+
+	claim := c.MustGet("user_data").(jwt_helpers.SuperadminJWTClaims)
+	id := c.Param("id")
+
+	response := h.Usecase.RescheduleTicket(ctx, claim, id, payload)
+	c.JSON(response.Status, response)
+}
@@ -0,0 +1,59 @@
+package superadmin_http
+
+import (
+	mongo_model "app/domain/model/mongo"
+	"github.com/gin-gonic/gin"
+)
+
+func (h *routeSuperadmin) handleTicketCancellationJobRoute(prefixPath string) {
+	api := h.Route.Group(prefixPath)
+
+	api.GET("", h.Middleware.AuthSuperadmin(mongo_model.PermissionTicketsView), h.GetTicketCancellationJobsList)
+	api.GET("/:id", h.Middleware.AuthSuperadmin(mongo_model.PermissionTicketsView), h.GetTicketCancellationJobDetail)
+}
+
+// GetTicketCancellationJobsList
+//
+// @Summary Get Ticket Cancellation Jobs
+// @Description Get Ticket Cancellation Jobs with their progress
+// @Tags TicketCancellationJob-Superadmin
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param ticketId query string false "Ticket ID"
+// @Param seriesId query string false "Series ID"
+// @Param status query string false "Status running, completed or failed"
+// @Param page query int false "Page"
+// @Param limit query int false "Limit"
+// @Param sort query string false "Sort"
+// @Param dir query string false "Direction asc or desc"
+// @Success 200 {object} helpers.Response
+// @Router /superadmin/ticket-cancellation-jobs [get]
+func (h *routeSuperadmin) GetTicketCancellationJobsList(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	query := c.Request.URL.Query()
+
+	response := h.Usecase.GetTicketCancellationJobsList(ctx, query)
+	c.JSON(response.Status, response)
+}
+
+// GetTicketCancellationJobDetail
+//
+// @Summary Get Ticket Cancellation Job Detail
+// @Description Get Ticket Cancellation Job Detail
+// @Tags TicketCancellationJob-Superadmin
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param id path string true "Job ID"
+// @Success 200 {object} helpers.Response
+// @Router /superadmin/ticket-cancellation-jobs/{id} [get]
+func (h *routeSuperadmin) GetTicketCancellationJobDetail(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	id := c.Param("id")
+
+	response := h.Usecase.GetTicketCancellationJobDetail(ctx, id)
+	c.JSON(response.Status, response)
+}
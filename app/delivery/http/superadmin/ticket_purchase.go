@@ -0,0 +1,72 @@
+package superadmin_http
+
+import (
+	mongo_model "app/domain/model/mongo"
+	"app/domain/request"
+	"app/helpers"
+	jwt_helpers "app/helpers/jwt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+func (h *routeSuperadmin) handleTicketPurchaseRoute(prefixPath string) {
+	api := h.Route.Group(prefixPath)
+
+	api.GET("/reissue-logs", h.Middleware.AuthSuperadmin(mongo_model.PermissionPurchasesView), h.GetTicketPurchaseReissueLogsList)
+	api.POST("/:id/reissue", h.Middleware.AuthSuperadmin(mongo_model.PermissionPurchasesManage), h.ReissueTicketPurchase)
+}
+
+// GetTicketPurchaseReissueLogsList
+//
+//	@Summary		Get Ticket Purchase Reissue Logs List
+//	@Description	Get Ticket Purchase Reissue Logs List
+//	@Tags			TicketPurchase-Superadmin
+//	@Security		BearerAuth
+//	@Accept			json
+//	@Produce		json
+//	@Param			ticketPurchaseId	query	string	false	"Ticket Purchase ID"
+//	@Param			purchaseId			query	string	false	"Purchase ID"
+//	@Param			memberId			query	string	false	"Member ID"
+//	@Param			page				query	int		false	"Page"
+//	@Param			limit				query	int		false	"Limit"
+//	@Param			sort				query	string	false	"Sort"
+//	@Param			dir					query	string	false	"Direction asc or desc"
+//	@Success		200		{object}	helpers.Response
+//	@Router			/superadmin/ticket-purchases/reissue-logs [get]
+func (h *routeSuperadmin) GetTicketPurchaseReissueLogsList(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	query := c.Request.URL.Query()
+
+	response := h.Usecase.GetTicketPurchaseReissueLogsList(ctx, query)
+	c.JSON(response.Status, response)
+}
+
+// ReissueTicketPurchase
+//
+//	@Summary		Reissue Ticket Purchase
+//	@Description	Revoke the current QR code of a ticket purchase and email a new one to the member
+//	@Tags			TicketPurchase-Superadmin
+//	@Security		BearerAuth
+//	@Accept			json
+//	@Produce		json
+//	@Param			id		path	string	true	"Ticket Purchase ID"
+//	@Param			payload	body	request.TicketPurchaseReissueRequest	true	"Reissue Ticket Purchase"
+//	@Success		200		{object}	helpers.Response
+//	@Router			/superadmin/ticket-purchases/{id}/reissue [post]
+func (h *routeSuperadmin) ReissueTicketPurchase(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	id := c.Param("id")
+	claim := c.MustGet("user_data").(jwt_helpers.SuperadminJWTClaims)
+	payload := request.TicketPurchaseReissueRequest{}
+	err := c.ShouldBindJSON(&payload)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, helpers.NewResponse(http.StatusBadRequest, "Invalid json data", nil, nil))
+		return
+	}
+
+	response := h.Usecase.ReissueTicketPurchase(ctx, claim, id, payload)
+	c.JSON(response.Status, response)
+}
@@ -0,0 +1,158 @@
+package superadmin_http
+
+import (
+	"app/domain/request"
+	"app/helpers"
+	jwt_helpers "app/helpers/jwt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// VerifyTwoFactor
+//
+// @Summary Verify Two Factor Superadmin
+// @Description Second login step, exchange the challenge token and an authenticator or recovery code for the token
+// @Tags Auth-Superadmin
+// @Accept json
+// @Produce json
+// @Param payload body request.TwoFactorVerifyRequest true "Verify Two Factor Superadmin"
+// @Success 200 {object} helpers.Response
+// @Router /superadmin/auth/2fa/verify [post]
+func (h *routeSuperadmin) VerifyTwoFactor(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	payload := request.TwoFactorVerifyRequest{}
+	err := c.ShouldBindJSON(&payload)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, helpers.NewResponse(http.StatusBadRequest, "Invalid json data", nil, nil))
+		return
+	}
+
+	response := h.Usecase.VerifyTwoFactor(ctx, payload, c.ClientIP())
+	c.JSON(response.Status, response)
+}
+
+// SetupTwoFactorChallenge
+//
+// @Summary Setup Two Factor Challenge Superadmin
+// @Description Start the mandatory two factor setup during login with the challenge token
+// @Tags Auth-Superadmin
+// @Accept json
+// @Produce json
+// @Param payload body request.TwoFactorChallengeRequest true "Setup Two Factor Challenge Superadmin"
+// @Success 200 {object} helpers.Response
+// @Router /superadmin/auth/2fa/setup-challenge [post]
+func (h *routeSuperadmin) SetupTwoFactorChallenge(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	payload := request.TwoFactorChallengeRequest{}
+	err := c.ShouldBindJSON(&payload)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, helpers.NewResponse(http.StatusBadRequest, "Invalid json data", nil, nil))
+		return
+	}
+
+	response := h.Usecase.SetupTwoFactorChallenge(ctx, payload)
+	c.JSON(response.Status, response)
+}
+
+// SetupTwoFactor
+//
+// @Summary Setup Two Factor Superadmin
+// @Description Generate a new authenticator secret and qr code
+// @Tags Auth-Superadmin
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Success 200 {object} helpers.Response
+// @Router /superadmin/auth/2fa/setup [post]
+func (h *routeSuperadmin) SetupTwoFactor(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	claim := c.MustGet("user_data").(jwt_helpers.SuperadminJWTClaims)
+
+	response := h.Usecase.SetupTwoFactor(ctx, claim)
+	c.JSON(response.Status, response)
+}
+
+// EnableTwoFactor
+//
+// @Summary Enable Two Factor Superadmin
+// @Description Confirm the authenticator with a code, returns the recovery codes
+// @Tags Auth-Superadmin
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param payload body request.TwoFactorCodeRequest true "Enable Two Factor Superadmin"
+// @Success 200 {object} helpers.Response
+// @Router /superadmin/auth/2fa/enable [post]
+func (h *routeSuperadmin) EnableTwoFactor(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	claim := c.MustGet("user_data").(jwt_helpers.SuperadminJWTClaims)
+
+	payload := request.TwoFactorCodeRequest{}
+	err := c.ShouldBindJSON(&payload)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, helpers.NewResponse(http.StatusBadRequest, "Invalid json data", nil, nil))
+		return
+	}
+
+	response := h.Usecase.EnableTwoFactor(ctx, claim, payload)
+	c.JSON(response.Status, response)
+}
+
+// DisableTwoFactor
+//
+// @Summary Disable Two Factor Superadmin
+// @Description Disable Two Factor Superadmin, not allowed when two factor is mandatory
+// @Tags Auth-Superadmin
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param payload body request.TwoFactorDisableRequest true "Disable Two Factor Superadmin"
+// @Success 200 {object} helpers.Response
+// @Router /superadmin/auth/2fa/disable [post]
+func (h *routeSuperadmin) DisableTwoFactor(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	claim := c.MustGet("user_data").(jwt_helpers.SuperadminJWTClaims)
+
+	payload := request.TwoFactorDisableRequest{}
+	err := c.ShouldBindJSON(&payload)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, helpers.NewResponse(http.StatusBadRequest, "Invalid json data", nil, nil))
+		return
+	}
+
+	response := h.Usecase.DisableTwoFactor(ctx, claim, payload)
+	c.JSON(response.Status, response)
+}
+
+// RegenerateRecoveryCodes
+//
+// @Summary Regenerate Recovery Codes Superadmin
+// @Description Replace every recovery code, the old codes stop working
+// @Tags Auth-Superadmin
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param payload body request.TwoFactorCodeRequest true "Regenerate Recovery Codes Superadmin"
+// @Success 200 {object} helpers.Response
+// @Router /superadmin/auth/2fa/recovery-codes [post]
+func (h *routeSuperadmin) RegenerateRecoveryCodes(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	claim := c.MustGet("user_data").(jwt_helpers.SuperadminJWTClaims)
+
+	payload := request.TwoFactorCodeRequest{}
+	err := c.ShouldBindJSON(&payload)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, helpers.NewResponse(http.StatusBadRequest, "Invalid json data", nil, nil))
+		return
+	}
+
+	response := h.Usecase.RegenerateRecoveryCodes(ctx, claim, payload)
+	c.JSON(response.Status, response)
+}
@@ -1,6 +1,7 @@
 package superadmin_http
 
 import (
+	mongo_model "app/domain/model/mongo"
 	"app/domain/request"
 	"app/helpers"
 	"net/http"
@@ -11,11 +12,11 @@ import (
 func (h *routeSuperadmin) handleVenueRoute(prefixPath string) {
 	api := h.Route.Group(prefixPath)
 
-	api.GET("", h.Middleware.AuthSuperadmin(), h.GetVenuesList)
-	api.GET("/:id", h.Middleware.AuthSuperadmin(), h.GetVenueDetail)
-	api.POST("", h.Middleware.AuthSuperadmin(), h.CreateVenue)
-	api.PUT("/:id", h.Middleware.AuthSuperadmin(), h.UpdateVenue)
-	api.DELETE("/:id", h.Middleware.AuthSuperadmin(), h.DeleteVenue)
+	api.GET("", h.Middleware.AuthSuperadmin(mongo_model.PermissionVenuesView), h.GetVenuesList)
+	api.GET("/:id", h.Middleware.AuthSuperadmin(mongo_model.PermissionVenuesView), h.GetVenueDetail)
+	api.POST("", h.Middleware.AuthSuperadmin(mongo_model.PermissionVenuesManage), h.CreateVenue)
+	api.PUT("/:id", h.Middleware.AuthSuperadmin(mongo_model.PermissionVenuesManage), h.UpdateVenue)
+	api.DELETE("/:id", h.Middleware.AuthSuperadmin(mongo_model.PermissionVenuesManage), h.DeleteVenue)
 }
 
 // GetVenuesList
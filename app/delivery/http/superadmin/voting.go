@@ -1,6 +1,7 @@
 package superadmin_http
 
 import (
+	mongo_model "app/domain/model/mongo"
 	"app/domain/request"
 	"app/helpers"
 	"net/http"
@@ -11,11 +12,12 @@ import (
 func (h *routeSuperadmin) handleVotingRoute(prefixPath string) {
 	api := h.Route.Group(prefixPath)
 
-	api.GET("", h.Middleware.AuthSuperadmin(), h.GetVotingList)
-	api.GET("/:id", h.Middleware.AuthSuperadmin(), h.GetVotingDetail)
-	api.POST("", h.Middleware.AuthSuperadmin(), h.CreateVoting)
-	api.PUT("/:id", h.Middleware.AuthSuperadmin(), h.UpdateVoting)
-	api.DELETE("/:id", h.Middleware.AuthSuperadmin(), h.DeleteVoting)
+	api.GET("", h.Middleware.AuthSuperadmin(mongo_model.PermissionVotingsView), h.GetVotingList)
+	api.GET("/:id", h.Middleware.AuthSuperadmin(mongo_model.PermissionVotingsView), h.GetVotingDetail)
+	api.POST("", h.Middleware.AuthSuperadmin(mongo_model.PermissionVotingsManage), h.CreateVoting)
+	api.PUT("/:id", h.Middleware.AuthSuperadmin(mongo_model.PermissionVotingsManage), h.UpdateVoting)
+	api.DELETE("/:id", h.Middleware.AuthSuperadmin(mongo_model.PermissionVotingsManage), h.DeleteVoting)
+	api.POST("/:id/refresh-candidates", h.Middleware.AuthSuperadmin(mongo_model.PermissionVotingsManage), h.RefreshVotingCandidates)
 }
 
 // GetVotingList
@@ -135,3 +137,22 @@ func (h *routeSuperadmin) DeleteVoting(c *gin.Context) {
 	response := h.Usecase.DeleteVoting(ctx, id)
 	c.JSON(response.Status, response)
 }
+
+// RefreshVotingCandidates
+//
+// @Summary Refresh Voting Candidates
+// @Description Pull the performance of every candidate from the recorded matches of the voting series and recompute their score
+// @Tags Voting-Superadmin
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param id path string true "Voting ID"
+// @Success 200 {object} helpers.Response
+// @Router /superadmin/votings/{id}/refresh-candidates [post]
+func (h *routeSuperadmin) RefreshVotingCandidates(c *gin.Context) {
+	ctx := c.Request.Context()
+	id := c.Param("id")
+
+	response := h.Usecase.RefreshVotingCandidates(ctx, id)
+	c.JSON(response.Status, response)
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/sirupsen/logrus"
 	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo"
 	moptions "go.mongodb.org/mongo-driver/mongo/options"
 )
@@ -22,10 +23,63 @@ func generateQueryFilterAdmin(options map[string]interface{}, withOptions bool)
 	if username, ok := options["username"].(string); ok {
 		query["username"] = username
 	}
+	if email, ok := options["email"].(string); ok {
+		query["email"] = email
+	}
+	if passwordToken, ok := options["passwordToken"].(string); ok {
+		query["passwordToken"] = passwordToken
+	}
+	if search, ok := options["search"].(string); ok {
+		regex := bson.M{
+			"$regex": primitive.Regex{
+				Pattern: search,
+				Options: "i",
+			},
+		}
+		query["$or"] = []bson.M{
+			{"name": regex},
+			{"username": regex},
+			{"email": regex},
+		}
+	}
+	if venueId, ok := options["venueId"].(string); ok {
+		query["venue.id"] = venueId
+	}
+	if isDisabled, ok := options["isDisabled"].(bool); ok {
+		if isDisabled {
+			query["isDisabled"] = true
+		} else {
+			query["isDisabled"] = bson.M{"$ne": true}
+		}
+	}
 
 	return query, mongoOptions
 }
 
+func (r *mongoDbRepo) FetchListAdmin(ctx context.Context, options map[string]interface{}) (cur *mongo.Cursor, err error) {
+	query, findOptions := generateQueryFilterAdmin(options, true)
+
+	cur, err = r.Conn.Collection(r.adminCollection).Find(ctx, query, findOptions)
+	if err != nil {
+		logrus.Error("FetchListAdmin Find:", err)
+		return
+	}
+
+	return
+}
+
+func (r *mongoDbRepo) CountAdmin(ctx context.Context, options map[string]interface{}) (total int64) {
+	query, _ := generateQueryFilterAdmin(options, true)
+
+	total, err := r.Conn.Collection(r.adminCollection).CountDocuments(ctx, query)
+	if err != nil {
+		logrus.Error("CountAdmin CountDocuments:", err)
+		return 0
+	}
+
+	return
+}
+
 func (r *mongoDbRepo) FetchOneAdmin(ctx context.Context, options map[string]interface{}) (row *mongo_model.Admin, err error) {
 	query, _ := generateQueryFilterAdmin(options, false)
 
@@ -42,3 +96,42 @@ func (r *mongoDbRepo) FetchOneAdmin(ctx context.Context, options map[string]inte
 
 	return
 }
+
+func (r *mongoDbRepo) CreateOneAdmin(ctx context.Context, admin *mongo_model.Admin) (err error) {
+	_, err = r.Conn.Collection(r.adminCollection).InsertOne(ctx, admin)
+	if err != nil {
+		logrus.Error("CreateOneAdmin InsertOne:", err)
+		return
+	}
+	return
+}
+
+func (r *mongoDbRepo) UpdatePartialAdmin(ctx context.Context, options, field map[string]interface{}) (err error) {
+	query, _ := generateQueryFilterAdmin(options, false)
+
+	_, err = r.Conn.Collection(r.adminCollection).UpdateOne(ctx, query, bson.M{"$set": field})
+	if err != nil {
+		logrus.Error("UpdatePartialAdmin UpdateOne:", err)
+		return
+	}
+
+	return
+}
+
+// UpdatePartialAdminBumpTokenVersion sets the fields and increments the token version in one update,
+// so every access token issued before is revoked
+func (r *mongoDbRepo) UpdatePartialAdminBumpTokenVersion(ctx context.Context, options, field map[string]interface{}) (matched bool, err error) {
+	query, _ := generateQueryFilterAdmin(options, false)
+
+	result, err := r.Conn.Collection(r.adminCollection).UpdateOne(ctx, query, bson.M{
+		"$set": field,
+		"$inc": bson.M{"tokenVersion": 1},
+	})
+	if err != nil {
+		logrus.Error("UpdatePartialAdminBumpTokenVersion UpdateOne:", err)
+		return
+	}
+
+	matched = result.MatchedCount > 0
+	return
+}
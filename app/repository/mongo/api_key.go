@@ -0,0 +1,153 @@
+package mongo_repository
+
+import (
+	mongo_model "app/domain/model/mongo"
+	"app/helpers"
+	"context"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	moptions "go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func generateQueryFilterAPIKey(options map[string]interface{}, withOptions bool) (query bson.M, mongoOptions *moptions.FindOptions) {
+	// common filter and find options
+	query = helpers.CommonFilter(options)
+	if withOptions {
+		mongoOptions = helpers.CommonMongoFindOptions(options)
+	}
+
+	// custom filter
+	var and []bson.M
+	if keyHash, ok := options["keyHash"].(string); ok {
+		query["keyHash"] = keyHash
+	}
+	if search, ok := options["search"].(string); ok {
+		regex := bson.M{
+			"$regex": primitive.Regex{
+				Pattern: search,
+				Options: "i",
+			},
+		}
+		and = append(and, bson.M{"$or": []bson.M{
+			{"name": regex},
+			{"prefix": regex},
+		}})
+	}
+	if scope, ok := options["scope"].(mongo_model.APIKeyScope); ok {
+		query["scopes"] = scope
+	}
+	if isRevoked, ok := options["isRevoked"].(bool); ok {
+		if isRevoked {
+			query["revokedAt"] = bson.M{"$ne": nil}
+		} else {
+			query["revokedAt"] = nil
+		}
+	}
+	if expiredAt, ok := options["expiredAt"].(time.Time); ok {
+		query["expiredAt"] = bson.M{"$lte": expiredAt}
+	}
+	if notExpiredAt, ok := options["notExpiredAt"].(time.Time); ok {
+		and = append(and, bson.M{"$or": []bson.M{
+			{"expiredAt": nil},
+			{"expiredAt": bson.M{"$gt": notExpiredAt}},
+		}})
+	}
+	if len(and) > 0 {
+		query["$and"] = and
+	}
+
+	return query, mongoOptions
+}
+
+func (r *mongoDbRepo) FetchListAPIKey(ctx context.Context, options map[string]interface{}) (cur *mongo.Cursor, err error) {
+	query, findOptions := generateQueryFilterAPIKey(options, true)
+
+	cur, err = r.Conn.Collection(r.apiKeyCollection).Find(ctx, query, findOptions)
+	if err != nil {
+		logrus.Error("FetchListAPIKey Find:", err)
+		return
+	}
+
+	return
+}
+
+func (r *mongoDbRepo) CountAPIKey(ctx context.Context, options map[string]interface{}) (total int64) {
+	query, _ := generateQueryFilterAPIKey(options, true)
+
+	total, err := r.Conn.Collection(r.apiKeyCollection).CountDocuments(ctx, query)
+	if err != nil {
+		logrus.Error("CountAPIKey CountDocuments:", err)
+		return 0
+	}
+
+	return
+}
+
+func (r *mongoDbRepo) FetchOneAPIKey(ctx context.Context, options map[string]interface{}) (row *mongo_model.APIKey, err error) {
+	query, _ := generateQueryFilterAPIKey(options, false)
+
+	err = r.Conn.Collection(r.apiKeyCollection).FindOne(ctx, query).Decode(&row)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			err = nil
+			return
+		}
+
+		logrus.Error("FetchOneAPIKey FindOne:", err)
+		return
+	}
+
+	return
+}
+
+func (r *mongoDbRepo) CreateOneAPIKey(ctx context.Context, apiKey *mongo_model.APIKey) (err error) {
+	_, err = r.Conn.Collection(r.apiKeyCollection).InsertOne(ctx, apiKey)
+	if err != nil {
+		logrus.Error("CreateOneAPIKey InsertOne:", err)
+		return
+	}
+	return
+}
+
+func (r *mongoDbRepo) UpdatePartialAPIKey(ctx context.Context, options, field map[string]interface{}) (err error) {
+	query, _ := generateQueryFilterAPIKey(options, false)
+
+	_, err = r.Conn.Collection(r.apiKeyCollection).UpdateOne(ctx, query, bson.M{"$set": field})
+	if err != nil {
+		logrus.Error("UpdatePartialAPIKey UpdateOne:", err)
+		return
+	}
+
+	return
+}
+
+// IncrementAPIKeyUsage counts a request in the fixed rate limit window of the key,
+// the window restarts when it began before windowStart
+func (r *mongoDbRepo) IncrementAPIKeyUsage(ctx context.Context, id string, now, windowStart time.Time) (row *mongo_model.APIKey, err error) {
+	isNewWindow := bson.M{"$lt": bson.A{bson.M{"$ifNull": bson.A{"$rateWindowStart", nil}}, windowStart}}
+	update := mongo.Pipeline{
+		{{Key: "$set", Value: bson.M{
+			"rateWindowStart": bson.M{"$cond": bson.A{isNewWindow, now, "$rateWindowStart"}},
+			"rateCount": bson.M{"$cond": bson.A{
+				isNewWindow,
+				1,
+				bson.M{"$add": bson.A{bson.M{"$ifNull": bson.A{"$rateCount", 0}}, 1}},
+			}},
+			"lastUsedAt": now,
+		}}},
+	}
+	opts := moptions.FindOneAndUpdate().SetReturnDocument(moptions.After)
+	query, _ := generateQueryFilterAPIKey(map[string]interface{}{"id": id}, false)
+
+	err = r.Conn.Collection(r.apiKeyCollection).FindOneAndUpdate(ctx, query, update, opts).Decode(&row)
+	if err != nil {
+		logrus.Error("IncrementAPIKeyUsage FindOneAndUpdate:", err)
+		return
+	}
+
+	return
+}
@@ -0,0 +1,100 @@
+package mongo_repository
+
+import (
+	mongo_model "app/domain/model/mongo"
+	"app/helpers"
+	"context"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	moptions "go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func generateQueryFilterAuditLog(options map[string]interface{}, withOptions bool) (query bson.M, mongoOptions *moptions.FindOptions) {
+	// common filter and find options
+	query = helpers.CommonFilter(options)
+	if withOptions {
+		mongoOptions = helpers.CommonMongoFindOptions(options)
+	}
+
+	// custom filter
+	if entityType, ok := options["entityType"].(mongo_model.AuditEntity); ok {
+		query["entityType"] = entityType
+	}
+	if entityId, ok := options["entityId"].(string); ok {
+		query["entityId"] = entityId
+	}
+	if action, ok := options["action"].(mongo_model.AuditAction); ok {
+		query["action"] = action
+	}
+	if actorId, ok := options["actorId"].(string); ok {
+		query["actor.id"] = actorId
+	}
+	if actorRole, ok := options["actorRole"].(mongo_model.ActorRole); ok {
+		query["actor.role"] = actorRole
+	}
+	createdAtQuery := bson.M{}
+	if createdAtFrom, ok := options["createdAtFrom"].(time.Time); ok {
+		createdAtQuery["$gte"] = createdAtFrom
+	}
+	if createdAtTo, ok := options["createdAtTo"].(time.Time); ok {
+		createdAtQuery["$lte"] = createdAtTo
+	}
+	if len(createdAtQuery) > 0 {
+		query["createdAt"] = createdAtQuery
+	}
+
+	return query, mongoOptions
+}
+
+func (r *mongoDbRepo) FetchListAuditLog(ctx context.Context, options map[string]interface{}) (cur *mongo.Cursor, err error) {
+	query, findOptions := generateQueryFilterAuditLog(options, true)
+
+	cur, err = r.Conn.Collection(r.auditLogCollection).Find(ctx, query, findOptions)
+	if err != nil {
+		logrus.Error("FetchListAuditLog Find:", err)
+		return
+	}
+
+	return
+}
+
+func (r *mongoDbRepo) CountAuditLog(ctx context.Context, options map[string]interface{}) (total int64) {
+	query, _ := generateQueryFilterAuditLog(options, true)
+
+	total, err := r.Conn.Collection(r.auditLogCollection).CountDocuments(ctx, query)
+	if err != nil {
+		logrus.Error("CountAuditLog CountDocuments:", err)
+		return 0
+	}
+
+	return
+}
+
+func (r *mongoDbRepo) FetchOneAuditLog(ctx context.Context, options map[string]interface{}) (row *mongo_model.AuditLog, err error) {
+	query, _ := generateQueryFilterAuditLog(options, false)
+
+	err = r.Conn.Collection(r.auditLogCollection).FindOne(ctx, query).Decode(&row)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			err = nil
+			return
+		}
+
+		logrus.Error("FetchOneAuditLog FindOne:", err)
+		return
+	}
+
+	return
+}
+
+func (r *mongoDbRepo) CreateOneAuditLog(ctx context.Context, auditLog *mongo_model.AuditLog) (err error) {
+	_, err = r.Conn.Collection(r.auditLogCollection).InsertOne(ctx, auditLog)
+	if err != nil {
+		logrus.Error("CreateOneAuditLog InsertOne:", err)
+		return
+	}
+	return
+}
@@ -0,0 +1,134 @@
+package mongo_repository
+
+import (
+	mongo_model "app/domain/model/mongo"
+	"app/helpers"
+	"context"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	moptions "go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func generateQueryFilterAuthSession(options map[string]interface{}, withOptions bool) (query bson.M, mongoOptions *moptions.FindOptions) {
+	// common filter and find options
+	query = helpers.CommonFilter(options)
+	if withOptions {
+		mongoOptions = helpers.CommonMongoFindOptions(options)
+	}
+
+	// custom filter
+	if excludeId, ok := options["excludeId"].(string); ok {
+		obj, _ := primitive.ObjectIDFromHex(excludeId)
+		query["_id"] = bson.M{"$ne": obj}
+	}
+	if role, ok := options["role"].(mongo_model.ActorRole); ok {
+		query["role"] = role
+	}
+	if userId, ok := options["userId"].(string); ok {
+		query["userId"] = userId
+	}
+	if refreshTokenHash, ok := options["refreshTokenHash"].(string); ok {
+		query["refreshTokenHash"] = refreshTokenHash
+	}
+	if previousRefreshTokenHash, ok := options["previousRefreshTokenHash"].(string); ok {
+		query["previousRefreshTokenHash"] = previousRefreshTokenHash
+	}
+	if isRevoked, ok := options["isRevoked"].(bool); ok {
+		if isRevoked {
+			query["revokedAt"] = bson.M{"$ne": nil}
+		} else {
+			query["revokedAt"] = nil
+		}
+	}
+
+	return query, mongoOptions
+}
+
+func (r *mongoDbRepo) FetchOneAuthSession(ctx context.Context, options map[string]interface{}) (row *mongo_model.AuthSession, err error) {
+	query, _ := generateQueryFilterAuthSession(options, false)
+
+	err = r.Conn.Collection(r.authSessionCollection).FindOne(ctx, query).Decode(&row)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			err = nil
+			return
+		}
+
+		logrus.Error("FetchOneAuthSession FindOne:", err)
+		return
+	}
+
+	return
+}
+
+func (r *mongoDbRepo) CreateOneAuthSession(ctx context.Context, authSession *mongo_model.AuthSession) (err error) {
+	_, err = r.Conn.Collection(r.authSessionCollection).InsertOne(ctx, authSession)
+	if err != nil {
+		logrus.Error("CreateOneAuthSession InsertOne:", err)
+		return
+	}
+	return
+}
+
+func (r *mongoDbRepo) UpdatePartialAuthSession(ctx context.Context, options, field map[string]interface{}) (err error) {
+	query, _ := generateQueryFilterAuthSession(options, false)
+
+	_, err = r.Conn.Collection(r.authSessionCollection).UpdateOne(ctx, query, bson.M{"$set": field})
+	if err != nil {
+		logrus.Error("UpdatePartialAuthSession UpdateOne:", err)
+		return
+	}
+
+	return
+}
+
+// RotateAuthSessionRefreshToken swaps the refresh token of an active session only while it still holds the old one,
+// a nil row means the token was rotated, revoked or expired in the meantime
+func (r *mongoDbRepo) RotateAuthSessionRefreshToken(ctx context.Context, id string, oldRefreshTokenHash, newRefreshTokenHash string, now time.Time) (row *mongo_model.AuthSession, err error) {
+	obj, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		logrus.Error("Invalid AuthSession ID:", err)
+		return
+	}
+
+	err = r.Conn.Collection(r.authSessionCollection).FindOneAndUpdate(ctx, bson.M{
+		"_id":              obj,
+		"refreshTokenHash": oldRefreshTokenHash,
+		"revokedAt":        nil,
+		"expiredAt":        bson.M{"$gt": now},
+	}, bson.M{
+		"$set": bson.M{
+			"refreshTokenHash":         newRefreshTokenHash,
+			"previousRefreshTokenHash": oldRefreshTokenHash,
+			"lastRefreshedAt":          now,
+			"updatedAt":                now,
+		},
+	}, moptions.FindOneAndUpdate().SetReturnDocument(moptions.After)).Decode(&row)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			err = nil
+			return
+		}
+
+		logrus.Error("RotateAuthSessionRefreshToken FindOneAndUpdate:", err)
+		return
+	}
+
+	return
+}
+
+func (r *mongoDbRepo) UpdateManyAuthSessionPartial(ctx context.Context, options, field map[string]interface{}) (err error) {
+	query, _ := generateQueryFilterAuthSession(options, false)
+
+	_, err = r.Conn.Collection(r.authSessionCollection).UpdateMany(ctx, query, bson.M{"$set": field})
+	if err != nil {
+		logrus.Error("UpdateManyAuthSessionPartial UpdateMany:", err)
+		return
+	}
+
+	return
+}
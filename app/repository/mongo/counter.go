@@ -0,0 +1,33 @@
+package mongo_repository
+
+import (
+	mongo_model "app/domain/model/mongo"
+	"context"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"go.mongodb.org/mongo-driver/bson"
+	moptions "go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// IncrementCounter atomically take the next value of the counter, the counter is created on first use
+func (r *mongoDbRepo) IncrementCounter(ctx context.Context, key string) (value int64, err error) {
+	now := time.Now()
+	opts := moptions.FindOneAndUpdate().SetUpsert(true).SetReturnDocument(moptions.After)
+
+	row := mongo_model.Counter{}
+	err = r.Conn.Collection(r.counterCollection).FindOneAndUpdate(ctx, bson.M{
+		"_id": key,
+	}, bson.M{
+		"$inc":         bson.M{"value": 1},
+		"$set":         bson.M{"updatedAt": now},
+		"$setOnInsert": bson.M{"createdAt": now},
+	}, opts).Decode(&row)
+	if err != nil {
+		logrus.Error("IncrementCounter FindOneAndUpdate:", err)
+		return
+	}
+
+	value = row.Value
+	return
+}
@@ -0,0 +1,55 @@
+package mongo_repository
+
+import (
+	"context"
+
+	"github.com/sirupsen/logrus"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	moptions "go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// EnsureIndexes creates the indexes the repository relies on for uniqueness and expiry, existing indexes are left as is
+func (r *mongoDbRepo) EnsureIndexes(ctx context.Context) (err error) {
+	indexes := map[string][]mongo.IndexModel{
+		// one ticket purchase per seat of a ticket day, so issuing codes twice does nothing
+		r.ticketPurchaseCollection: {
+			{
+				Keys: bson.D{{Key: "purchaseId", Value: 1}, {Key: "ticket.id", Value: 1}, {Key: "seat", Value: 1}},
+				Options: moptions.Index().SetName("purchase_ticket_seat_unique").SetUnique(true).
+					SetPartialFilterExpression(bson.M{"seat": bson.M{"$exists": true}}),
+			},
+		},
+		// one refund per ticket day of a purchase
+		r.refundCollection: {
+			{
+				Keys:    bson.D{{Key: "purchaseId", Value: 1}, {Key: "ticket.id", Value: 1}},
+				Options: moptions.Index().SetName("purchase_ticket_unique").SetUnique(true),
+			},
+		},
+		// one failure counter per account or ip of a role, so concurrent first failures upsert the same counter
+		r.loginAttemptCollection: {
+			{
+				Keys:    bson.D{{Key: "role", Value: 1}, {Key: "type", Value: 1}, {Key: "identifier", Value: 1}},
+				Options: moptions.Index().SetName("role_type_identifier_unique").SetUnique(true),
+			},
+		},
+		// pending login states are dropped by mongo once expired
+		r.oidcStateCollection: {
+			{
+				Keys:    bson.D{{Key: "expiredAt", Value: 1}},
+				Options: moptions.Index().SetName("expired_at_ttl").SetExpireAfterSeconds(0),
+			},
+		},
+	}
+
+	for collection, models := range indexes {
+		_, err = r.Conn.Collection(collection).Indexes().CreateMany(ctx, models)
+		if err != nil {
+			logrus.Error("EnsureIndexes CreateMany "+collection+":", err)
+			return
+		}
+	}
+
+	return
+}
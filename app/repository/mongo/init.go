@@ -7,45 +7,71 @@ import (
 )
 
 type mongoDbRepo struct {
-	Conn                       *mongo.Database
-	superadminCollection       string
-	adminCollection            string
-	memberCollection           string
-	mediaCollection            string
-	seasonCollection           string
-	venueCollection            string
-	teamCollection             string
-	playerCollection           string
-	seasonTeamCollection       string
-	seasonTeamPlayerCollection string
-	seriesCollection           string
-	ticketCollection           string
-	votingCollection           string
-	candidateCollection        string
-	votingLogCollection        string
-	purchaseCollection         string
-	ticketPurchaseCollection   string
+	Conn                               *mongo.Database
+	superadminCollection               string
+	adminCollection                    string
+	memberCollection                   string
+	mediaCollection                    string
+	seasonCollection                   string
+	venueCollection                    string
+	teamCollection                     string
+	playerCollection                   string
+	seasonTeamCollection               string
+	seasonTeamPlayerCollection         string
+	seriesCollection                   string
+	ticketCollection                   string
+	votingCollection                   string
+	candidateCollection                string
+	votingLogCollection                string
+	purchaseCollection                 string
+	ticketPurchaseCollection           string
+	ticketPurchaseReissueLogCollection string
+	refundCollection                   string
+	ticketCancellationJobCollection    string
+	authSessionCollection              string
+	loginAttemptCollection             string
+	oidcStateCollection                string
+	roleCollection                     string
+	auditLogCollection                 string
+	apiKeyCollection                   string
+	matchCollection                    string
+	matchEventCollection               string
+	standingCollection                 string
+	counterCollection                  string
 }
 
 func NewMongoDbRepo(conn *mongo.Database) domain.MongoDbRepo {
 	return &mongoDbRepo{
-		Conn:                       conn,
-		superadminCollection:       "superadmins",
-		adminCollection:            "admins",
-		memberCollection:           "members",
-		mediaCollection:            "medias",
-		seasonCollection:           "seasons",
-		venueCollection:            "venues",
-		teamCollection:             "teams",
-		playerCollection:           "players",
-		seasonTeamCollection:       "season_teams",
-		seasonTeamPlayerCollection: "season_team_players",
-		seriesCollection:           "series",
-		ticketCollection:           "tickets",
-		votingCollection:           "votings",
-		candidateCollection:        "candidates",
-		votingLogCollection:        "voting_logs",
-		purchaseCollection:         "purchases",
-		ticketPurchaseCollection:   "ticket_purchases",
+		Conn:                               conn,
+		superadminCollection:               "superadmins",
+		adminCollection:                    "admins",
+		memberCollection:                   "members",
+		mediaCollection:                    "medias",
+		seasonCollection:                   "seasons",
+		venueCollection:                    "venues",
+		teamCollection:                     "teams",
+		playerCollection:                   "players",
+		seasonTeamCollection:               "season_teams",
+		seasonTeamPlayerCollection:         "season_team_players",
+		seriesCollection:                   "series",
+		ticketCollection:                   "tickets",
+		votingCollection:                   "votings",
+		candidateCollection:                "candidates",
+		votingLogCollection:                "voting_logs",
+		purchaseCollection:                 "purchases",
+		ticketPurchaseCollection:           "ticket_purchases",
+		ticketPurchaseReissueLogCollection: "ticket_purchase_reissue_logs",
+		refundCollection:                   "refunds",
+		ticketCancellationJobCollection:    "ticket_cancellation_jobs",
+		authSessionCollection:              "auth_sessions",
+		loginAttemptCollection:             "login_attempts",
+		oidcStateCollection:                "oidc_states",
+		roleCollection:                     "roles",
+		auditLogCollection:                 "audit_logs",
+		apiKeyCollection:                   "api_keys",
+		matchCollection:                    "matches",
+		matchEventCollection:               "match_events",
+		standingCollection:                 "standings",
+		counterCollection:                  "counters",
 	}
 }
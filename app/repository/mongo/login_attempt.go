@@ -0,0 +1,156 @@
+package mongo_repository
+
+import (
+	mongo_model "app/domain/model/mongo"
+	"app/helpers"
+	"context"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	moptions "go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func generateQueryFilterLoginAttempt(options map[string]interface{}, withOptions bool) (query bson.M, mongoOptions *moptions.FindOptions) {
+	// common filter and find options
+	query = helpers.CommonFilter(options)
+	if withOptions {
+		mongoOptions = helpers.CommonMongoFindOptions(options)
+	}
+
+	// custom filter
+	if role, ok := options["role"].(mongo_model.ActorRole); ok {
+		query["role"] = role
+	}
+	if attemptType, ok := options["type"].(mongo_model.LoginAttemptType); ok {
+		query["type"] = attemptType
+	}
+	if identifier, ok := options["identifier"].(string); ok {
+		query["identifier"] = identifier
+	}
+	if search, ok := options["search"].(string); ok {
+		query["identifier"] = bson.M{
+			"$regex": primitive.Regex{
+				Pattern: search,
+				Options: "i",
+			},
+		}
+	}
+	if lockedAt, ok := options["lockedAt"].(time.Time); ok {
+		query["lockedUntil"] = bson.M{"$gt": lockedAt}
+	}
+
+	return query, mongoOptions
+}
+
+func (r *mongoDbRepo) FetchListLoginAttempt(ctx context.Context, options map[string]interface{}) (cur *mongo.Cursor, err error) {
+	query, findOptions := generateQueryFilterLoginAttempt(options, true)
+
+	cur, err = r.Conn.Collection(r.loginAttemptCollection).Find(ctx, query, findOptions)
+	if err != nil {
+		logrus.Error("FetchListLoginAttempt Find:", err)
+		return
+	}
+
+	return
+}
+
+func (r *mongoDbRepo) CountLoginAttempt(ctx context.Context, options map[string]interface{}) (total int64) {
+	query, _ := generateQueryFilterLoginAttempt(options, true)
+
+	total, err := r.Conn.Collection(r.loginAttemptCollection).CountDocuments(ctx, query)
+	if err != nil {
+		logrus.Error("CountLoginAttempt CountDocuments:", err)
+		return 0
+	}
+
+	return
+}
+
+func (r *mongoDbRepo) FetchOneLoginAttempt(ctx context.Context, options map[string]interface{}) (row *mongo_model.LoginAttempt, err error) {
+	query, _ := generateQueryFilterLoginAttempt(options, false)
+
+	err = r.Conn.Collection(r.loginAttemptCollection).FindOne(ctx, query).Decode(&row)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			err = nil
+			return
+		}
+
+		logrus.Error("FetchOneLoginAttempt FindOne:", err)
+		return
+	}
+
+	return
+}
+
+// IncrementLoginAttempt atomically count one failure and apply the backoff or lockout of the policy in the same update.
+// The counter restarts when the last failure is older than windowStart, a locking failure resets it to zero
+func (r *mongoDbRepo) IncrementLoginAttempt(ctx context.Context, role mongo_model.ActorRole, attemptType mongo_model.LoginAttemptType, identifier string, policy mongo_model.LoginAttemptPolicy, now, windowStart time.Time) (row *mongo_model.LoginAttempt, err error) {
+	query := bson.M{
+		"role":       role,
+		"type":       attemptType,
+		"identifier": identifier,
+		"deletedAt":  nil,
+	}
+
+	isLocking := bson.M{"$gte": bson.A{"$failures", policy.MaxAttempts}}
+	lockout := bson.M{"$min": bson.A{
+		bson.M{"$multiply": bson.A{policy.LockoutDuration.Milliseconds(), bson.M{"$pow": bson.A{2, "$lockCount"}}}},
+		mongo_model.LoginAttemptMaxLockout.Milliseconds(),
+	}}
+	retryAt := interface{}(bson.M{"$ifNull": bson.A{"$retryAt", nil}})
+	if attemptType == mongo_model.LoginAttemptTypeAccount && policy.BackoffBase > 0 {
+		retryAt = bson.M{"$add": bson.A{now, bson.M{"$min": bson.A{
+			bson.M{"$multiply": bson.A{policy.BackoffBase.Milliseconds(), bson.M{"$pow": bson.A{2, bson.M{"$subtract": bson.A{"$failures", 1}}}}}},
+			mongo_model.LoginAttemptMaxBackoff.Milliseconds(),
+		}}}}
+	}
+
+	update := mongo.Pipeline{
+		{{Key: "$set", Value: bson.M{
+			"failures": bson.M{"$cond": bson.A{
+				bson.M{"$lt": bson.A{bson.M{"$ifNull": bson.A{"$lastFailedAt", nil}}, windowStart}},
+				1,
+				bson.M{"$add": bson.A{bson.M{"$ifNull": bson.A{"$failures", 0}}, 1}},
+			}},
+			"lockCount":    bson.M{"$ifNull": bson.A{"$lockCount", 0}},
+			"lastFailedAt": now,
+			"createdAt":    bson.M{"$ifNull": bson.A{"$createdAt", now}},
+			"updatedAt":    now,
+		}}},
+		{{Key: "$set", Value: bson.M{
+			"lockedUntil": bson.M{"$cond": bson.A{isLocking, bson.M{"$add": bson.A{now, lockout}}, bson.M{"$ifNull": bson.A{"$lockedUntil", nil}}}},
+			"lockCount":   bson.M{"$cond": bson.A{isLocking, bson.M{"$add": bson.A{"$lockCount", 1}}, "$lockCount"}},
+			"failures":    bson.M{"$cond": bson.A{isLocking, 0, "$failures"}},
+			"retryAt":     bson.M{"$cond": bson.A{isLocking, nil, retryAt}},
+		}}},
+	}
+	opts := moptions.FindOneAndUpdate().SetUpsert(true).SetReturnDocument(moptions.After)
+
+	err = r.Conn.Collection(r.loginAttemptCollection).FindOneAndUpdate(ctx, query, update, opts).Decode(&row)
+	if mongo.IsDuplicateKeyError(err) {
+		// a concurrent first failure inserted the counter, increment that one
+		err = r.Conn.Collection(r.loginAttemptCollection).FindOneAndUpdate(ctx, query, update, opts).Decode(&row)
+	}
+	if err != nil {
+		logrus.Error("IncrementLoginAttempt FindOneAndUpdate:", err)
+		return
+	}
+
+	return
+}
+
+func (r *mongoDbRepo) UpdatePartialLoginAttempt(ctx context.Context, options, field map[string]interface{}) (err error) {
+	query, _ := generateQueryFilterLoginAttempt(options, false)
+
+	_, err = r.Conn.Collection(r.loginAttemptCollection).UpdateOne(ctx, query, bson.M{"$set": field})
+	if err != nil {
+		logrus.Error("UpdatePartialLoginAttempt UpdateOne:", err)
+		return
+	}
+
+	return
+}
@@ -0,0 +1,195 @@
+package mongo_repository
+
+import (
+	mongo_model "app/domain/model/mongo"
+	"app/helpers"
+	"context"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	moptions "go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func generateQueryFilterMatch(options map[string]interface{}, withOptions bool) (query bson.M, mongoOptions *moptions.FindOptions) {
+	// common filter and find options
+	query = helpers.CommonFilter(options)
+	if withOptions {
+		mongoOptions = helpers.CommonMongoFindOptions(options)
+	}
+
+	// custom filter
+	if seasonId, ok := options["seasonId"].(string); ok {
+		query["seasonId"] = seasonId
+	}
+	if seriesId, ok := options["seriesId"].(string); ok {
+		query["seriesId"] = seriesId
+	}
+	if ticketId, ok := options["ticketId"].(string); ok {
+		query["ticketId"] = ticketId
+	}
+	if venueId, ok := options["venueId"].(string); ok {
+		query["venueId"] = venueId
+	}
+	if seasonTeamId, ok := options["seasonTeamId"].(string); ok {
+		query["$or"] = []bson.M{
+			{"homeSeasonTeamId": seasonTeamId},
+			{"awaySeasonTeamId": seasonTeamId},
+		}
+	}
+	if status, ok := options["status"].(mongo_model.MatchStatus); ok {
+		query["status"] = status
+	}
+	if statuses, ok := options["statuses"].([]mongo_model.MatchStatus); ok {
+		query["status"] = bson.M{"$in": statuses}
+	}
+	kickoffAtQuery := bson.M{}
+	if kickoffAtFrom, ok := options["kickoffAtFrom"].(time.Time); ok {
+		kickoffAtQuery["$gte"] = kickoffAtFrom
+	}
+	if kickoffAtTo, ok := options["kickoffAtTo"].(time.Time); ok {
+		kickoffAtQuery["$lte"] = kickoffAtTo
+	}
+	if len(kickoffAtQuery) > 0 {
+		query["kickoffAt"] = kickoffAtQuery
+	}
+
+	return query, mongoOptions
+}
+
+func (r *mongoDbRepo) FetchListMatch(ctx context.Context, options map[string]interface{}) (cur *mongo.Cursor, err error) {
+	query, findOptions := generateQueryFilterMatch(options, true)
+
+	cur, err = r.Conn.Collection(r.matchCollection).Find(ctx, query, findOptions)
+	if err != nil {
+		logrus.Error("FetchListMatch Find:", err)
+		return
+	}
+
+	return
+}
+
+func (r *mongoDbRepo) CountMatch(ctx context.Context, options map[string]interface{}) (total int64) {
+	query, _ := generateQueryFilterMatch(options, true)
+
+	total, err := r.Conn.Collection(r.matchCollection).CountDocuments(ctx, query)
+	if err != nil {
+		logrus.Error("CountMatch CountDocuments:", err)
+		return 0
+	}
+
+	return
+}
+
+func (r *mongoDbRepo) FetchOneMatch(ctx context.Context, options map[string]interface{}) (row *mongo_model.Match, err error) {
+	query, _ := generateQueryFilterMatch(options, false)
+
+	err = r.Conn.Collection(r.matchCollection).FindOne(ctx, query).Decode(&row)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			err = nil
+			return
+		}
+
+		logrus.Error("FetchOneMatch FindOne:", err)
+		return
+	}
+
+	return
+}
+
+func (r *mongoDbRepo) CreateOneMatch(ctx context.Context, match *mongo_model.Match) (err error) {
+	_, err = r.Conn.Collection(r.matchCollection).InsertOne(ctx, match)
+	if err != nil {
+		logrus.Error("CreateOneMatch InsertOne:", err)
+		return
+	}
+	return
+}
+
+func (r *mongoDbRepo) UpdatePartialMatch(ctx context.Context, options, field map[string]interface{}) (err error) {
+	query, _ := generateQueryFilterMatch(options, false)
+
+	_, err = r.Conn.Collection(r.matchCollection).UpdateOne(ctx, query, bson.M{"$set": field})
+	if err != nil {
+		logrus.Error("UpdatePartialMatch UpdateOne:", err)
+		return
+	}
+
+	return
+}
+
+func (r *mongoDbRepo) UpdateManyMatchPartial(ctx context.Context, options, field map[string]interface{}) (err error) {
+	query, _ := generateQueryFilterMatch(options, false)
+
+	_, err = r.Conn.Collection(r.matchCollection).UpdateMany(ctx, query, bson.M{"$set": field})
+	if err != nil {
+		logrus.Error("UpdateManyMatchPartial UpdateMany:", err)
+		return
+	}
+
+	return
+}
+
+// StartMatch moves a scheduled match to live, a match already live or finished is left as is
+func (r *mongoDbRepo) StartMatch(ctx context.Context, id string, now time.Time) (matched bool, err error) {
+	obj, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		logrus.Error("Invalid match ID:", err)
+		return
+	}
+
+	result, err := r.Conn.Collection(r.matchCollection).UpdateOne(ctx, bson.M{
+		"_id":       obj,
+		"status":    mongo_model.MatchStatusScheduled,
+		"deletedAt": nil,
+	}, bson.M{
+		"$set": bson.M{
+			"status":    mongo_model.MatchStatusLive,
+			"updatedAt": now,
+		},
+	})
+	if err != nil {
+		logrus.Error("StartMatch UpdateOne:", err)
+		return
+	}
+
+	matched = result.MatchedCount > 0
+	return
+}
+
+// UpdateMatchScore saves the score only when no other score was saved since scoreVersion was read
+func (r *mongoDbRepo) UpdateMatchScore(ctx context.Context, id string, scoreVersion int64, score mongo_model.MatchScore, now time.Time) (matched bool, err error) {
+	obj, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		logrus.Error("Invalid match ID:", err)
+		return
+	}
+
+	query := bson.M{
+		"_id":          obj,
+		"scoreVersion": scoreVersion,
+		"deletedAt":    nil,
+	}
+	if scoreVersion == 0 {
+		// matches saved before the score version
+		query["scoreVersion"] = bson.M{"$in": bson.A{0, nil}}
+	}
+
+	result, err := r.Conn.Collection(r.matchCollection).UpdateOne(ctx, query, bson.M{
+		"$set": bson.M{
+			"score":     score,
+			"updatedAt": now,
+		},
+		"$inc": bson.M{"scoreVersion": 1},
+	})
+	if err != nil {
+		logrus.Error("UpdateMatchScore UpdateOne:", err)
+		return
+	}
+
+	matched = result.MatchedCount > 0
+	return
+}
@@ -0,0 +1,303 @@
+package mongo_repository
+
+import (
+	mongo_model "app/domain/model/mongo"
+	"app/helpers"
+	"context"
+	"regexp"
+
+	"github.com/sirupsen/logrus"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	moptions "go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func generateQueryFilterMatchEvent(options map[string]interface{}, withOptions bool) (query bson.M, mongoOptions *moptions.FindOptions) {
+	// common filter and find options
+	query = helpers.CommonFilter(options)
+	if withOptions {
+		mongoOptions = helpers.CommonMongoFindOptions(options)
+	}
+
+	// custom filter
+	if matchId, ok := options["matchId"].(string); ok {
+		query["matchId"] = matchId
+	}
+	if matchIds, ok := options["matchIds"].([]string); ok {
+		query["matchId"] = bson.M{"$in": matchIds}
+	}
+	if eventType, ok := options["type"].(mongo_model.MatchEventType); ok {
+		query["type"] = eventType
+	}
+	if eventTypes, ok := options["types"].([]mongo_model.MatchEventType); ok {
+		query["type"] = bson.M{"$in": eventTypes}
+	}
+	if seasonTeamPlayerId, ok := options["seasonTeamPlayerId"].(string); ok {
+		query["seasonTeamPlayer.id"] = seasonTeamPlayerId
+	}
+	if seasonTeamId, ok := options["seasonTeamId"].(string); ok {
+		query["seasonTeamPlayer.seasonTeam.id"] = seasonTeamId
+	}
+
+	return query, mongoOptions
+}
+
+func (r *mongoDbRepo) FetchListMatchEvent(ctx context.Context, options map[string]interface{}) (cur *mongo.Cursor, err error) {
+	query, findOptions := generateQueryFilterMatchEvent(options, true)
+
+	cur, err = r.Conn.Collection(r.matchEventCollection).Find(ctx, query, findOptions)
+	if err != nil {
+		logrus.Error("FetchListMatchEvent Find:", err)
+		return
+	}
+
+	return
+}
+
+func (r *mongoDbRepo) CountMatchEvent(ctx context.Context, options map[string]interface{}) (total int64) {
+	query, _ := generateQueryFilterMatchEvent(options, true)
+
+	total, err := r.Conn.Collection(r.matchEventCollection).CountDocuments(ctx, query)
+	if err != nil {
+		logrus.Error("CountMatchEvent CountDocuments:", err)
+		return 0
+	}
+
+	return
+}
+
+func (r *mongoDbRepo) FetchOneMatchEvent(ctx context.Context, options map[string]interface{}) (row *mongo_model.MatchEvent, err error) {
+	query, _ := generateQueryFilterMatchEvent(options, false)
+
+	err = r.Conn.Collection(r.matchEventCollection).FindOne(ctx, query).Decode(&row)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			err = nil
+			return
+		}
+
+		logrus.Error("FetchOneMatchEvent FindOne:", err)
+		return
+	}
+
+	return
+}
+
+func (r *mongoDbRepo) CreateOneMatchEvent(ctx context.Context, matchEvent *mongo_model.MatchEvent) (err error) {
+	_, err = r.Conn.Collection(r.matchEventCollection).InsertOne(ctx, matchEvent)
+	if err != nil {
+		logrus.Error("CreateOneMatchEvent InsertOne:", err)
+		return
+	}
+	return
+}
+
+func (r *mongoDbRepo) UpdatePartialMatchEvent(ctx context.Context, options, field map[string]interface{}) (err error) {
+	query, _ := generateQueryFilterMatchEvent(options, false)
+
+	_, err = r.Conn.Collection(r.matchEventCollection).UpdateOne(ctx, query, bson.M{"$set": field})
+	if err != nil {
+		logrus.Error("UpdatePartialMatchEvent UpdateOne:", err)
+		return
+	}
+
+	return
+}
+
+// AggregatePlayerStats counts the events of the matches per season team player and ranks the players by the sort stat,
+// players without the sort stat are left out, every player is returned when no sort is given.
+// The minutes of a match are counted per player and end at the duration of a finished match
+// or the last recorded minute of a match still played
+func (r *mongoDbRepo) AggregatePlayerStats(ctx context.Context, options map[string]interface{}) (list []mongo_model.PlayerStats, total int64, err error) {
+	// filter
+	query, _ := generateQueryFilterMatchEvent(map[string]interface{}{
+		"matchIds": options["matchIds"],
+	}, false)
+
+	sortBy, hasSort := options["sort"].(mongo_model.PlayerStatsSort)
+	if !hasSort {
+		sortBy = mongo_model.PlayerStatsSortGoal
+	}
+	offset, _ := options["offset"].(int64)
+	limit, _ := options["limit"].(int64)
+
+	countWhen := func(conditions ...bson.M) bson.M {
+		return bson.M{"$sum": bson.M{"$cond": bson.A{bson.M{"$and": conditions}, 1, 0}}}
+	}
+	minuteWhen := func(condition bson.M) bson.M {
+		return bson.M{"$min": bson.M{"$cond": bson.A{condition, "$minute", nil}}}
+	}
+	isSubject := bson.M{"$eq": bson.A{"$players.isSubject", true}}
+	isType := func(eventType mongo_model.MatchEventType) bson.M {
+		return bson.M{"$eq": bson.A{"$type", eventType}}
+	}
+	sumOf := func(field string) bson.M {
+		return bson.M{"$sum": "$" + field}
+	}
+
+	// filter players after grouping so every event of a player is counted
+	playerQuery := bson.M{}
+	if hasSort {
+		playerQuery[string(sortBy)] = bson.M{"$gt": 0}
+	}
+	if seasonTeamId, ok := options["seasonTeamId"].(string); ok {
+		playerQuery["seasonTeamPlayer.seasonTeam.id"] = seasonTeamId
+	}
+	if position, ok := options["position"].(string); ok {
+		playerQuery["seasonTeamPlayer.position"] = primitive.Regex{Pattern: "^" + regexp.QuoteMeta(position) + "$", Options: "i"}
+	}
+
+	// a tie goes to the player with fewer minutes
+	listSort := bson.D{{Key: string(sortBy), Value: -1}}
+	if sortBy != mongo_model.PlayerStatsSortMinute {
+		listSort = append(listSort, bson.E{Key: "minute", Value: 1})
+	}
+	listSort = append(listSort,
+		bson.E{Key: "seasonTeamPlayer.player.name", Value: 1},
+		bson.E{Key: "_id", Value: 1},
+	)
+	listStages := bson.A{
+		bson.M{"$sort": listSort},
+		bson.M{"$skip": offset},
+	}
+	if limit > 0 {
+		listStages = append(listStages, bson.M{"$limit": limit})
+	}
+
+	// pipeline
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: query}},
+		// end of each match, the duration of a finished match or the last recorded minute
+		{{Key: "$group", Value: bson.M{
+			"_id":        "$matchId",
+			"lastMinute": bson.M{"$max": "$minute"},
+			"events": bson.M{"$push": bson.M{
+				"type":                        "$type",
+				"minute":                      "$minute",
+				"isOwnGoal":                   "$isOwnGoal",
+				"seasonTeamPlayer":            "$seasonTeamPlayer",
+				"substitutedSeasonTeamPlayer": "$substitutedSeasonTeamPlayer",
+			}},
+		}}},
+		{{Key: "$lookup", Value: bson.M{
+			"from": r.matchCollection,
+			"let":  bson.M{"matchId": bson.M{"$toObjectId": "$_id"}},
+			"pipeline": bson.A{
+				bson.M{"$match": bson.M{"$expr": bson.M{"$eq": bson.A{"$_id", "$$matchId"}}}},
+				bson.M{"$project": bson.M{"status": 1, "duration": 1}},
+			},
+			"as": "match",
+		}}},
+		{{Key: "$addFields", Value: bson.M{
+			"match": bson.M{"$arrayElemAt": bson.A{"$match", 0}},
+		}}},
+		{{Key: "$addFields", Value: bson.M{
+			"endMinute": bson.M{"$cond": bson.A{
+				bson.M{"$ne": bson.A{"$match.status", mongo_model.MatchStatusFinished}},
+				"$lastMinute",
+				bson.M{"$cond": bson.A{
+					bson.M{"$gt": bson.A{"$match.duration", 0}},
+					"$match.duration",
+					bson.M{"$max": bson.A{mongo_model.MatchDefaultDuration, "$lastMinute"}},
+				}},
+			}},
+		}}},
+		{{Key: "$unwind", Value: "$events"}},
+		{{Key: "$project", Value: bson.M{
+			"endMinute": 1,
+			"type":      "$events.type",
+			"minute":    "$events.minute",
+			"isOwnGoal": "$events.isOwnGoal",
+			"players": bson.M{"$concatArrays": bson.A{
+				bson.A{bson.M{"seasonTeamPlayer": "$events.seasonTeamPlayer", "isSubject": true}},
+				bson.M{"$cond": bson.A{
+					bson.M{"$ifNull": bson.A{"$events.substitutedSeasonTeamPlayer", false}},
+					bson.A{bson.M{"seasonTeamPlayer": "$events.substitutedSeasonTeamPlayer", "isSubject": false}},
+					bson.A{},
+				}},
+			}},
+		}}},
+		{{Key: "$unwind", Value: "$players"}},
+		// stats of each player in each match
+		{{Key: "$group", Value: bson.M{
+			"_id":              bson.M{"matchId": "$_id", "seasonTeamPlayerId": "$players.seasonTeamPlayer.id"},
+			"seasonTeamPlayer": bson.M{"$first": "$players.seasonTeamPlayer"},
+			"endMinute":        bson.M{"$first": "$endMinute"},
+			"goal":             countWhen(isSubject, isType(mongo_model.MatchEventTypeGoal), bson.M{"$ne": bson.A{"$isOwnGoal", true}}),
+			"ownGoal":          countWhen(isSubject, isType(mongo_model.MatchEventTypeGoal), bson.M{"$eq": bson.A{"$isOwnGoal", true}}),
+			"assist":           countWhen(isSubject, isType(mongo_model.MatchEventTypeAssist)),
+			"save":             countWhen(isSubject, isType(mongo_model.MatchEventTypeSave)),
+			"yellowCard":       countWhen(isSubject, isType(mongo_model.MatchEventTypeYellowCard)),
+			"redCard":          countWhen(isSubject, isType(mongo_model.MatchEventTypeRedCard)),
+			"foul":             countWhen(isSubject, isType(mongo_model.MatchEventTypeFoul)),
+			"started":          countWhen(isSubject, isType(mongo_model.MatchEventTypeStart)),
+			"onMinute":         minuteWhen(bson.M{"$and": bson.A{isSubject, isType(mongo_model.MatchEventTypeSubstitution)}}),
+			"offMinute": minuteWhen(bson.M{"$or": bson.A{
+				bson.M{"$and": bson.A{bson.M{"$not": bson.A{isSubject}}, isType(mongo_model.MatchEventTypeSubstitution)}},
+				bson.M{"$and": bson.A{isSubject, isType(mongo_model.MatchEventTypeRedCard)}},
+			}}),
+		}}},
+		{{Key: "$addFields", Value: bson.M{
+			"minute": bson.M{"$max": bson.A{0, bson.M{"$subtract": bson.A{
+				bson.M{"$min": bson.A{bson.M{"$ifNull": bson.A{"$offMinute", "$endMinute"}}, "$endMinute"}},
+				bson.M{"$cond": bson.A{bson.M{"$gt": bson.A{"$started", 0}}, 0, bson.M{"$ifNull": bson.A{"$onMinute", 0}}}},
+			}}}},
+		}}},
+		// stats of each player
+		{{Key: "$group", Value: bson.M{
+			"_id":              "$_id.seasonTeamPlayerId",
+			"seasonTeamPlayer": bson.M{"$first": "$seasonTeamPlayer"},
+			"goal":             sumOf("goal"),
+			"ownGoal":          sumOf("ownGoal"),
+			"assist":           sumOf("assist"),
+			"save":             sumOf("save"),
+			"yellowCard":       sumOf("yellowCard"),
+			"redCard":          sumOf("redCard"),
+			"foul":             sumOf("foul"),
+			"minute":           sumOf("minute"),
+			"match":            bson.M{"$sum": 1},
+		}}},
+		{{Key: "$addFields", Value: bson.M{
+			"card": bson.M{"$add": bson.A{"$yellowCard", "$redCard"}},
+		}}},
+		{{Key: "$match", Value: playerQuery}},
+		{{Key: "$facet", Value: bson.M{
+			"list":  listStages,
+			"total": bson.A{bson.M{"$count": "count"}},
+		}}},
+	}
+
+	// aggregate
+	cur, err := r.Conn.Collection(r.matchEventCollection).Aggregate(ctx, pipeline)
+	if err != nil {
+		logrus.Error("AggregatePlayerStats Aggregate:", err)
+		return
+	}
+	defer cur.Close(ctx)
+
+	// decode
+	list = make([]mongo_model.PlayerStats, 0)
+	if cur.Next(ctx) {
+		var result struct {
+			List  []mongo_model.PlayerStats `bson:"list"`
+			Total []struct {
+				Count int64 `bson:"count"`
+			} `bson:"total"`
+		}
+		if err = cur.Decode(&result); err != nil {
+			logrus.Error("AggregatePlayerStats Decode:", err)
+			return
+		}
+		list = result.List
+		if len(result.Total) > 0 {
+			total = result.Total[0].Count
+		}
+	}
+
+	for i := range list {
+		list[i].Rank = offset + int64(i) + 1
+	}
+
+	return list, total, nil
+}
@@ -25,6 +25,15 @@ func generateQueryFilterMember(options map[string]interface{}, withOptions bool)
 	if emailToken, ok := options["emailToken"].(string); ok {
 		query["emailToken"] = emailToken
 	}
+	if passwordToken, ok := options["passwordToken"].(string); ok {
+		query["passwordToken"] = passwordToken
+	}
+	if oidcIssuer, ok := options["oidcIssuer"].(string); ok {
+		query["oidc.issuer"] = oidcIssuer
+	}
+	if oidcSubject, ok := options["oidcSubject"].(string); ok {
+		query["oidc.subject"] = oidcSubject
+	}
 
 	return query, mongoOptions
 }
@@ -66,3 +75,21 @@ func (r *mongoDbRepo) UpdatePartialMember(ctx context.Context, options, field ma
 
 	return
 }
+
+// UpdatePartialMemberBumpTokenVersion sets the fields and increments the token version in one update,
+// so every access token issued before is revoked
+func (r *mongoDbRepo) UpdatePartialMemberBumpTokenVersion(ctx context.Context, options, field map[string]interface{}) (matched bool, err error) {
+	query, _ := generateQueryFilterMember(options, false)
+
+	result, err := r.Conn.Collection(r.memberCollection).UpdateOne(ctx, query, bson.M{
+		"$set": field,
+		"$inc": bson.M{"tokenVersion": 1},
+	})
+	if err != nil {
+		logrus.Error("UpdatePartialMemberBumpTokenVersion UpdateOne:", err)
+		return
+	}
+
+	matched = result.MatchedCount > 0
+	return
+}
@@ -0,0 +1,49 @@
+package mongo_repository
+
+import (
+	mongo_model "app/domain/model/mongo"
+	"context"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	moptions "go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func (r *mongoDbRepo) CreateOneOIDCState(ctx context.Context, oidcState *mongo_model.OIDCState) (err error) {
+	_, err = r.Conn.Collection(r.oidcStateCollection).InsertOne(ctx, oidcState)
+	if err != nil {
+		logrus.Error("CreateOneOIDCState InsertOne:", err)
+		return
+	}
+	return
+}
+
+// ConsumeOIDCState mark an unused and unexpired state as used, so a callback can only be completed once
+func (r *mongoDbRepo) ConsumeOIDCState(ctx context.Context, state string, now time.Time) (row *mongo_model.OIDCState, err error) {
+	query := bson.M{
+		"state":     state,
+		"usedAt":    nil,
+		"expiredAt": bson.M{"$gt": now},
+		"deletedAt": nil,
+	}
+	update := bson.M{"$set": bson.M{
+		"usedAt":    now,
+		"updatedAt": now,
+	}}
+	opts := moptions.FindOneAndUpdate().SetReturnDocument(moptions.After)
+
+	err = r.Conn.Collection(r.oidcStateCollection).FindOneAndUpdate(ctx, query, update, opts).Decode(&row)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			err = nil
+			return
+		}
+
+		logrus.Error("ConsumeOIDCState FindOneAndUpdate:", err)
+		return
+	}
+
+	return
+}
@@ -59,6 +59,36 @@ func generateQueryFilterPurchase(options map[string]interface{}, withOptions boo
 	if seasonId, ok := options["seasonId"].(string); ok {
 		query["season.id"] = seasonId
 	}
+	if ticketId, ok := options["ticketId"].(string); ok {
+		query["tickets.id"] = ticketId
+	}
+	if statuses, ok := options["statuses"].([]mongo_model.PurchaseStatus); ok {
+		query["status"] = bson.M{"$in": statuses}
+	}
+	if isSeasonPass, ok := options["isSeasonPass"].(bool); ok {
+		query["isSeasonPass"] = isSeasonPass
+	}
+	if channel, ok := options["channel"].(mongo_model.PurchaseChannel); ok {
+		query["channel"] = channel
+	}
+	if boxOfficeAdminId, ok := options["boxOfficeAdminId"].(string); ok {
+		query["boxOffice.admin.id"] = boxOfficeAdminId
+	}
+	if idAfter, ok := options["idAfter"].(string); ok {
+		if obj, err := primitive.ObjectIDFromHex(idAfter); err == nil {
+			query["_id"] = bson.M{"$gt": obj}
+		}
+	}
+	paidAtQuery := bson.M{}
+	if paidAtFrom, ok := options["paidAtFrom"].(time.Time); ok {
+		paidAtQuery["$gte"] = paidAtFrom
+	}
+	if paidAtTo, ok := options["paidAtTo"].(time.Time); ok {
+		paidAtQuery["$lte"] = paidAtTo
+	}
+	if len(paidAtQuery) > 0 {
+		query["paidAt"] = paidAtQuery
+	}
 
 	return query, mongoOptions
 }
@@ -124,3 +154,49 @@ func (r *mongoDbRepo) UpdatePartialPurchase(ctx context.Context, options, field
 
 	return
 }
+
+func (r *mongoDbRepo) UpdateManyPurchasePartial(ctx context.Context, options, field map[string]interface{}) (err error) {
+	query, _ := generateQueryFilterPurchase(options, false)
+
+	_, err = r.Conn.Collection(r.purchaseCollection).UpdateMany(ctx, query, bson.M{"$set": field})
+	if err != nil {
+		logrus.Error("UpdateManyPurchasePartial UpdateMany:", err)
+		return
+	}
+
+	return
+}
+
+// AddPurchaseTicket adds a ticket day to a pending or paid purchase which does not cover it yet,
+// returns the purchase after the update or nil when nothing matched
+func (r *mongoDbRepo) AddPurchaseTicket(ctx context.Context, id string, ticket mongo_model.TicketFK, now time.Time) (row *mongo_model.Purchase, err error) {
+	obj, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		logrus.Error("Invalid purchase ID:", err)
+		return
+	}
+
+	opts := moptions.FindOneAndUpdate().SetReturnDocument(moptions.After)
+	err = r.Conn.Collection(r.purchaseCollection).FindOneAndUpdate(ctx, bson.M{
+		"_id": obj,
+		"status": bson.M{"$in": []mongo_model.PurchaseStatus{
+			mongo_model.PurchaseStatusPending,
+			mongo_model.PurchaseStatusPaid,
+		}},
+		"tickets.id": bson.M{"$ne": ticket.ID},
+		"deletedAt":  nil,
+	}, bson.M{
+		"$push": bson.M{"tickets": ticket},
+		"$set":  bson.M{"updatedAt": now},
+	}, opts).Decode(&row)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+
+		logrus.Error("AddPurchaseTicket FindOneAndUpdate:", err)
+		return
+	}
+
+	return
+}
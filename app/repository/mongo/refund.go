@@ -0,0 +1,133 @@
+package mongo_repository
+
+import (
+	mongo_model "app/domain/model/mongo"
+	"app/helpers"
+	"context"
+
+	"github.com/sirupsen/logrus"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	moptions "go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func generateQueryFilterRefund(options map[string]interface{}, withOptions bool) (query bson.M, mongoOptions *moptions.FindOptions) {
+	// common filter and find options
+	query = helpers.CommonFilter(options)
+	if withOptions {
+		mongoOptions = helpers.CommonMongoFindOptions(options)
+	}
+
+	// custom filter
+	if purchaseId, ok := options["purchaseId"].(string); ok {
+		query["purchaseId"] = purchaseId
+	}
+	if ticketId, ok := options["ticketId"].(string); ok {
+		query["ticket.id"] = ticketId
+	}
+	if memberId, ok := options["memberId"].(string); ok {
+		query["member.id"] = memberId
+	}
+	if jobId, ok := options["jobId"].(string); ok {
+		query["jobId"] = jobId
+	}
+	if reason, ok := options["reason"].(mongo_model.RefundReason); ok {
+		query["reason"] = reason
+	}
+	if status, ok := options["status"].(mongo_model.RefundStatus); ok {
+		query["status"] = status
+	}
+
+	return query, mongoOptions
+}
+
+func (r *mongoDbRepo) FetchListRefund(ctx context.Context, options map[string]interface{}) (cur *mongo.Cursor, err error) {
+	query, findOptions := generateQueryFilterRefund(options, true)
+
+	cur, err = r.Conn.Collection(r.refundCollection).Find(ctx, query, findOptions)
+	if err != nil {
+		logrus.Error("FetchListRefund Find:", err)
+		return
+	}
+
+	return
+}
+
+func (r *mongoDbRepo) CountRefund(ctx context.Context, options map[string]interface{}) (total int64) {
+	query, _ := generateQueryFilterRefund(options, true)
+
+	total, err := r.Conn.Collection(r.refundCollection).CountDocuments(ctx, query)
+	if err != nil {
+		logrus.Error("CountRefund CountDocuments:", err)
+		return 0
+	}
+
+	return
+}
+
+func (r *mongoDbRepo) FetchOneRefund(ctx context.Context, options map[string]interface{}) (row *mongo_model.Refund, err error) {
+	query, _ := generateQueryFilterRefund(options, false)
+
+	err = r.Conn.Collection(r.refundCollection).FindOne(ctx, query).Decode(&row)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			err = nil
+			return
+		}
+
+		logrus.Error("FetchOneRefund FindOne:", err)
+		return
+	}
+
+	return
+}
+
+func (r *mongoDbRepo) CreateOneRefund(ctx context.Context, refund *mongo_model.Refund) (err error) {
+	_, err = r.Conn.Collection(r.refundCollection).InsertOne(ctx, refund)
+	if err != nil {
+		logrus.Error("CreateOneRefund InsertOne:", err)
+		return
+	}
+	return
+}
+
+// CreateOneRefundIfNotExists queues the refund unless the purchase already has one for the ticket day,
+// the unique purchase ticket index makes concurrent requests create only one
+func (r *mongoDbRepo) CreateOneRefundIfNotExists(ctx context.Context, refund *mongo_model.Refund) (created bool, err error) {
+	_, err = r.Conn.Collection(r.refundCollection).InsertOne(ctx, refund)
+	if err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return false, nil
+		}
+
+		logrus.Error("CreateOneRefundIfNotExists InsertOne:", err)
+		return
+	}
+
+	created = true
+	return
+}
+
+func (r *mongoDbRepo) UpdatePartialRefund(ctx context.Context, options, field map[string]interface{}) (err error) {
+	query, _ := generateQueryFilterRefund(options, false)
+
+	_, err = r.Conn.Collection(r.refundCollection).UpdateOne(ctx, query, bson.M{"$set": field})
+	if err != nil {
+		logrus.Error("UpdatePartialRefund UpdateOne:", err)
+		return
+	}
+
+	return
+}
+
+func (r *mongoDbRepo) UpdateManyRefundPartial(ctx context.Context, options, field map[string]interface{}) (err error) {
+	query, _ := generateQueryFilterRefund(options, false)
+
+	_, err = r.Conn.Collection(r.refundCollection).UpdateMany(ctx, query, bson.M{"$set": field})
+	if err != nil {
+		logrus.Error("UpdateManyRefundPartial UpdateMany:", err)
+		return
+	}
+
+	return
+}
@@ -0,0 +1,106 @@
+package mongo_repository
+
+import (
+	mongo_model "app/domain/model/mongo"
+	"app/helpers"
+	"context"
+	"regexp"
+
+	"github.com/sirupsen/logrus"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	moptions "go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func generateQueryFilterRole(options map[string]interface{}, withOptions bool) (query bson.M, mongoOptions *moptions.FindOptions) {
+	// common filter and find options
+	query = helpers.CommonFilter(options)
+	if withOptions {
+		mongoOptions = helpers.CommonMongoFindOptions(options)
+	}
+
+	// custom filter
+	if excludeId, ok := options["excludeId"].(string); ok {
+		obj, _ := primitive.ObjectIDFromHex(excludeId)
+		query["_id"] = bson.M{"$ne": obj}
+	}
+	if name, ok := options["name"].(string); ok {
+		query["name"] = primitive.Regex{
+			Pattern: "^" + regexp.QuoteMeta(name) + "$",
+			Options: "i",
+		}
+	}
+	if search, ok := options["search"].(string); ok {
+		query["name"] = bson.M{
+			"$regex": primitive.Regex{
+				Pattern: search,
+				Options: "i",
+			},
+		}
+	}
+
+	return query, mongoOptions
+}
+
+func (r *mongoDbRepo) FetchListRole(ctx context.Context, options map[string]interface{}) (cur *mongo.Cursor, err error) {
+	query, findOptions := generateQueryFilterRole(options, true)
+
+	cur, err = r.Conn.Collection(r.roleCollection).Find(ctx, query, findOptions)
+	if err != nil {
+		logrus.Error("FetchListRole Find:", err)
+		return
+	}
+
+	return
+}
+
+func (r *mongoDbRepo) CountRole(ctx context.Context, options map[string]interface{}) (total int64) {
+	query, _ := generateQueryFilterRole(options, true)
+
+	total, err := r.Conn.Collection(r.roleCollection).CountDocuments(ctx, query)
+	if err != nil {
+		logrus.Error("CountRole CountDocuments:", err)
+		return 0
+	}
+
+	return
+}
+
+func (r *mongoDbRepo) FetchOneRole(ctx context.Context, options map[string]interface{}) (row *mongo_model.Role, err error) {
+	query, _ := generateQueryFilterRole(options, false)
+
+	err = r.Conn.Collection(r.roleCollection).FindOne(ctx, query).Decode(&row)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			err = nil
+			return
+		}
+
+		logrus.Error("FetchOneRole FindOne:", err)
+		return
+	}
+
+	return
+}
+
+func (r *mongoDbRepo) CreateOneRole(ctx context.Context, role *mongo_model.Role) (err error) {
+	_, err = r.Conn.Collection(r.roleCollection).InsertOne(ctx, role)
+	if err != nil {
+		logrus.Error("CreateOneRole InsertOne:", err)
+		return
+	}
+	return
+}
+
+func (r *mongoDbRepo) UpdatePartialRole(ctx context.Context, options, field map[string]interface{}) (err error) {
+	query, _ := generateQueryFilterRole(options, false)
+
+	_, err = r.Conn.Collection(r.roleCollection).UpdateOne(ctx, query, bson.M{"$set": field})
+	if err != nil {
+		logrus.Error("UpdatePartialRole UpdateOne:", err)
+		return
+	}
+
+	return
+}
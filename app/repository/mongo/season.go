@@ -4,6 +4,7 @@ import (
 	mongo_model "app/domain/model/mongo"
 	"app/helpers"
 	"context"
+	"time"
 
 	"github.com/sirupsen/logrus"
 	"go.mongodb.org/mongo-driver/bson"
@@ -97,3 +98,99 @@ func (r *mongoDbRepo) UpdatePartialSeason(ctx context.Context, options, field ma
 
 	return
 }
+
+func (r *mongoDbRepo) IncrementOneSeason(ctx context.Context, id string, payload map[string]int64) (err error) {
+	obj, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		logrus.Error("Invalid season ID:", err)
+		return err
+	}
+
+	_, err = r.Conn.Collection(r.seasonCollection).UpdateOne(ctx, map[string]any{
+		"_id": obj,
+	}, bson.M{
+		"$inc": payload,
+	})
+	if err != nil {
+		logrus.Error("IncrementOneSeason UpdateOne:", err)
+		return
+	}
+
+	return
+}
+
+// ReserveSeasonPassQuota takes amount of the season pass quota, matched is false when not enough is left
+func (r *mongoDbRepo) ReserveSeasonPassQuota(ctx context.Context, id string, amount int64) (matched bool, err error) {
+	obj, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		logrus.Error("Invalid season ID:", err)
+		return
+	}
+
+	result, err := r.Conn.Collection(r.seasonCollection).UpdateOne(ctx, bson.M{
+		"_id": obj,
+		"$expr": bson.M{"$lte": bson.A{
+			bson.M{"$add": bson.A{"$pass.quota.used", amount}},
+			"$pass.quota.stock",
+		}},
+	}, bson.M{
+		"$inc": bson.M{"pass.quota.used": amount},
+	})
+	if err != nil {
+		logrus.Error("ReserveSeasonPassQuota UpdateOne:", err)
+		return
+	}
+
+	matched = result.MatchedCount > 0
+	return
+}
+
+// ClaimSeasonStandingLock lets one run compute the standings of a season, an expired lock is taken over
+func (r *mongoDbRepo) ClaimSeasonStandingLock(ctx context.Context, id string, lockedUntil, now time.Time) (matched bool, err error) {
+	obj, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		logrus.Error("Invalid season ID:", err)
+		return
+	}
+
+	result, err := r.Conn.Collection(r.seasonCollection).UpdateOne(ctx, bson.M{
+		"_id": obj,
+		"$or": []bson.M{
+			{"standingLockedUntil": nil},
+			{"standingLockedUntil": bson.M{"$lt": now}},
+		},
+		"deletedAt": nil,
+	}, bson.M{
+		"$set": bson.M{"standingLockedUntil": lockedUntil},
+	})
+	if err != nil {
+		logrus.Error("ClaimSeasonStandingLock UpdateOne:", err)
+		return
+	}
+
+	matched = result.MatchedCount > 0
+	return
+}
+
+// ReleaseSeasonStandingLock releases the lock only when no computation was requested since standingVersion was read
+func (r *mongoDbRepo) ReleaseSeasonStandingLock(ctx context.Context, id string, standingVersion int64) (matched bool, err error) {
+	obj, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		logrus.Error("Invalid season ID:", err)
+		return
+	}
+
+	result, err := r.Conn.Collection(r.seasonCollection).UpdateOne(ctx, bson.M{
+		"_id":             obj,
+		"standingVersion": standingVersion,
+	}, bson.M{
+		"$set": bson.M{"standingLockedUntil": nil},
+	})
+	if err != nil {
+		logrus.Error("ReleaseSeasonStandingLock UpdateOne:", err)
+		return
+	}
+
+	matched = result.MatchedCount > 0
+	return
+}
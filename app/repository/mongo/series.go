@@ -4,6 +4,7 @@ import (
 	mongo_model "app/domain/model/mongo"
 	"app/helpers"
 	"context"
+	"time"
 
 	"github.com/sirupsen/logrus"
 	"go.mongodb.org/mongo-driver/bson"
@@ -26,6 +27,11 @@ func generateQueryFilterSeries(options map[string]interface{}, withOptions bool)
 	if seasonId, ok := options["seasonId"].(string); ok {
 		query["seasonId"] = seasonId
 	}
+	if publishAtBefore, ok := options["publishAtBefore"].(time.Time); ok {
+		query["publishAt"] = bson.M{
+			"$lte": publishAtBefore,
+		}
+	}
 	if name, ok := options["name"].(string); ok {
 		regex := bson.M{
 			"$regex": primitive.Regex{
@@ -134,3 +140,32 @@ func (r *mongoDbRepo) SumSeriesMatchCount(ctx context.Context, options map[strin
 
 	return total, nil
 }
+
+// PublishSeries moves a draft series whose publish time has passed to active, matched is false when
+// the series was already published or changed meanwhile
+func (r *mongoDbRepo) PublishSeries(ctx context.Context, id string, now time.Time) (matched bool, err error) {
+	obj, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		logrus.Error("Invalid series ID:", err)
+		return
+	}
+
+	result, err := r.Conn.Collection(r.seriesCollection).UpdateOne(ctx, bson.M{
+		"_id":       obj,
+		"status":    mongo_model.SeriesStatusDraft,
+		"publishAt": bson.M{"$lte": now},
+		"deletedAt": nil,
+	}, bson.M{
+		"$set": bson.M{
+			"status":    mongo_model.SeriesStatusActive,
+			"updatedAt": now,
+		},
+	})
+	if err != nil {
+		logrus.Error("PublishSeries UpdateOne:", err)
+		return
+	}
+
+	matched = result.MatchedCount > 0
+	return
+}
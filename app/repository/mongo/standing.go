@@ -0,0 +1,107 @@
+package mongo_repository
+
+import (
+	mongo_model "app/domain/model/mongo"
+	"app/helpers"
+	"context"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	moptions "go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func generateQueryFilterStanding(options map[string]interface{}, withOptions bool) (query bson.M, mongoOptions *moptions.FindOptions) {
+	// common filter and find options
+	query = helpers.CommonFilter(options)
+	if withOptions {
+		mongoOptions = helpers.CommonMongoFindOptions(options)
+	}
+
+	// custom filter, an empty series id or group is the table of the whole season
+	if seasonId, ok := options["seasonId"].(string); ok {
+		query["seasonId"] = seasonId
+	}
+	if seriesId, ok := options["seriesId"].(string); ok {
+		query["seriesId"] = seriesId
+	}
+	if group, ok := options["group"].(string); ok {
+		query["group"] = group
+	}
+	if computedAtBefore, ok := options["computedAtBefore"].(time.Time); ok {
+		query["computedAt"] = bson.M{"$lt": computedAtBefore}
+	}
+
+	return query, mongoOptions
+}
+
+func (r *mongoDbRepo) FetchListStanding(ctx context.Context, options map[string]interface{}) (cur *mongo.Cursor, err error) {
+	query, findOptions := generateQueryFilterStanding(options, true)
+
+	cur, err = r.Conn.Collection(r.standingCollection).Find(ctx, query, findOptions)
+	if err != nil {
+		logrus.Error("FetchListStanding Find:", err)
+		return
+	}
+
+	return
+}
+
+func (r *mongoDbRepo) FetchOneStanding(ctx context.Context, options map[string]interface{}) (row *mongo_model.Standing, err error) {
+	query, _ := generateQueryFilterStanding(options, false)
+
+	err = r.Conn.Collection(r.standingCollection).FindOne(ctx, query).Decode(&row)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			err = nil
+			return
+		}
+
+		logrus.Error("FetchOneStanding FindOne:", err)
+		return
+	}
+
+	return
+}
+
+// UpsertStanding replace the table of the same season, series and group
+func (r *mongoDbRepo) UpsertStanding(ctx context.Context, standing *mongo_model.Standing) (err error) {
+	query, _ := generateQueryFilterStanding(map[string]interface{}{
+		"seasonId": standing.SeasonID,
+		"seriesId": standing.SeriesID,
+		"group":    standing.Group,
+	}, false)
+
+	_, err = r.Conn.Collection(r.standingCollection).UpdateOne(ctx, query, bson.M{
+		"$set": bson.M{
+			"rule":       standing.Rule,
+			"rows":       standing.Rows,
+			"computedAt": standing.ComputedAt,
+			"updatedAt":  standing.ComputedAt,
+		},
+		"$setOnInsert": bson.M{
+			"_id":       primitive.NewObjectID(),
+			"createdAt": standing.ComputedAt,
+		},
+	}, moptions.Update().SetUpsert(true))
+	if err != nil {
+		logrus.Error("UpsertStanding UpdateOne:", err)
+		return
+	}
+
+	return
+}
+
+func (r *mongoDbRepo) UpdateManyStandingPartial(ctx context.Context, options, field map[string]interface{}) (err error) {
+	query, _ := generateQueryFilterStanding(options, false)
+
+	_, err = r.Conn.Collection(r.standingCollection).UpdateMany(ctx, query, bson.M{"$set": field})
+	if err != nil {
+		logrus.Error("UpdateManyStandingPartial UpdateMany:", err)
+		return
+	}
+
+	return
+}
@@ -4,9 +4,11 @@ import (
 	mongo_model "app/domain/model/mongo"
 	"app/helpers"
 	"context"
+	"time"
 
 	"github.com/sirupsen/logrus"
 	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo"
 	moptions "go.mongodb.org/mongo-driver/mongo/options"
 )
@@ -22,10 +24,60 @@ func generateQueryFilterSuperadmin(options map[string]interface{}, withOptions b
 	if email, ok := options["email"].(string); ok {
 		query["email"] = email
 	}
+	if excludeId, ok := options["excludeId"].(string); ok {
+		obj, _ := primitive.ObjectIDFromHex(excludeId)
+		query["_id"] = bson.M{"$ne": obj}
+	}
+	if search, ok := options["search"].(string); ok {
+		regex := bson.M{
+			"$regex": primitive.Regex{
+				Pattern: search,
+				Options: "i",
+			},
+		}
+		query["$or"] = []bson.M{
+			{"name": regex},
+			{"email": regex},
+		}
+	}
+	if roleId, ok := options["roleId"].(string); ok {
+		query["role.id"] = roleId
+	}
+	if isOwner, ok := options["isOwner"].(bool); ok {
+		if isOwner {
+			query["role"] = nil
+		} else {
+			query["role"] = bson.M{"$ne": nil}
+		}
+	}
 
 	return query, mongoOptions
 }
 
+func (r *mongoDbRepo) FetchListSuperadmin(ctx context.Context, options map[string]interface{}) (cur *mongo.Cursor, err error) {
+	query, findOptions := generateQueryFilterSuperadmin(options, true)
+
+	cur, err = r.Conn.Collection(r.superadminCollection).Find(ctx, query, findOptions)
+	if err != nil {
+		logrus.Error("FetchListSuperadmin Find:", err)
+		return
+	}
+
+	return
+}
+
+func (r *mongoDbRepo) CountSuperadmin(ctx context.Context, options map[string]interface{}) (total int64) {
+	query, _ := generateQueryFilterSuperadmin(options, true)
+
+	total, err := r.Conn.Collection(r.superadminCollection).CountDocuments(ctx, query)
+	if err != nil {
+		logrus.Error("CountSuperadmin CountDocuments:", err)
+		return 0
+	}
+
+	return
+}
+
 func (r *mongoDbRepo) FetchOneSuperadmin(ctx context.Context, options map[string]interface{}) (row *mongo_model.Superadmin, err error) {
 	query, _ := generateQueryFilterSuperadmin(options, false)
 
@@ -42,3 +94,100 @@ func (r *mongoDbRepo) FetchOneSuperadmin(ctx context.Context, options map[string
 
 	return
 }
+
+func (r *mongoDbRepo) UpdatePartialSuperadmin(ctx context.Context, options, field map[string]interface{}) (err error) {
+	query, _ := generateQueryFilterSuperadmin(options, false)
+
+	_, err = r.Conn.Collection(r.superadminCollection).UpdateOne(ctx, query, bson.M{"$set": field})
+	if err != nil {
+		logrus.Error("UpdatePartialSuperadmin UpdateOne:", err)
+		return
+	}
+
+	return
+}
+
+// UpdatePartialSuperadminBumpTokenVersion sets the fields and increments the token version in one update,
+// so every access token issued before is revoked
+func (r *mongoDbRepo) UpdatePartialSuperadminBumpTokenVersion(ctx context.Context, options, field map[string]interface{}) (matched bool, err error) {
+	query, _ := generateQueryFilterSuperadmin(options, false)
+
+	result, err := r.Conn.Collection(r.superadminCollection).UpdateOne(ctx, query, bson.M{
+		"$set": field,
+		"$inc": bson.M{"tokenVersion": 1},
+	})
+	if err != nil {
+		logrus.Error("UpdatePartialSuperadminBumpTokenVersion UpdateOne:", err)
+		return
+	}
+
+	matched = result.MatchedCount > 0
+	return
+}
+
+// UseSuperadminTwoFactorStep records the accepted time step only while it is newer than the last used one,
+// so the same authenticator code can never be accepted twice
+func (r *mongoDbRepo) UseSuperadminTwoFactorStep(ctx context.Context, id string, step int64, now time.Time) (matched bool, err error) {
+	obj, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		logrus.Error("Invalid Superadmin ID:", err)
+		return
+	}
+
+	result, err := r.Conn.Collection(r.superadminCollection).UpdateOne(ctx, bson.M{
+		"_id":                    obj,
+		"twoFactor.isEnabled":    true,
+		"twoFactor.lastUsedStep": bson.M{"$lt": step},
+		"deletedAt":              nil,
+	}, bson.M{
+		"$set": bson.M{
+			"twoFactor.lastUsedStep": step,
+			"updatedAt":              now,
+		},
+	})
+	if err != nil {
+		logrus.Error("UseSuperadminTwoFactorStep UpdateOne:", err)
+		return
+	}
+
+	matched = result.MatchedCount > 0
+	return
+}
+
+// UseSuperadminRecoveryCode removes the recovery code only while it is still unused
+func (r *mongoDbRepo) UseSuperadminRecoveryCode(ctx context.Context, id string, recoveryCodeHash string, now time.Time) (matched bool, err error) {
+	obj, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		logrus.Error("Invalid Superadmin ID:", err)
+		return
+	}
+
+	result, err := r.Conn.Collection(r.superadminCollection).UpdateOne(ctx, bson.M{
+		"_id":                     obj,
+		"twoFactor.isEnabled":     true,
+		"twoFactor.recoveryCodes": recoveryCodeHash,
+		"deletedAt":               nil,
+	}, bson.M{
+		"$pull": bson.M{"twoFactor.recoveryCodes": recoveryCodeHash},
+		"$set":  bson.M{"updatedAt": now},
+	})
+	if err != nil {
+		logrus.Error("UseSuperadminRecoveryCode UpdateOne:", err)
+		return
+	}
+
+	matched = result.MatchedCount > 0
+	return
+}
+
+func (r *mongoDbRepo) UpdateManySuperadminPartial(ctx context.Context, options, field map[string]interface{}) (err error) {
+	query, _ := generateQueryFilterSuperadmin(options, false)
+
+	_, err = r.Conn.Collection(r.superadminCollection).UpdateMany(ctx, query, bson.M{"$set": field})
+	if err != nil {
+		logrus.Error("UpdateManySuperadminPartial UpdateMany:", err)
+		return
+	}
+
+	return
+}
@@ -4,6 +4,7 @@ import (
 	mongo_model "app/domain/model/mongo"
 	"app/helpers"
 	"context"
+	"time"
 
 	"github.com/sirupsen/logrus"
 	"go.mongodb.org/mongo-driver/bson"
@@ -26,6 +27,41 @@ func generateQueryFilterTicket(options map[string]interface{}, withOptions bool)
 	if seriesIds, ok := options["seriesIds"]; ok {
 		query["seriesId"] = bson.M{"$in": seriesIds}
 	}
+	if venueId, ok := options["venueId"].(string); ok {
+		// tickets created before the ticket venue only have the venue on their matches
+		query["$or"] = []bson.M{
+			{"venueId": venueId},
+			{"venueId": bson.M{"$in": bson.A{"", nil}}, "matchs.venueId": venueId},
+		}
+	}
+	if missingMatchId, ok := options["missingMatchId"].(bool); ok && missingMatchId {
+		// ticket days saved before matches were kept in their own collection
+		query["matchs"] = bson.M{"$elemMatch": bson.M{"matchId": bson.M{"$in": bson.A{"", nil}}}}
+	}
+	if isCancelled, ok := options["isCancelled"].(bool); ok {
+		if isCancelled {
+			query["isCancelled"] = true
+		} else {
+			query["isCancelled"] = bson.M{"$ne": true}
+		}
+	}
+	if today, ok := options["today"].(bool); ok && today {
+		now := time.Now()
+		query["date"] = bson.M{
+			"$gte": helpers.SetToStartOfDayWIB(now),
+			"$lte": helpers.SetToEndOfDayWIB(now),
+		}
+	}
+	dateQuery := bson.M{}
+	if dateFrom, ok := options["dateFrom"].(time.Time); ok {
+		dateQuery["$gte"] = dateFrom
+	}
+	if dateTo, ok := options["dateTo"].(time.Time); ok {
+		dateQuery["$lte"] = dateTo
+	}
+	if len(dateQuery) > 0 {
+		query["date"] = dateQuery
+	}
 
 	return query, mongoOptions
 }
@@ -124,3 +160,57 @@ func (r *mongoDbRepo) IncrementOneTicket(ctx context.Context, id string, payload
 	}
 	return
 }
+
+// ReserveTicketQuota takes amount from the ticket quota only when the stock still covers it
+func (r *mongoDbRepo) ReserveTicketQuota(ctx context.Context, id string, amount int64) (matched bool, err error) {
+	obj, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		logrus.Error("Invalid ticket ID:", err)
+		return
+	}
+
+	result, err := r.Conn.Collection(r.ticketCollection).UpdateOne(ctx, bson.M{
+		"_id": obj,
+		"$expr": bson.M{"$lte": bson.A{
+			bson.M{"$add": bson.A{"$quota.used", amount}},
+			"$quota.stock",
+		}},
+	}, bson.M{
+		"$inc": bson.M{"quota.used": amount},
+	})
+	if err != nil {
+		logrus.Error("ReserveTicketQuota UpdateOne:", err)
+		return
+	}
+
+	matched = result.MatchedCount > 0
+	return
+}
+
+func (r *mongoDbRepo) CancelOneTicket(ctx context.Context, id string, reason string, now time.Time) (matched bool, err error) {
+	obj, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		logrus.Error("Invalid ticket ID:", err)
+		return
+	}
+
+	result, err := r.Conn.Collection(r.ticketCollection).UpdateOne(ctx, bson.M{
+		"_id":         obj,
+		"deletedAt":   nil,
+		"isCancelled": bson.M{"$ne": true},
+	}, bson.M{
+		"$set": bson.M{
+			"isCancelled":  true,
+			"cancelledAt":  now,
+			"cancelReason": reason,
+			"updatedAt":    now,
+		},
+	})
+	if err != nil {
+		logrus.Error("CancelOneTicket UpdateOne:", err)
+		return
+	}
+
+	matched = result.MatchedCount > 0
+	return
+}
@@ -0,0 +1,123 @@
+package mongo_repository
+
+import (
+	mongo_model "app/domain/model/mongo"
+	"app/helpers"
+	"context"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	moptions "go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func generateQueryFilterTicketCancellationJob(options map[string]interface{}, withOptions bool) (query bson.M, mongoOptions *moptions.FindOptions) {
+	// common filter and find options
+	query = helpers.CommonFilter(options)
+	if withOptions {
+		mongoOptions = helpers.CommonMongoFindOptions(options)
+	}
+
+	// custom filter
+	if ticketId, ok := options["ticketId"].(string); ok {
+		query["ticket.id"] = ticketId
+	}
+	if seriesId, ok := options["seriesId"].(string); ok {
+		query["series.id"] = seriesId
+	}
+	if status, ok := options["status"].(mongo_model.JobStatus); ok {
+		query["status"] = status
+	}
+
+	return query, mongoOptions
+}
+
+func (r *mongoDbRepo) FetchListTicketCancellationJob(ctx context.Context, options map[string]interface{}) (cur *mongo.Cursor, err error) {
+	query, findOptions := generateQueryFilterTicketCancellationJob(options, true)
+
+	cur, err = r.Conn.Collection(r.ticketCancellationJobCollection).Find(ctx, query, findOptions)
+	if err != nil {
+		logrus.Error("FetchListTicketCancellationJob Find:", err)
+		return
+	}
+
+	return
+}
+
+func (r *mongoDbRepo) CountTicketCancellationJob(ctx context.Context, options map[string]interface{}) (total int64) {
+	query, _ := generateQueryFilterTicketCancellationJob(options, true)
+
+	total, err := r.Conn.Collection(r.ticketCancellationJobCollection).CountDocuments(ctx, query)
+	if err != nil {
+		logrus.Error("CountTicketCancellationJob CountDocuments:", err)
+		return 0
+	}
+
+	return
+}
+
+func (r *mongoDbRepo) FetchOneTicketCancellationJob(ctx context.Context, options map[string]interface{}) (row *mongo_model.TicketCancellationJob, err error) {
+	query, _ := generateQueryFilterTicketCancellationJob(options, false)
+
+	err = r.Conn.Collection(r.ticketCancellationJobCollection).FindOne(ctx, query).Decode(&row)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			err = nil
+			return
+		}
+
+		logrus.Error("FetchOneTicketCancellationJob FindOne:", err)
+		return
+	}
+
+	return
+}
+
+func (r *mongoDbRepo) CreateOneTicketCancellationJob(ctx context.Context, job *mongo_model.TicketCancellationJob) (err error) {
+	_, err = r.Conn.Collection(r.ticketCancellationJobCollection).InsertOne(ctx, job)
+	if err != nil {
+		logrus.Error("CreateOneTicketCancellationJob InsertOne:", err)
+		return
+	}
+	return
+}
+
+func (r *mongoDbRepo) UpdatePartialTicketCancellationJob(ctx context.Context, options, field map[string]interface{}) (err error) {
+	query, _ := generateQueryFilterTicketCancellationJob(options, false)
+
+	_, err = r.Conn.Collection(r.ticketCancellationJobCollection).UpdateOne(ctx, query, bson.M{"$set": field})
+	if err != nil {
+		logrus.Error("UpdatePartialTicketCancellationJob UpdateOne:", err)
+		return
+	}
+
+	return
+}
+
+// ClaimTicketCancellationJob takes over a running job nobody saved progress for since staleBefore,
+// only one replica can claim it
+func (r *mongoDbRepo) ClaimTicketCancellationJob(ctx context.Context, id string, staleBefore, now time.Time) (matched bool, err error) {
+	obj, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		logrus.Error("Invalid ticket cancellation job ID:", err)
+		return
+	}
+
+	result, err := r.Conn.Collection(r.ticketCancellationJobCollection).UpdateOne(ctx, bson.M{
+		"_id":       obj,
+		"status":    mongo_model.JobStatusRunning,
+		"updatedAt": bson.M{"$lt": staleBefore},
+		"deletedAt": nil,
+	}, bson.M{
+		"$set": bson.M{"updatedAt": now},
+	})
+	if err != nil {
+		logrus.Error("ClaimTicketCancellationJob UpdateOne:", err)
+		return
+	}
+
+	matched = result.MatchedCount > 0
+	return
+}
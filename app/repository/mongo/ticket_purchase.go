@@ -8,6 +8,7 @@ import (
 
 	"github.com/sirupsen/logrus"
 	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo"
 	moptions "go.mongodb.org/mongo-driver/mongo/options"
 )
@@ -26,6 +27,9 @@ func generateQueryFilterTicketPurchase(options map[string]interface{}, withOptio
 	if code, ok := options["code"].(string); ok {
 		query["code"] = code
 	}
+	if revokedCode, ok := options["revokedCode"].(string); ok {
+		query["revokedCodes"] = revokedCode
+	}
 	if today, ok := options["today"].(bool); ok {
 		now := time.Now()
 		loc, _ := time.LoadLocation("Asia/Jakarta")
@@ -38,6 +42,22 @@ func generateQueryFilterTicketPurchase(options map[string]interface{}, withOptio
 			}
 		}
 	}
+	if purchaseId, ok := options["purchaseId"].(string); ok {
+		query["purchaseId"] = purchaseId
+	}
+	if ticketId, ok := options["ticketId"].(string); ok {
+		query["ticket.id"] = ticketId
+	}
+	if isVoided, ok := options["isVoided"].(bool); ok {
+		if isVoided {
+			query["isVoided"] = true
+		} else {
+			query["isVoided"] = bson.M{"$ne": true}
+		}
+	}
+	if venueId, ok := options["venueId"].(string); ok {
+		query["venue.id"] = venueId
+	}
 	if isUsed, ok := options["isUsed"].(bool); ok {
 		query["isUsed"] = isUsed
 	}
@@ -110,6 +130,44 @@ func (r *mongoDbRepo) CreateManyTicketPurchase(ctx context.Context, ticketPurcha
 	return
 }
 
+// CreateManyTicketPurchaseSkipDuplicate inserts the ticket purchases which were not issued yet, a seat already issued
+// is rejected by the unique purchase ticket seat index and left out of inserted
+func (r *mongoDbRepo) CreateManyTicketPurchaseSkipDuplicate(ctx context.Context, ticketPurchases []*mongo_model.TicketPurchase) (inserted []*mongo_model.TicketPurchase, err error) {
+	if len(ticketPurchases) == 0 {
+		return
+	}
+
+	docs := make([]interface{}, len(ticketPurchases))
+	for i, row := range ticketPurchases {
+		docs[i] = row
+	}
+
+	duplicates := make(map[int]struct{})
+	_, err = r.Conn.Collection(r.ticketPurchaseCollection).InsertMany(ctx, docs, moptions.InsertMany().SetOrdered(false))
+	if err != nil {
+		bulkErr, ok := err.(mongo.BulkWriteException)
+		if !ok || bulkErr.WriteConcernError != nil {
+			logrus.Error("CreateManyTicketPurchaseSkipDuplicate InsertMany:", err)
+			return
+		}
+		for _, writeErr := range bulkErr.WriteErrors {
+			if !mongo.IsDuplicateKeyError(writeErr) {
+				logrus.Error("CreateManyTicketPurchaseSkipDuplicate InsertMany:", err)
+				return
+			}
+			duplicates[writeErr.Index] = struct{}{}
+		}
+		err = nil
+	}
+
+	for i, row := range ticketPurchases {
+		if _, ok := duplicates[i]; !ok {
+			inserted = append(inserted, row)
+		}
+	}
+	return
+}
+
 func (r *mongoDbRepo) UpdatePartialTicketPurchase(ctx context.Context, options, field map[string]interface{}) (err error) {
 	query, _ := generateQueryFilterTicketPurchase(options, false)
 
@@ -133,3 +191,30 @@ func (r *mongoDbRepo) UpdateManyTicketPurchasePartial(ctx context.Context, optio
 
 	return
 }
+
+// ReissueTicketPurchaseCode swaps the code only while it is still the current one, the old code is pushed to the revoked codes
+func (r *mongoDbRepo) ReissueTicketPurchaseCode(ctx context.Context, id string, oldCode, newCode string, now time.Time) (matched bool, err error) {
+	obj, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		logrus.Error("Invalid ticket purchase ID:", err)
+		return
+	}
+
+	result, err := r.Conn.Collection(r.ticketPurchaseCollection).UpdateOne(ctx, bson.M{
+		"_id":       obj,
+		"code":      oldCode,
+		"isUsed":    false,
+		"isVoided":  bson.M{"$ne": true},
+		"deletedAt": nil,
+	}, bson.M{
+		"$set":  bson.M{"code": newCode, "updatedAt": now},
+		"$push": bson.M{"revokedCodes": oldCode},
+	})
+	if err != nil {
+		logrus.Error("ReissueTicketPurchaseCode UpdateOne:", err)
+		return
+	}
+
+	matched = result.MatchedCount > 0
+	return
+}
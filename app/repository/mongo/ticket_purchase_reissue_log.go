@@ -0,0 +1,78 @@
+package mongo_repository
+
+import (
+	mongo_model "app/domain/model/mongo"
+	"app/helpers"
+	"context"
+
+	"github.com/sirupsen/logrus"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	moptions "go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func generateQueryFilterTicketPurchaseReissueLog(options map[string]interface{}, withOptions bool) (query bson.M, mongoOptions *moptions.FindOptions) {
+	// common filter and find options
+	query = helpers.CommonFilter(options)
+	if withOptions {
+		mongoOptions = helpers.CommonMongoFindOptions(options)
+	}
+
+	// custom filter
+	if ticketPurchaseId, ok := options["ticketPurchaseId"].(string); ok {
+		query["ticketPurchaseId"] = ticketPurchaseId
+	}
+	if purchaseId, ok := options["purchaseId"].(string); ok {
+		query["purchaseId"] = purchaseId
+	}
+	if memberId, ok := options["memberId"].(string); ok {
+		query["member.id"] = memberId
+	}
+
+	return query, mongoOptions
+}
+
+func (r *mongoDbRepo) FetchListTicketPurchaseReissueLog(ctx context.Context, options map[string]interface{}) (cur *mongo.Cursor, err error) {
+	query, findOptions := generateQueryFilterTicketPurchaseReissueLog(options, true)
+
+	cur, err = r.Conn.Collection(r.ticketPurchaseReissueLogCollection).Find(ctx, query, findOptions)
+	if err != nil {
+		logrus.Error("FetchListTicketPurchaseReissueLog Find:", err)
+		return
+	}
+
+	return
+}
+
+func (r *mongoDbRepo) CountTicketPurchaseReissueLog(ctx context.Context, options map[string]interface{}) (total int64) {
+	query, _ := generateQueryFilterTicketPurchaseReissueLog(options, true)
+
+	total, err := r.Conn.Collection(r.ticketPurchaseReissueLogCollection).CountDocuments(ctx, query)
+	if err != nil {
+		logrus.Error("CountTicketPurchaseReissueLog CountDocuments:", err)
+		return 0
+	}
+
+	return
+}
+
+func (r *mongoDbRepo) CreateOneTicketPurchaseReissueLog(ctx context.Context, reissueLog *mongo_model.TicketPurchaseReissueLog) (err error) {
+	_, err = r.Conn.Collection(r.ticketPurchaseReissueLogCollection).InsertOne(ctx, reissueLog)
+	if err != nil {
+		logrus.Error("CreateOneTicketPurchaseReissueLog InsertOne:", err)
+		return
+	}
+	return
+}
+
+func (r *mongoDbRepo) UpdateManyTicketPurchaseReissueLogPartial(ctx context.Context, options, field map[string]interface{}) (err error) {
+	query, _ := generateQueryFilterTicketPurchaseReissueLog(options, false)
+
+	_, err = r.Conn.Collection(r.ticketPurchaseReissueLogCollection).UpdateMany(ctx, query, bson.M{"$set": field})
+	if err != nil {
+		logrus.Error("UpdateManyTicketPurchaseReissueLogPartial UpdateMany:", err)
+		return
+	}
+
+	return
+}
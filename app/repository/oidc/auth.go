@@ -0,0 +1,127 @@
+package oidc_repository
+
+import (
+	oidc_model "app/domain/model/oidc"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/sirupsen/logrus"
+)
+
+func (r *oidcRepo) AuthorizationURL(ctx context.Context, state, nonce, codeChallenge string) (string, error) {
+	metadata, err := r.providerMetadata(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	authorizationURL, err := url.Parse(metadata.AuthorizationEndpoint)
+	if err != nil {
+		return "", err
+	}
+	query := authorizationURL.Query()
+	query.Set("response_type", "code")
+	query.Set("client_id", r.clientID)
+	query.Set("redirect_uri", r.redirectURL)
+	query.Set("scope", r.scopes)
+	query.Set("state", state)
+	query.Set("nonce", nonce)
+	query.Set("code_challenge", codeChallenge)
+	query.Set("code_challenge_method", "S256")
+	authorizationURL.RawQuery = query.Encode()
+
+	return authorizationURL.String(), nil
+}
+
+func (r *oidcRepo) ExchangeCode(ctx context.Context, code, codeVerifier string) (*oidc_model.TokenResponse, error) {
+	metadata, err := r.providerMetadata(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	form := url.Values{}
+	form.Set("grant_type", "authorization_code")
+	form.Set("code", code)
+	form.Set("redirect_uri", r.redirectURL)
+	form.Set("client_id", r.clientID)
+	form.Set("code_verifier", codeVerifier)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, metadata.TokenEndpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Add("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Add("Accept", "application/json")
+	if r.clientSecret != "" {
+		req.SetBasicAuth(url.QueryEscape(r.clientID), url.QueryEscape(r.clientSecret))
+	}
+
+	res, err := r.Client.Do(req)
+	if err != nil {
+		logrus.Error("OIDC Exchange Code:", err)
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, err
+	}
+	if res.StatusCode != http.StatusOK {
+		failed := oidc_model.TokenResponseError{}
+		_ = json.Unmarshal(body, &failed)
+		logrus.Error("OIDC Exchange Code Response:", res.StatusCode, failed)
+		if failed.ErrorDescription != "" {
+			return nil, errors.New(failed.ErrorDescription)
+		}
+		return nil, errors.New("Authorization code exchange failed")
+	}
+
+	token := &oidc_model.TokenResponse{}
+	if err := json.Unmarshal(body, token); err != nil {
+		return nil, err
+	}
+	if token.IDToken == "" {
+		return nil, errors.New("Provider did not return an id token")
+	}
+
+	return token, nil
+}
+
+// VerifyIDToken check the signature, issuer, audience, expiry and nonce of the id token
+func (r *oidcRepo) VerifyIDToken(ctx context.Context, rawIDToken, nonce string) (*oidc_model.IDTokenClaims, error) {
+	metadata, err := r.providerMetadata(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	claims := &oidc_model.IDTokenClaims{}
+	_, err = jwt.ParseWithClaims(rawIDToken, claims, func(token *jwt.Token) (interface{}, error) {
+		kid, _ := token.Header["kid"].(string)
+		return r.signingKey(ctx, kid)
+	},
+		jwt.WithValidMethods([]string{"RS256", "RS384", "RS512", "ES256", "ES384", "ES512"}),
+		jwt.WithIssuer(metadata.Issuer),
+		jwt.WithAudience(r.clientID),
+		jwt.WithExpirationRequired(),
+		jwt.WithIssuedAt(),
+		jwt.WithLeeway(time.Minute),
+	)
+	if err != nil {
+		return nil, err
+	}
+	if claims.Subject == "" {
+		return nil, errors.New("ID token has no subject")
+	}
+	if claims.Nonce != nonce {
+		return nil, errors.New("ID token nonce mismatch")
+	}
+
+	return claims, nil
+}
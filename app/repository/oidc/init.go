@@ -0,0 +1,47 @@
+package oidc_repository
+
+import (
+	"app/domain"
+	oidc_model "app/domain/model/oidc"
+	"crypto"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+type oidcRepo struct {
+	Client       *http.Client
+	issuerURL    string
+	clientID     string
+	clientSecret string
+	redirectURL  string
+	scopes       string
+
+	// discovery document and signing keys are cached, keys are refetched when an unknown kid shows up
+	mu          sync.Mutex
+	metadata    *oidc_model.ProviderMetadata
+	keys        map[string]crypto.PublicKey
+	refreshedAt time.Time
+}
+
+func NewOIDCRepo() domain.OIDCRepo {
+	scopes := os.Getenv("OIDC_SCOPES")
+	if scopes == "" {
+		scopes = "openid email profile"
+	}
+
+	return &oidcRepo{
+		Client:       &http.Client{Timeout: 10 * time.Second},
+		issuerURL:    strings.TrimSuffix(os.Getenv("OIDC_ISSUER_URL"), "/"),
+		clientID:     os.Getenv("OIDC_CLIENT_ID"),
+		clientSecret: os.Getenv("OIDC_CLIENT_SECRET"),
+		redirectURL:  os.Getenv("OIDC_REDIRECT_URL"),
+		scopes:       scopes,
+	}
+}
+
+func (r *oidcRepo) IsEnabled() bool {
+	return r.issuerURL != "" && r.clientID != "" && r.redirectURL != ""
+}
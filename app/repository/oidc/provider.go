@@ -0,0 +1,192 @@
+package oidc_repository
+
+import (
+	oidc_model "app/domain/model/oidc"
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+const providerCacheTTL = time.Hour
+
+func (r *oidcRepo) getJSON(ctx context.Context, endpoint string, result any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Add("Accept", "application/json")
+
+	res, err := r.Client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return err
+	}
+	if res.StatusCode != http.StatusOK {
+		return fmt.Errorf("GET %s returned status %d", endpoint, res.StatusCode)
+	}
+
+	return json.Unmarshal(body, result)
+}
+
+// providerMetadata returns the cached discovery document, the issuer must match the configured one
+func (r *oidcRepo) providerMetadata(ctx context.Context) (*oidc_model.ProviderMetadata, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.metadata != nil && time.Since(r.refreshedAt) < providerCacheTTL {
+		return r.metadata, nil
+	}
+
+	metadata := &oidc_model.ProviderMetadata{}
+	if err := r.getJSON(ctx, r.issuerURL+"/.well-known/openid-configuration", metadata); err != nil {
+		logrus.Error("OIDC Discovery:", err)
+		return nil, err
+	}
+	if strings.TrimSuffix(metadata.Issuer, "/") != r.issuerURL {
+		return nil, fmt.Errorf("OIDC discovery issuer %s does not match %s", metadata.Issuer, r.issuerURL)
+	}
+	if metadata.AuthorizationEndpoint == "" || metadata.TokenEndpoint == "" || metadata.JwksURI == "" {
+		return nil, errors.New("OIDC discovery document is incomplete")
+	}
+
+	keys, err := r.fetchKeys(ctx, metadata.JwksURI)
+	if err != nil {
+		return nil, err
+	}
+
+	r.metadata = metadata
+	r.keys = keys
+	r.refreshedAt = time.Now()
+
+	return r.metadata, nil
+}
+
+// signingKey returns the key for kid, the key set is refetched once when the kid is unknown
+func (r *oidcRepo) signingKey(ctx context.Context, kid string) (crypto.PublicKey, error) {
+	metadata, err := r.providerMetadata(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if key := findKey(r.keys, kid); key != nil {
+		return key, nil
+	}
+
+	keys, err := r.fetchKeys(ctx, metadata.JwksURI)
+	if err != nil {
+		return nil, err
+	}
+	r.keys = keys
+
+	if key := findKey(r.keys, kid); key != nil {
+		return key, nil
+	}
+
+	return nil, fmt.Errorf("OIDC signing key %s not found", kid)
+}
+
+func findKey(keys map[string]crypto.PublicKey, kid string) crypto.PublicKey {
+	if key, ok := keys[kid]; ok {
+		return key
+	}
+	// a token without kid is accepted only when the provider has a single key
+	if kid == "" && len(keys) == 1 {
+		for _, key := range keys {
+			return key
+		}
+	}
+	return nil
+}
+
+func (r *oidcRepo) fetchKeys(ctx context.Context, jwksURI string) (map[string]crypto.PublicKey, error) {
+	keySet := oidc_model.JSONWebKeySet{}
+	if err := r.getJSON(ctx, jwksURI, &keySet); err != nil {
+		logrus.Error("OIDC JWKS:", err)
+		return nil, err
+	}
+
+	keys := make(map[string]crypto.PublicKey)
+	for _, jwk := range keySet.Keys {
+		if jwk.Use != "" && jwk.Use != "sig" {
+			continue
+		}
+		key, err := parseJSONWebKey(jwk)
+		if err != nil {
+			logrus.Warn("OIDC JWKS skip key ", jwk.Kid, ": ", err)
+			continue
+		}
+		keys[jwk.Kid] = key
+	}
+
+	return keys, nil
+}
+
+func parseJSONWebKey(jwk oidc_model.JSONWebKey) (crypto.PublicKey, error) {
+	switch jwk.Kty {
+	case "RSA":
+		n, err := base64.RawURLEncoding.DecodeString(jwk.N)
+		if err != nil {
+			return nil, err
+		}
+		e, err := base64.RawURLEncoding.DecodeString(jwk.E)
+		if err != nil {
+			return nil, err
+		}
+		return &rsa.PublicKey{
+			N: new(big.Int).SetBytes(n),
+			E: int(new(big.Int).SetBytes(e).Int64()),
+		}, nil
+	case "EC":
+		var curve elliptic.Curve
+		switch jwk.Crv {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		case "P-521":
+			curve = elliptic.P521()
+		default:
+			return nil, fmt.Errorf("unsupported curve %s", jwk.Crv)
+		}
+		x, err := base64.RawURLEncoding.DecodeString(jwk.X)
+		if err != nil {
+			return nil, err
+		}
+		y, err := base64.RawURLEncoding.DecodeString(jwk.Y)
+		if err != nil {
+			return nil, err
+		}
+		key := &ecdsa.PublicKey{
+			Curve: curve,
+			X:     new(big.Int).SetBytes(x),
+			Y:     new(big.Int).SetBytes(y),
+		}
+		if !curve.IsOnCurve(key.X, key.Y) {
+			return nil, errors.New("point is not on curve")
+		}
+		return key, nil
+	}
+
+	return nil, fmt.Errorf("unsupported key type %s", jwk.Kty)
+}
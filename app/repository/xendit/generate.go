@@ -17,7 +17,9 @@ import (
 func (r *xenditRepo) GenereteSnapLink(ctx context.Context, purchase mongo_model.Purchase) (result helpers.Response, err error) {
 	itemName := ""
 
-	if purchase.IsCheckoutPackage {
+	if purchase.IsSeasonPass {
+		itemName = fmt.Sprintf("Season Pass (%s)", purchase.Season.Name)
+	} else if purchase.IsCheckoutPackage {
 		itemName = fmt.Sprintf("%s (%s)", purchase.Series.Name, purchase.Season.Name)
 	} else {
 		itemName = purchase.Tickets[0].Name
@@ -0,0 +1,14 @@
+package admin_usecase
+
+import (
+	shared_usecase "app/app/usecase/shared"
+	mongo_model "app/domain/model/mongo"
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// recordAuditLog saves who changed the entity and how, see shared_usecase.RecordAuditLog
+func (u *adminAppUsecase) recordAuditLog(ctx context.Context, action mongo_model.AuditAction, entityType mongo_model.AuditEntity, entityId string, before bson.M, after interface{}) {
+	shared_usecase.RecordAuditLog(ctx, u.mongoDbRepo, action, entityType, entityId, before, after)
+}
@@ -4,16 +4,17 @@ import (
 	"app/domain/request"
 	"app/helpers"
 	jwt_helpers "app/helpers/jwt"
+	mailing_helpers "app/helpers/mailing"
 	"context"
+	"fmt"
 	"net/http"
+	"net/url"
 	"time"
 
-	"github.com/golang-jwt/jwt/v5"
-	"github.com/google/uuid"
 	"golang.org/x/crypto/bcrypt"
 )
 
-func (u *adminAppUsecase) Login(ctx context.Context, payload request.AdminLoginRequest) helpers.Response {
+func (u *adminAppUsecase) Login(ctx context.Context, payload request.AdminLoginRequest, clientIP string) helpers.Response {
 	ctx, cancel := context.WithTimeout(ctx, u.contextTimeout)
 	defer cancel()
 
@@ -29,6 +30,15 @@ func (u *adminAppUsecase) Login(ctx context.Context, payload request.AdminLoginR
 		return helpers.NewResponse(http.StatusUnprocessableEntity, "Validation Error", errValidation, nil)
 	}
 
+	// check failed login backoff and lockout
+	message, err := u.checkLoginAttempt(ctx, payload.Username, clientIP)
+	if err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+	if message != "" {
+		return helpers.NewResponse(http.StatusTooManyRequests, message, nil, nil)
+	}
+
 	// check admin
 	admin, err := u.mongoDbRepo.FetchOneAdmin(ctx, map[string]interface{}{
 		"username": payload.Username,
@@ -37,36 +47,160 @@ func (u *adminAppUsecase) Login(ctx context.Context, payload request.AdminLoginR
 		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
 	}
 	if admin == nil {
+		u.recordLoginFailure(ctx, payload.Username, clientIP, nil)
 		return helpers.NewResponse(http.StatusBadRequest, "User not found", nil, nil)
 	}
+	if admin.IsDisabled {
+		return helpers.NewResponse(http.StatusBadRequest, "Account is disabled", nil, nil)
+	}
+	if admin.Password == "" {
+		return helpers.NewResponse(http.StatusBadRequest, "Password has not been set, please use the invite link", nil, nil)
+	}
 
 	// check password
 	if err := bcrypt.CompareHashAndPassword([]byte(admin.Password), []byte(payload.Password)); err != nil {
+		u.recordLoginFailure(ctx, payload.Username, clientIP, admin)
 		return helpers.NewResponse(http.StatusBadRequest, "Wrong password", nil, nil)
 	}
+	u.clearLoginAttempt(ctx, payload.Username)
 
-	// generate token
+	// record last login
 	now := time.Now()
-	expiredAt := now.Add(time.Duration(jwt_helpers.GetJWTTTL()) * time.Minute)
-	token, err := jwt_helpers.GenerateJWTTokenAdmin(jwt_helpers.AdminJWTClaims{
-		UserID: admin.ID.Hex(),
-		RegisteredClaims: jwt.RegisteredClaims{
-			ID:        uuid.NewString(),
-			Issuer:    "admin",
-			IssuedAt:  jwt.NewNumericDate(now),
-			NotBefore: jwt.NewNumericDate(now),
-			ExpiresAt: jwt.NewNumericDate(expiredAt),
-		},
+	admin.LastLoginAt = &now
+	if err := u.mongoDbRepo.UpdatePartialAdmin(ctx, map[string]interface{}{
+		"id": admin.ID,
+	}, map[string]interface{}{
+		"lastLoginAt": admin.LastLoginAt,
+	}); err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+
+	// create session and generate token
+	result, err := u.createAuthSession(ctx, admin)
+	if err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+	result["user"] = admin
+
+	return helpers.NewResponse(http.StatusOK, "Login successful", nil, result)
+}
+
+func (u *adminAppUsecase) ForgotPassword(ctx context.Context, payload request.ForgotPasswordRequest) helpers.Response {
+	ctx, cancel := context.WithTimeout(ctx, u.contextTimeout)
+	defer cancel()
+
+	// validate payload
+	errValidation := make(map[string]string)
+	if payload.Email == "" {
+		errValidation["email"] = "Email field is required"
+	}
+	if len(errValidation) > 0 {
+		return helpers.NewResponse(http.StatusUnprocessableEntity, "Validation Error", errValidation, nil)
+	}
+
+	// same response whether the account exists or not
+	successMessage := "If the email is registered, a reset password link has been sent"
+
+	// check admin
+	admin, err := u.mongoDbRepo.FetchOneAdmin(ctx, map[string]interface{}{
+		"email": payload.Email,
 	})
 	if err != nil {
 		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
 	}
+	if admin == nil || admin.IsDisabled {
+		return helpers.NewResponse(http.StatusOK, successMessage, nil, nil)
+	}
 
-	return helpers.NewResponse(http.StatusOK, "Login successful", nil, map[string]any{
-		"token":     token,
-		"expiredAt": expiredAt,
-		"user":      admin,
+	// generate password token, only the hash is stored
+	passwordToken, err := helpers.GenerateSecureRandomChar(64)
+	if err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+	now := time.Now()
+	expiredAt := now.Add(time.Duration(helpers.GetPasswordResetTTL()) * time.Minute)
+
+	// save
+	if err := u.mongoDbRepo.UpdatePartialAdmin(ctx, map[string]interface{}{
+		"id": admin.ID,
+	}, map[string]interface{}{
+		"passwordToken":          helpers.HashToken(passwordToken),
+		"passwordTokenExpiredAt": expiredAt,
+		"updatedAt":              now,
+	}); err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+
+	// send email reset password
+	resetLink := fmt.Sprintf(
+		"%s/admin/reset-password/%s",
+		helpers.GetFEUrl(),
+		url.PathEscape(passwordToken))
+	go mailing_helpers.SendResetPassword(admin.Name, admin.Email, resetLink)
+
+	return helpers.NewResponse(http.StatusOK, successMessage, nil, nil)
+}
+
+func (u *adminAppUsecase) ResetPassword(ctx context.Context, payload request.ResetPasswordRequest) helpers.Response {
+	ctx, cancel := context.WithTimeout(ctx, u.contextTimeout)
+	defer cancel()
+
+	// validate payload
+	errValidation := make(map[string]string)
+	if payload.Token == "" {
+		errValidation["token"] = "Token field is required"
+	}
+	if payload.Password == "" {
+		errValidation["password"] = "Password field is required"
+	} else {
+		if !helpers.IsValidLengthPassword(payload.Password) {
+			errValidation["password"] = "Password must be at least 8 characters"
+		} else if !helpers.IsStrongPassword(payload.Password) {
+			errValidation["password"] = "Password must contain at least one uppercase letter, one lowercase letter, and one number"
+		}
+	}
+	if len(errValidation) > 0 {
+		return helpers.NewResponse(http.StatusUnprocessableEntity, "Validation Error", errValidation, nil)
+	}
+
+	// check admin
+	hashedToken := helpers.HashToken(payload.Token)
+	admin, err := u.mongoDbRepo.FetchOneAdmin(ctx, map[string]interface{}{
+		"passwordToken": hashedToken,
 	})
+	if err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+	if admin == nil {
+		return helpers.NewResponse(http.StatusBadRequest, "Token is invalid", nil, nil)
+	}
+	now := time.Now()
+	if admin.PasswordTokenExpiredAt == nil || now.After(*admin.PasswordTokenExpiredAt) {
+		return helpers.NewResponse(http.StatusBadRequest, "Token is expired", nil, nil)
+	}
+
+	// hash password
+	hashedPassword, _ := bcrypt.GenerateFromPassword([]byte(payload.Password), bcrypt.DefaultCost)
+
+	// clear the token so it can only be used once, and bump token version to revoke older sessions
+	matched, err := u.mongoDbRepo.UpdatePartialAdminBumpTokenVersion(ctx, map[string]interface{}{
+		"id":            admin.ID,
+		"passwordToken": hashedToken,
+	}, map[string]interface{}{
+		"password":               string(hashedPassword),
+		"passwordToken":          "",
+		"passwordTokenExpiredAt": nil,
+		"passwordChangedAt":      now,
+		"updatedAt":              now,
+	})
+	if err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+	if !matched {
+		return helpers.NewResponse(http.StatusBadRequest, "Token is invalid", nil, nil)
+	}
+
+	return helpers.NewResponse(http.StatusOK, "Reset password successful", nil, nil)
 }
 
 func (u *adminAppUsecase) GetProfile(ctx context.Context, claim jwt_helpers.AdminJWTClaims) helpers.Response {
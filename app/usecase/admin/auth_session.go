@@ -0,0 +1,81 @@
+package admin_usecase
+
+import (
+	shared_usecase "app/app/usecase/shared"
+	mongo_model "app/domain/model/mongo"
+	"app/domain/request"
+	"app/helpers"
+	jwt_helpers "app/helpers/jwt"
+	"context"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+)
+
+// authSession the admin side of the shared device session flow
+func (u *adminAppUsecase) authSession() shared_usecase.AuthSessionActor {
+	return shared_usecase.AuthSessionActor{
+		Role: mongo_model.ActorRoleAdmin,
+		FetchUser: func(ctx context.Context, userId string) (*shared_usecase.AuthSessionUser, error) {
+			admin, err := u.mongoDbRepo.FetchOneAdmin(ctx, map[string]interface{}{
+				"id": userId,
+			})
+			if err != nil || admin == nil {
+				return nil, err
+			}
+			return &shared_usecase.AuthSessionUser{TokenVersion: admin.TokenVersion, IsDisabled: admin.IsDisabled}, nil
+		},
+		BumpTokenVersion: func(ctx context.Context, userId string, now time.Time) (bool, error) {
+			return u.mongoDbRepo.UpdatePartialAdminBumpTokenVersion(ctx, map[string]interface{}{
+				"id": userId,
+			}, map[string]interface{}{
+				"updatedAt": now,
+			})
+		},
+		GenerateToken: generateAdminToken,
+	}
+}
+
+// createAuthSession start a new device session and issue its first access and refresh token
+func (u *adminAppUsecase) createAuthSession(ctx context.Context, admin *mongo_model.Admin) (map[string]any, error) {
+	return u.authSession().Create(ctx, u.mongoDbRepo, admin.ID.Hex(), admin.TokenVersion)
+}
+
+func generateAdminToken(ctx context.Context, session *mongo_model.AuthSession, now time.Time) (string, time.Time, map[string]any, error) {
+	expiredAt := now.Add(time.Duration(jwt_helpers.GetJWTTTL()) * time.Minute)
+	token, err := jwt_helpers.GenerateJWTTokenAdmin(jwt_helpers.AdminJWTClaims{
+		UserID:       session.UserID,
+		SessionID:    session.ID.Hex(),
+		TokenVersion: session.TokenVersion,
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        uuid.NewString(),
+			Issuer:    "admin",
+			IssuedAt:  jwt.NewNumericDate(now),
+			NotBefore: jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(expiredAt),
+		},
+	})
+	return token, expiredAt, nil, err
+}
+
+func (u *adminAppUsecase) RefreshToken(ctx context.Context, payload request.RefreshTokenRequest) helpers.Response {
+	ctx, cancel := context.WithTimeout(ctx, u.contextTimeout)
+	defer cancel()
+
+	return u.authSession().Refresh(ctx, u.mongoDbRepo, payload)
+}
+
+func (u *adminAppUsecase) Logout(ctx context.Context, claim jwt_helpers.AdminJWTClaims) helpers.Response {
+	ctx, cancel := context.WithTimeout(ctx, u.contextTimeout)
+	defer cancel()
+
+	return u.authSession().Logout(ctx, u.mongoDbRepo, claim.SessionID, claim.UserID)
+}
+
+func (u *adminAppUsecase) LogoutAllDevices(ctx context.Context, claim jwt_helpers.AdminJWTClaims) helpers.Response {
+	ctx, cancel := context.WithTimeout(ctx, u.contextTimeout)
+	defer cancel()
+
+	return u.authSession().LogoutAllDevices(ctx, u.mongoDbRepo, claim.UserID)
+}
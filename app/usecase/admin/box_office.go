@@ -0,0 +1,368 @@
+package admin_usecase
+
+import (
+	shared_usecase "app/app/usecase/shared"
+	mongo_model "app/domain/model/mongo"
+	"app/domain/request"
+	"app/helpers"
+	jwt_helpers "app/helpers/jwt"
+	"context"
+	"encoding/base64"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func (u *adminAppUsecase) GetBoxOfficeTicketsList(ctx context.Context, claim jwt_helpers.AdminJWTClaims) helpers.Response {
+	ctx, cancel := context.WithTimeout(ctx, u.contextTimeout)
+	defer cancel()
+
+	// check admin
+	admin, err := u.mongoDbRepo.FetchOneAdmin(ctx, map[string]interface{}{
+		"id": claim.UserID,
+	})
+	if err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+	if admin == nil {
+		return helpers.NewResponse(http.StatusBadRequest, "User not found", nil, nil)
+	}
+	if admin.Venue.ID == "" {
+		return helpers.NewResponse(http.StatusBadRequest, "Admin is not assigned to any venue", nil, nil)
+	}
+
+	// fetch today tickets at admin venue
+	cur, err := u.mongoDbRepo.FetchListTicket(ctx, map[string]interface{}{
+		"today":       true,
+		"venueId":     admin.Venue.ID,
+		"isCancelled": false,
+	})
+	if err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+	defer cur.Close(ctx)
+
+	list := make([]interface{}, 0)
+	for cur.Next(ctx) {
+		row := mongo_model.Ticket{}
+		err := cur.Decode(&row)
+		if err != nil {
+			logrus.Error("Ticket Decode:", err)
+			return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+		}
+
+		list = append(list, row.Format())
+	}
+
+	return helpers.NewResponse(http.StatusOK, "Success", nil, map[string]interface{}{
+		"venue": admin.Venue,
+		"list":  list,
+	})
+}
+
+func (u *adminAppUsecase) CreateBoxOfficeSale(ctx context.Context, claim jwt_helpers.AdminJWTClaims, payload request.BoxOfficeSaleRequest) helpers.Response {
+	ctx, cancel := context.WithTimeout(ctx, u.contextTimeout)
+	defer cancel()
+
+	// validate payload
+	errValidation := make(map[string]string)
+	if payload.TicketID == "" {
+		errValidation["ticketId"] = "Ticket ID field is required"
+	}
+	if payload.Amount <= 0 {
+		errValidation["amount"] = "Amount field is required"
+	}
+	paymentMethod := mongo_model.BoxOfficePaymentMethod(payload.PaymentMethod)
+	switch paymentMethod {
+	case mongo_model.BoxOfficePaymentMethodCash:
+	case mongo_model.BoxOfficePaymentMethodEdc:
+		if payload.EdcReference == "" {
+			errValidation["edcReference"] = "EDC reference field is required for EDC payment"
+		}
+	case "":
+		errValidation["paymentMethod"] = "Payment method field is required"
+	default:
+		errValidation["paymentMethod"] = "Payment method must be cash or edc"
+	}
+	if payload.BuyerEmail != "" && !helpers.IsValidEmail(payload.BuyerEmail) {
+		errValidation["buyerEmail"] = "Invalid email format"
+	}
+	if len(errValidation) > 0 {
+		return helpers.NewResponse(http.StatusUnprocessableEntity, "Validation Error", errValidation, nil)
+	}
+
+	// check admin
+	admin, err := u.mongoDbRepo.FetchOneAdmin(ctx, map[string]interface{}{
+		"id": claim.UserID,
+	})
+	if err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+	if admin == nil {
+		return helpers.NewResponse(http.StatusBadRequest, "User not found", nil, nil)
+	}
+	if admin.Venue.ID == "" {
+		return helpers.NewResponse(http.StatusBadRequest, "Admin is not assigned to any venue", nil, nil)
+	}
+
+	// check ticket, only today ticket at admin venue can be sold
+	ticket, err := u.mongoDbRepo.FetchOneTicket(ctx, map[string]interface{}{
+		"id":          payload.TicketID,
+		"today":       true,
+		"venueId":     admin.Venue.ID,
+		"isCancelled": false,
+	})
+	if err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+	if ticket == nil {
+		return helpers.NewResponse(http.StatusBadRequest, "Ticket not found for today at your venue", nil, nil)
+	}
+
+	// check series
+	series, err := u.mongoDbRepo.FetchOneSeries(ctx, map[string]interface{}{
+		"id": ticket.SeriesID,
+	})
+	if err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+	if series == nil {
+		return helpers.NewResponse(http.StatusBadRequest, "Series not found", nil, nil)
+	}
+	if series.Status != mongo_model.SeriesStatusActive {
+		return helpers.NewResponse(http.StatusBadRequest, "Series is not on sale", nil, nil)
+	}
+
+	// check season
+	season, err := u.mongoDbRepo.FetchOneSeason(ctx, map[string]interface{}{
+		"id": series.SeasonID,
+	})
+	if err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+	if season == nil {
+		return helpers.NewResponse(http.StatusBadRequest, "Season not found", nil, nil)
+	}
+
+	// reserve quota, concurrent box office and online sales can not oversell
+	reserved, err := u.mongoDbRepo.ReserveTicketQuota(ctx, ticket.ID.Hex(), payload.Amount)
+	if err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+	if !reserved {
+		return helpers.NewResponse(http.StatusBadRequest, "Ticket quota is not enough", nil, nil)
+	}
+	releaseQuota := func() {
+		if err := u.mongoDbRepo.IncrementOneTicket(ctx, ticket.ID.Hex(), map[string]int64{
+			"quota.used": payload.Amount * -1,
+		}); err != nil {
+			logrus.Error("IncrementOneTicket:", err)
+		}
+	}
+
+	// generate external ID
+	now := time.Now()
+	externalId, err := shared_usecase.GenerateInvoiceExternalId(ctx, u.mongoDbRepo)
+	if err != nil {
+		releaseQuota()
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+
+	// create paid purchase
+	buyerName := payload.BuyerName
+	if buyerName == "" {
+		buyerName = "Box Office"
+	}
+	ticketFK := mongo_model.TicketFK{
+		ID:      ticket.ID.Hex(),
+		Name:    ticket.Name,
+		Date:    ticket.Date,
+		VenueID: admin.Venue.ID,
+	}
+	newPurchase := mongo_model.Purchase{
+		ID: primitive.NewObjectID(),
+		Member: mongo_model.MemberPurchaseFK{
+			Name:  buyerName,
+			Email: payload.BuyerEmail,
+			Phone: payload.BuyerPhone,
+		},
+		Season: mongo_model.SeasonFK{
+			ID:   season.ID.Hex(),
+			Name: season.Name,
+		},
+		Series: mongo_model.SeriesFK{
+			ID:   series.ID.Hex(),
+			Name: series.Name,
+		},
+		Tickets: []mongo_model.TicketFK{ticketFK},
+		Invoice: mongo_model.Invoice{
+			InvoiceExternalID:  externalId,
+			PaymentMethod:      string(paymentMethod),
+			PaymentChannel:     string(mongo_model.PurchaseChannelBoxOffice),
+			PaymentDestination: payload.EdcReference,
+		},
+		Amount:            payload.Amount,
+		Price:             ticket.Price,
+		GrandTotal:        ticket.Price * float64(payload.Amount),
+		IsCheckoutPackage: false,
+		Channel:           mongo_model.PurchaseChannelBoxOffice,
+		BoxOffice: &mongo_model.BoxOffice{
+			Admin: mongo_model.AdminFK{
+				ID:   admin.ID.Hex(),
+				Name: admin.Name,
+			},
+			Venue:         admin.Venue,
+			PaymentMethod: paymentMethod,
+			EdcReference:  payload.EdcReference,
+		},
+		Status:    mongo_model.PurchaseStatusPaid,
+		ExpiredAt: now,
+		PaidAt:    &now,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	// save purchase
+	err = u.mongoDbRepo.CreateOnePurchase(ctx, &newPurchase)
+	if err != nil {
+		releaseQuota()
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+
+	// issue ticket purchases right away, the buyer gets the codes by email when provided
+	ticketPurchases, err := shared_usecase.IssueTicketPurchases(ctx, u.mongoDbRepo, &newPurchase, []mongo_model.TicketFK{ticketFK}, map[string]mongo_model.Venue{
+		admin.Venue.ID: {Name: admin.Venue.Name},
+	})
+	if err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+	u.recordAuditLog(ctx, mongo_model.AuditActionCreate, mongo_model.AuditEntityPurchase, newPurchase.ID.Hex(), nil, newPurchase)
+
+	// set qr code for printing
+	printList := make([]interface{}, 0, len(ticketPurchases))
+	for _, ticketPurchase := range ticketPurchases {
+		qrCodePng, err := helpers.GenerateQRCodePNG(ticketPurchase.Code)
+		if err != nil {
+			logrus.Error("Failed to generate QR code:", err)
+			return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+		}
+
+		printList = append(printList, map[string]interface{}{
+			"ticketPurchase": ticketPurchase,
+			"qrCode":         "data:image/png;base64," + base64.StdEncoding.EncodeToString(qrCodePng),
+		})
+	}
+
+	return helpers.NewResponse(http.StatusOK, "Box office sale success", nil, map[string]interface{}{
+		"purchase":        newPurchase.Format(),
+		"ticketPurchases": printList,
+	})
+}
+
+func (u *adminAppUsecase) GetBoxOfficeCashUpReport(ctx context.Context, claim jwt_helpers.AdminJWTClaims, queryParam url.Values) helpers.Response {
+	ctx, cancel := context.WithTimeout(ctx, u.contextTimeout)
+	defer cancel()
+
+	// shift range, default today
+	date := time.Now()
+	if queryParam.Get("date") != "" {
+		loc, _ := time.LoadLocation("Asia/Jakarta")
+		parsedDate, err := time.ParseInLocation("2006-01-02", queryParam.Get("date"), loc)
+		if err != nil {
+			return helpers.NewResponse(http.StatusUnprocessableEntity, "Validation Error", map[string]string{
+				"date": "Date must be in format YYYY-MM-DD",
+			}, nil)
+		}
+		date = parsedDate
+	}
+	startAt := helpers.SetToStartOfDayWIB(date)
+	endAt := helpers.SetToEndOfDayWIB(date)
+
+	// check admin
+	admin, err := u.mongoDbRepo.FetchOneAdmin(ctx, map[string]interface{}{
+		"id": claim.UserID,
+	})
+	if err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+	if admin == nil {
+		return helpers.NewResponse(http.StatusBadRequest, "User not found", nil, nil)
+	}
+
+	// fetch admin box office purchases in range
+	cur, err := u.mongoDbRepo.FetchListPurchase(ctx, map[string]interface{}{
+		"channel":          mongo_model.PurchaseChannelBoxOffice,
+		"boxOfficeAdminId": admin.ID.Hex(),
+		"status":           mongo_model.PurchaseStatusPaid,
+		"paidAtFrom":       startAt,
+		"paidAtTo":         endAt,
+		"sort":             "paidAt",
+		"dir":              "asc",
+	})
+	if err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+	defer cur.Close(ctx)
+
+	type paymentMethodSummary struct {
+		PaymentMethod    mongo_model.BoxOfficePaymentMethod `json:"paymentMethod"`
+		TransactionCount int64                              `json:"transactionCount"`
+		TicketCount      int64                              `json:"ticketCount"`
+		Total            float64                            `json:"total"`
+	}
+
+	summaryMap := make(map[mongo_model.BoxOfficePaymentMethod]*paymentMethodSummary)
+	for _, paymentMethod := range mongo_model.BoxOfficePaymentMethodList {
+		summaryMap[paymentMethod] = &paymentMethodSummary{PaymentMethod: paymentMethod}
+	}
+
+	var transactionCount, ticketCount int64
+	var grandTotal float64
+	list := make([]interface{}, 0)
+	for cur.Next(ctx) {
+		row := mongo_model.Purchase{}
+		err := cur.Decode(&row)
+		if err != nil {
+			logrus.Error("Purchase Decode:", err)
+			return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+		}
+
+		transactionCount++
+		ticketCount += row.Amount * int64(len(row.Tickets))
+		grandTotal += row.GrandTotal
+		if row.BoxOffice != nil {
+			if summary, ok := summaryMap[row.BoxOffice.PaymentMethod]; ok {
+				summary.TransactionCount++
+				summary.TicketCount += row.Amount * int64(len(row.Tickets))
+				summary.Total += row.GrandTotal
+			}
+		}
+
+		list = append(list, row.Format())
+	}
+
+	byPaymentMethod := make([]paymentMethodSummary, 0, len(summaryMap))
+	for _, paymentMethod := range mongo_model.BoxOfficePaymentMethodList {
+		byPaymentMethod = append(byPaymentMethod, *summaryMap[paymentMethod])
+	}
+
+	return helpers.NewResponse(http.StatusOK, "Success", nil, map[string]interface{}{
+		"admin": mongo_model.AdminFK{
+			ID:   admin.ID.Hex(),
+			Name: admin.Name,
+		},
+		"venue":   admin.Venue,
+		"startAt": startAt,
+		"endAt":   endAt,
+		"summary": map[string]interface{}{
+			"transactionCount": transactionCount,
+			"ticketCount":      ticketCount,
+			"grandTotal":       grandTotal,
+			"byPaymentMethod":  byPaymentMethod,
+		},
+		"list": list,
+	})
+}
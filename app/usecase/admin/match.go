@@ -0,0 +1,527 @@
+package admin_usecase
+
+import (
+	shared_usecase "app/app/usecase/shared"
+	mongo_model "app/domain/model/mongo"
+	"app/domain/request"
+	"app/helpers"
+	jwt_helpers "app/helpers/jwt"
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// matchScoreMaxAttempts is how often the score is derived again when events are saved concurrently
+const matchScoreMaxAttempts = 3
+
+func (u *adminAppUsecase) GetMatchesList(ctx context.Context, claim jwt_helpers.AdminJWTClaims) helpers.Response {
+	ctx, cancel := context.WithTimeout(ctx, u.contextTimeout)
+	defer cancel()
+
+	// check admin
+	admin, err := u.mongoDbRepo.FetchOneAdmin(ctx, map[string]interface{}{
+		"id": claim.UserID,
+	})
+	if err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+	if admin == nil {
+		return helpers.NewResponse(http.StatusBadRequest, "User not found", nil, nil)
+	}
+	if admin.Venue.ID == "" {
+		return helpers.NewResponse(http.StatusBadRequest, "Admin is not assigned to any venue", nil, nil)
+	}
+
+	// fetch today matches at admin venue
+	now := time.Now()
+	cur, err := u.mongoDbRepo.FetchListMatch(ctx, map[string]interface{}{
+		"venueId":       admin.Venue.ID,
+		"kickoffAtFrom": helpers.SetToStartOfDayWIB(now),
+		"kickoffAtTo":   helpers.SetToEndOfDayWIB(now),
+		"sort":          "kickoffAt",
+		"dir":           "asc",
+	})
+	if err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+	defer cur.Close(ctx)
+
+	var matches []mongo_model.Match
+	seasonTeamIds := make([]string, 0)
+	for cur.Next(ctx) {
+		row := mongo_model.Match{}
+		err := cur.Decode(&row)
+		if err != nil {
+			logrus.Error("Match Decode:", err)
+			return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+		}
+
+		matches = append(matches, row)
+		seasonTeamIds = append(seasonTeamIds, row.HomeSeasonTeamID, row.AwaySeasonTeamID)
+	}
+
+	// fetch season team
+	seasonTeamMap, err := shared_usecase.FetchSeasonTeamMap(ctx, u.mongoDbRepo, seasonTeamIds)
+	if err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+
+	list := make([]interface{}, 0, len(matches))
+	for _, match := range matches {
+		match.Venue = admin.Venue
+		match.HomeSeasonTeam = shared_usecase.ToSeasonTeamFK(seasonTeamMap[match.HomeSeasonTeamID])
+		match.AwaySeasonTeam = shared_usecase.ToSeasonTeamFK(seasonTeamMap[match.AwaySeasonTeamID])
+		list = append(list, match)
+	}
+
+	return helpers.NewResponse(http.StatusOK, "Success", nil, map[string]interface{}{
+		"venue": admin.Venue,
+		"list":  list,
+	})
+}
+
+func (u *adminAppUsecase) UpdateMatchStatus(ctx context.Context, claim jwt_helpers.AdminJWTClaims, id string, payload request.MatchStatusUpdateRequest) helpers.Response {
+	ctx, cancel := context.WithTimeout(ctx, u.contextTimeout)
+	defer cancel()
+
+	// validate payload
+	errValidation := make(map[string]string)
+	status := mongo_model.MatchStatus(payload.Status)
+	if status != mongo_model.MatchStatusLive && status != mongo_model.MatchStatusFinished {
+		errValidation["status"] = "Status must be live or finished"
+	}
+	if payload.Duration < 0 {
+		errValidation["duration"] = "Duration must not be negative"
+	} else if payload.Duration > 0 && status != mongo_model.MatchStatusFinished {
+		errValidation["duration"] = "Duration can only be set when the match is finished"
+	}
+	if len(errValidation) > 0 {
+		return helpers.NewResponse(http.StatusUnprocessableEntity, "Validation Error", errValidation, nil)
+	}
+
+	// check match
+	_, match, message, err := u.checkOfficiatedMatch(ctx, claim, id)
+	if err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+	if message != "" {
+		return helpers.NewResponse(http.StatusBadRequest, message, nil, nil)
+	}
+	before := helpers.AuditSnapshot(match)
+
+	// update match, a started match always has a score
+	now := time.Now()
+	match.Status = status
+	if match.Score == nil {
+		match.Score = &mongo_model.MatchScore{}
+	}
+	match.Duration = 0
+	if status == mongo_model.MatchStatusFinished {
+		match.Duration = payload.Duration
+		if match.Duration == 0 {
+			match.Duration = mongo_model.MatchDefaultDuration
+		}
+	}
+	match.UpdatedAt = now
+
+	// save
+	if err := u.mongoDbRepo.UpdatePartialMatch(ctx, map[string]interface{}{
+		"id": match.ID,
+	}, map[string]interface{}{
+		"status":    match.Status,
+		"score":     match.Score,
+		"duration":  match.Duration,
+		"updatedAt": match.UpdatedAt,
+	}); err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+	u.recordAuditLog(ctx, mongo_model.AuditActionUpdateStatus, mongo_model.AuditEntityMatch, match.ID.Hex(), before, match)
+
+	// a finished match counts in the standings, update in bg
+	go u.refreshStandings(context.Background(), match.SeasonID)
+
+	return helpers.NewResponse(http.StatusOK, "Update match status success", nil, match)
+}
+
+func (u *adminAppUsecase) GetMatchEventsList(ctx context.Context, claim jwt_helpers.AdminJWTClaims, id string) helpers.Response {
+	ctx, cancel := context.WithTimeout(ctx, u.contextTimeout)
+	defer cancel()
+
+	// check match
+	_, match, message, err := u.checkOfficiatedMatch(ctx, claim, id)
+	if err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+	if message != "" {
+		return helpers.NewResponse(http.StatusBadRequest, message, nil, nil)
+	}
+
+	// fetch events
+	events, err := shared_usecase.FetchMatchEvents(ctx, u.mongoDbRepo, match.ID.Hex())
+	if err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+
+	return helpers.NewResponse(http.StatusOK, "Success", nil, map[string]interface{}{
+		"match":  match,
+		"events": events,
+	})
+}
+
+func (u *adminAppUsecase) CreateMatchEvent(ctx context.Context, claim jwt_helpers.AdminJWTClaims, id string, payload request.MatchEventRequest) helpers.Response {
+	ctx, cancel := context.WithTimeout(ctx, u.contextTimeout)
+	defer cancel()
+
+	// validate payload
+	errValidation := validateMatchEventPayload(payload)
+	if len(errValidation) > 0 {
+		return helpers.NewResponse(http.StatusUnprocessableEntity, "Validation Error", errValidation, nil)
+	}
+
+	// check match
+	admin, match, message, err := u.checkOfficiatedMatch(ctx, claim, id)
+	if err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+	if message != "" {
+		return helpers.NewResponse(http.StatusBadRequest, message, nil, nil)
+	}
+
+	// create event
+	now := time.Now()
+	event := mongo_model.MatchEvent{
+		ID:      primitive.NewObjectID(),
+		MatchID: match.ID.Hex(),
+		RecordedBy: mongo_model.ActorFK{
+			ID:   admin.ID.Hex(),
+			Name: admin.Name,
+			Role: mongo_model.ActorRoleAdmin,
+		},
+		CreatedAt: now,
+	}
+	message, err = u.setMatchEventFromPayload(ctx, &event, match, payload)
+	if err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+	if message != "" {
+		return helpers.NewResponse(http.StatusBadRequest, message, nil, nil)
+	}
+	event.UpdatedAt = now
+
+	// save
+	if err := u.mongoDbRepo.CreateOneMatchEvent(ctx, &event); err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+	u.recordAuditLog(ctx, mongo_model.AuditActionCreate, mongo_model.AuditEntityMatchEvent, event.ID.Hex(), nil, event)
+
+	// derive score
+	if err := u.syncMatchScore(ctx, match, now); err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+
+	return helpers.NewResponse(http.StatusCreated, "Create match event success", nil, map[string]interface{}{
+		"match": match,
+		"event": event,
+	})
+}
+
+func (u *adminAppUsecase) UpdateMatchEvent(ctx context.Context, claim jwt_helpers.AdminJWTClaims, id, eventId string, payload request.MatchEventRequest) helpers.Response {
+	ctx, cancel := context.WithTimeout(ctx, u.contextTimeout)
+	defer cancel()
+
+	// validate payload
+	errValidation := validateMatchEventPayload(payload)
+	if len(errValidation) > 0 {
+		return helpers.NewResponse(http.StatusUnprocessableEntity, "Validation Error", errValidation, nil)
+	}
+
+	// check match
+	_, match, message, err := u.checkOfficiatedMatch(ctx, claim, id)
+	if err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+	if message != "" {
+		return helpers.NewResponse(http.StatusBadRequest, message, nil, nil)
+	}
+
+	// check event
+	event, err := u.mongoDbRepo.FetchOneMatchEvent(ctx, map[string]interface{}{
+		"id":      eventId,
+		"matchId": match.ID.Hex(),
+	})
+	if err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+	if event == nil {
+		return helpers.NewResponse(http.StatusBadRequest, "Match event not found", nil, nil)
+	}
+	before := helpers.AuditSnapshot(event)
+
+	// correct event
+	now := time.Now()
+	message, err = u.setMatchEventFromPayload(ctx, event, match, payload)
+	if err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+	if message != "" {
+		return helpers.NewResponse(http.StatusBadRequest, message, nil, nil)
+	}
+	event.UpdatedAt = now
+
+	// save
+	if err := u.mongoDbRepo.UpdatePartialMatchEvent(ctx, map[string]interface{}{
+		"id": event.ID,
+	}, map[string]interface{}{
+		"type":                        event.Type,
+		"minute":                      event.Minute,
+		"seasonTeamPlayer":            event.SeasonTeamPlayer,
+		"substitutedSeasonTeamPlayer": event.SubstitutedSeasonTeamPlayer,
+		"isOwnGoal":                   event.IsOwnGoal,
+		"note":                        event.Note,
+		"updatedAt":                   event.UpdatedAt,
+	}); err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+	u.recordAuditLog(ctx, mongo_model.AuditActionUpdate, mongo_model.AuditEntityMatchEvent, event.ID.Hex(), before, event)
+
+	// derive score
+	if err := u.syncMatchScore(ctx, match, now); err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+
+	return helpers.NewResponse(http.StatusOK, "Update match event success", nil, map[string]interface{}{
+		"match": match,
+		"event": event,
+	})
+}
+
+func (u *adminAppUsecase) DeleteMatchEvent(ctx context.Context, claim jwt_helpers.AdminJWTClaims, id, eventId string) helpers.Response {
+	ctx, cancel := context.WithTimeout(ctx, u.contextTimeout)
+	defer cancel()
+
+	// check match
+	_, match, message, err := u.checkOfficiatedMatch(ctx, claim, id)
+	if err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+	if message != "" {
+		return helpers.NewResponse(http.StatusBadRequest, message, nil, nil)
+	}
+
+	// check event
+	event, err := u.mongoDbRepo.FetchOneMatchEvent(ctx, map[string]interface{}{
+		"id":      eventId,
+		"matchId": match.ID.Hex(),
+	})
+	if err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+	if event == nil {
+		return helpers.NewResponse(http.StatusBadRequest, "Match event not found", nil, nil)
+	}
+	before := helpers.AuditSnapshot(event)
+
+	// delete event
+	now := time.Now()
+	event.DeletedAt = &now
+
+	// save
+	if err := u.mongoDbRepo.UpdatePartialMatchEvent(ctx, map[string]interface{}{
+		"id": event.ID,
+	}, map[string]interface{}{
+		"deletedAt": event.DeletedAt,
+	}); err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+	u.recordAuditLog(ctx, mongo_model.AuditActionDelete, mongo_model.AuditEntityMatchEvent, event.ID.Hex(), before, event)
+
+	// derive score
+	if err := u.syncMatchScore(ctx, match, now); err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+
+	return helpers.NewResponse(http.StatusOK, "Delete match event success", nil, match)
+}
+
+// checkOfficiatedMatch returns the match when it is played at the venue of the admin, otherwise a bad request message
+func (u *adminAppUsecase) checkOfficiatedMatch(ctx context.Context, claim jwt_helpers.AdminJWTClaims, id string) (*mongo_model.Admin, *mongo_model.Match, string, error) {
+	// check admin
+	admin, err := u.mongoDbRepo.FetchOneAdmin(ctx, map[string]interface{}{
+		"id": claim.UserID,
+	})
+	if err != nil {
+		return nil, nil, "", err
+	}
+	if admin == nil {
+		return nil, nil, "User not found", nil
+	}
+	if admin.Venue.ID == "" {
+		return nil, nil, "Admin is not assigned to any venue", nil
+	}
+
+	// check match
+	match, err := u.mongoDbRepo.FetchOneMatch(ctx, map[string]interface{}{
+		"id":      id,
+		"venueId": admin.Venue.ID,
+	})
+	if err != nil {
+		return nil, nil, "", err
+	}
+	if match == nil {
+		return nil, nil, "Match not found", nil
+	}
+	if match.Status == mongo_model.MatchStatusPostponed {
+		return nil, nil, "Match is postponed", nil
+	}
+
+	// set venue and season teams
+	seasonTeamMap, err := shared_usecase.FetchSeasonTeamMap(ctx, u.mongoDbRepo, []string{match.HomeSeasonTeamID, match.AwaySeasonTeamID})
+	if err != nil {
+		return nil, nil, "", err
+	}
+	match.Venue = admin.Venue
+	match.HomeSeasonTeam = shared_usecase.ToSeasonTeamFK(seasonTeamMap[match.HomeSeasonTeamID])
+	match.AwaySeasonTeam = shared_usecase.ToSeasonTeamFK(seasonTeamMap[match.AwaySeasonTeamID])
+
+	return admin, match, "", nil
+}
+
+func validateMatchEventPayload(payload request.MatchEventRequest) map[string]string {
+	errValidation := make(map[string]string)
+	eventType := mongo_model.MatchEventType(payload.Type)
+	if payload.Type == "" {
+		errValidation["type"] = "Type field is required"
+	} else if !mongo_model.IsValidMatchEventType(payload.Type) {
+		errValidation["type"] = "Type must be start, goal, assist, save, yellow_card, red_card, foul or substitution"
+	}
+	if payload.Minute < 0 {
+		errValidation["minute"] = "Minute must not be negative"
+	}
+	if eventType == mongo_model.MatchEventTypeStart && payload.Minute != 0 {
+		errValidation["minute"] = "Minute must be 0 for start"
+	}
+	if payload.SeasonTeamPlayerID == "" {
+		errValidation["seasonTeamPlayerId"] = "Season Team Player ID field is required"
+	}
+	if eventType == mongo_model.MatchEventTypeSubstitution {
+		if payload.SubstitutedSeasonTeamPlayerID == "" {
+			errValidation["substitutedSeasonTeamPlayerId"] = "Substituted Season Team Player ID field is required for substitution"
+		} else if payload.SubstitutedSeasonTeamPlayerID == payload.SeasonTeamPlayerID {
+			errValidation["substitutedSeasonTeamPlayerId"] = "Substituted player must be another player"
+		}
+	} else if payload.SubstitutedSeasonTeamPlayerID != "" {
+		errValidation["substitutedSeasonTeamPlayerId"] = "Substituted player can only be set on substitution"
+	}
+	if payload.IsOwnGoal && eventType != mongo_model.MatchEventTypeGoal {
+		errValidation["isOwnGoal"] = "Own goal can only be set on goal"
+	}
+
+	return errValidation
+}
+
+// setMatchEventFromPayload set the validated payload on the event, the players must play for one of the match teams
+func (u *adminAppUsecase) setMatchEventFromPayload(ctx context.Context, event *mongo_model.MatchEvent, match *mongo_model.Match, payload request.MatchEventRequest) (string, error) {
+	seasonTeamPlayer, message, err := u.fetchMatchSeasonTeamPlayer(ctx, match, payload.SeasonTeamPlayerID)
+	if err != nil || message != "" {
+		return message, err
+	}
+
+	event.SubstitutedSeasonTeamPlayer = nil
+	if payload.SubstitutedSeasonTeamPlayerID != "" {
+		substituted, message, err := u.fetchMatchSeasonTeamPlayer(ctx, match, payload.SubstitutedSeasonTeamPlayerID)
+		if err != nil || message != "" {
+			return message, err
+		}
+		if substituted.SeasonTeam.ID != seasonTeamPlayer.SeasonTeam.ID {
+			return "Substituted player must play for the same team", nil
+		}
+		event.SubstitutedSeasonTeamPlayer = substituted
+	}
+
+	event.Type = mongo_model.MatchEventType(payload.Type)
+	event.Minute = payload.Minute
+	event.SeasonTeamPlayer = *seasonTeamPlayer
+	event.IsOwnGoal = payload.IsOwnGoal
+	event.Note = payload.Note
+
+	return "", nil
+}
+
+func (u *adminAppUsecase) fetchMatchSeasonTeamPlayer(ctx context.Context, match *mongo_model.Match, id string) (*mongo_model.SeasonTeamPlayerFK, string, error) {
+	seasonTeamPlayer, err := u.mongoDbRepo.FetchOneSeasonTeamPlayer(ctx, map[string]interface{}{
+		"id": id,
+	})
+	if err != nil {
+		return nil, "", err
+	}
+	if seasonTeamPlayer == nil {
+		return nil, "Season Team Player " + id + " not found", nil
+	}
+	if seasonTeamPlayer.SeasonTeam.ID != match.HomeSeasonTeamID && seasonTeamPlayer.SeasonTeam.ID != match.AwaySeasonTeamID {
+		return nil, "Season Team Player " + id + " does not play in this match", nil
+	}
+
+	return &mongo_model.SeasonTeamPlayerFK{
+		ID:         seasonTeamPlayer.ID.Hex(),
+		SeasonTeam: seasonTeamPlayer.SeasonTeam,
+		Player:     seasonTeamPlayer.Player,
+		Position:   seasonTeamPlayer.Position,
+		Image:      seasonTeamPlayer.Image.URL,
+	}, "", nil
+}
+
+// syncMatchScore derive the match score from its events, the first event of a scheduled match starts it.
+// The score is saved only when no other sync saved one since the events were read, otherwise the events are read again
+func (u *adminAppUsecase) syncMatchScore(ctx context.Context, match *mongo_model.Match, now time.Time) error {
+	before := helpers.AuditSnapshot(match)
+
+	if _, err := u.mongoDbRepo.StartMatch(ctx, match.ID.Hex(), now); err != nil {
+		return err
+	}
+
+	for attempt := 0; attempt < matchScoreMaxAttempts; attempt++ {
+		// read the score version before the events so an event saved in between fails the update
+		current, err := u.mongoDbRepo.FetchOneMatch(ctx, map[string]interface{}{
+			"id": match.ID,
+		})
+		if err != nil {
+			return err
+		}
+		if current == nil {
+			return nil
+		}
+		events, err := shared_usecase.FetchMatchEvents(ctx, u.mongoDbRepo, match.ID.Hex())
+		if err != nil {
+			return err
+		}
+
+		score := current.ScoreFromEvents(events)
+		saved, err := u.mongoDbRepo.UpdateMatchScore(ctx, match.ID.Hex(), current.ScoreVersion, score, now)
+		if err != nil {
+			return err
+		}
+		if !saved {
+			continue
+		}
+
+		match.Status = current.Status
+		match.Score = &score
+		match.ScoreVersion = current.ScoreVersion + 1
+		match.UpdatedAt = now
+		if _, changed := helpers.AuditDiff(before, helpers.AuditSnapshot(match)); len(changed) > 0 {
+			u.recordAuditLog(ctx, mongo_model.AuditActionUpdate, mongo_model.AuditEntityMatch, match.ID.Hex(), before, match)
+
+			// a corrected result of a finished match changes the standings, update in bg
+			if match.Status == mongo_model.MatchStatusFinished {
+				go u.refreshStandings(context.Background(), match.SeasonID)
+			}
+		}
+		return nil
+	}
+
+	return errors.New("match score was changed by another request, please try again")
+}
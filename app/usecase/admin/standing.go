@@ -0,0 +1,18 @@
+package admin_usecase
+
+import (
+	shared_usecase "app/app/usecase/shared"
+	"context"
+
+	"github.com/sirupsen/logrus"
+)
+
+// refreshStandings recompute the cached standings of a season after a result changed
+func (u *adminAppUsecase) refreshStandings(ctx context.Context, seasonId string) {
+	ctx, cancel := context.WithTimeout(ctx, u.contextTimeout)
+	defer cancel()
+
+	if err := shared_usecase.RefreshStandings(ctx, u.mongoDbRepo, seasonId); err != nil {
+		logrus.Error("refreshStandings RefreshStandings:", err)
+	}
+}
@@ -4,6 +4,7 @@ import (
 	mongo_model "app/domain/model/mongo"
 	"app/domain/request"
 	"app/helpers"
+	jwt_helpers "app/helpers/jwt"
 	"context"
 	"net/http"
 	"time"
@@ -11,20 +12,36 @@ import (
 	"github.com/sirupsen/logrus"
 )
 
-func (u *adminAppUsecase) GetListTicketPurchasesIsUsedToday(ctx context.Context) helpers.Response {
+func (u *adminAppUsecase) GetListTicketPurchasesIsUsedToday(ctx context.Context, claim jwt_helpers.AdminJWTClaims) helpers.Response {
 	ctx, cancel := context.WithTimeout(ctx, u.contextTimeout)
 	defer cancel()
 
+	// check admin
+	admin, err := u.mongoDbRepo.FetchOneAdmin(ctx, map[string]interface{}{
+		"id": claim.UserID,
+	})
+	if err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+	if admin == nil {
+		return helpers.NewResponse(http.StatusBadRequest, "User not found", nil, nil)
+	}
+
 	fetchOptions := map[string]interface{}{
 		"today":  true,
 		"isUsed": true,
 	}
 
+	// admin assigned to a venue only see its own gate
+	if admin.Venue.ID != "" {
+		fetchOptions["venueId"] = admin.Venue.ID
+	}
+
 	// count
 	total := u.mongoDbRepo.CountTicketPurchase(ctx, fetchOptions)
 	if total == 0 {
 		return helpers.NewResponse(http.StatusOK, "Success", nil, map[string]interface{}{
-			"list": []interface{}{},
+			"list":  []interface{}{},
 			"total": 0,
 		})
 	}
@@ -54,7 +71,7 @@ func (u *adminAppUsecase) GetListTicketPurchasesIsUsedToday(ctx context.Context)
 	})
 }
 
-func (u *adminAppUsecase) ScanTicketPurchase(ctx context.Context, payload request.ScanTicketPurchaseRequest) helpers.Response {
+func (u *adminAppUsecase) ScanTicketPurchase(ctx context.Context, claim jwt_helpers.AdminJWTClaims, payload request.ScanTicketPurchaseRequest) helpers.Response {
 	ctx, cancel := context.WithTimeout(ctx, u.contextTimeout)
 	defer cancel()
 
@@ -75,9 +92,41 @@ func (u *adminAppUsecase) ScanTicketPurchase(ctx context.Context, payload reques
 		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
 	}
 	if ticketPurchase == nil {
+		// check revoked code
+		revokedTicketPurchase, err := u.mongoDbRepo.FetchOneTicketPurchase(ctx, map[string]interface{}{
+			"revokedCode": payload.Code,
+		})
+		if err != nil {
+			return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+		}
+		if revokedTicketPurchase != nil {
+			return helpers.NewResponse(http.StatusBadRequest, "Revoked ticket, this QR code has been replaced and can no longer be used", nil, nil)
+		}
+
 		return helpers.NewResponse(http.StatusBadRequest, "Ticket not found", nil, nil)
 	}
 
+	// voided ticket of cancelled day
+	if ticketPurchase.IsVoided {
+		return helpers.NewResponse(http.StatusBadRequest, "Voided ticket, this ticket day has been cancelled", nil, nil)
+	}
+
+	// check admin
+	admin, err := u.mongoDbRepo.FetchOneAdmin(ctx, map[string]interface{}{
+		"id": claim.UserID,
+	})
+	if err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+	if admin == nil {
+		return helpers.NewResponse(http.StatusBadRequest, "User not found", nil, nil)
+	}
+
+	// validate venue, admin assigned to a venue can only scan ticket for that venue
+	if admin.Venue.ID != "" && ticketPurchase.Venue.ID != admin.Venue.ID {
+		return helpers.NewResponse(http.StatusBadRequest, "This ticket is for venue "+ticketPurchase.Venue.Name+", please go to the correct venue", nil, nil)
+	}
+
 	// validate date
 	now := time.Now()
 	if !helpers.IsSameDateWIB(ticketPurchase.Ticket.Date, now) {
@@ -85,6 +134,7 @@ func (u *adminAppUsecase) ScanTicketPurchase(ctx context.Context, payload reques
 	}
 
 	// update ticket purchase
+	before := helpers.AuditSnapshot(ticketPurchase)
 	ticketPurchase.IsUsed = true
 	ticketPurchase.UsedAt = &now
 	ticketPurchase.UpdatedAt = now
@@ -99,6 +149,7 @@ func (u *adminAppUsecase) ScanTicketPurchase(ctx context.Context, payload reques
 	if err != nil {
 		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
 	}
+	u.recordAuditLog(ctx, mongo_model.AuditActionScan, mongo_model.AuditEntityTicketPurchase, ticketPurchase.ID.Hex(), before, ticketPurchase)
 
 	return helpers.NewResponse(http.StatusOK, "Success", nil, ticketPurchase)
 }
@@ -0,0 +1,209 @@
+package member_usecase
+
+import (
+	shared_usecase "app/app/usecase/shared"
+	mongo_model "app/domain/model/mongo"
+	"app/domain/request"
+	"app/helpers"
+	jwt_helpers "app/helpers/jwt"
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"golang.org/x/crypto/bcrypt"
+)
+
+func (u *memberAppUsecase) ExportAccountData(ctx context.Context, claim jwt_helpers.MemberJWTClaims) helpers.Response {
+	ctx, cancel := context.WithTimeout(ctx, u.contextTimeout)
+	defer cancel()
+
+	// check member
+	member, err := u.mongoDbRepo.FetchOneMember(ctx, map[string]interface{}{
+		"id": claim.UserID,
+	})
+	if err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+	if member == nil {
+		return helpers.NewResponse(http.StatusBadRequest, "User not found", nil, nil)
+	}
+
+	// purchases
+	purchaseCur, err := u.mongoDbRepo.FetchListPurchase(ctx, map[string]interface{}{
+		"memberId": claim.UserID,
+		"sort":     "createdAt",
+		"dir":      "asc",
+	})
+	if err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+	defer purchaseCur.Close(ctx)
+
+	purchases := make([]interface{}, 0)
+	for purchaseCur.Next(ctx) {
+		row := mongo_model.Purchase{}
+		if err := purchaseCur.Decode(&row); err != nil {
+			logrus.Error("ExportAccountData Purchase Decode:", err)
+			return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+		}
+		purchases = append(purchases, row.Format())
+	}
+
+	// ticket purchases
+	ticketPurchaseCur, err := u.mongoDbRepo.FetchListTicketPurchase(ctx, map[string]interface{}{
+		"memberId": claim.UserID,
+		"sort":     "createdAt",
+		"dir":      "asc",
+	})
+	if err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+	defer ticketPurchaseCur.Close(ctx)
+
+	ticketPurchases := make([]interface{}, 0)
+	for ticketPurchaseCur.Next(ctx) {
+		row := mongo_model.TicketPurchase{}
+		if err := ticketPurchaseCur.Decode(&row); err != nil {
+			logrus.Error("ExportAccountData TicketPurchase Decode:", err)
+			return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+		}
+		ticketPurchases = append(ticketPurchases, row)
+	}
+
+	// voting logs
+	votingLogCur, err := u.mongoDbRepo.FetchListVotingLog(ctx, map[string]interface{}{
+		"memberId": claim.UserID,
+		"sort":     "createdAt",
+		"dir":      "asc",
+	})
+	if err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+	defer votingLogCur.Close(ctx)
+
+	votingLogs := make([]interface{}, 0)
+	for votingLogCur.Next(ctx) {
+		row := mongo_model.VotingLog{}
+		if err := votingLogCur.Decode(&row); err != nil {
+			logrus.Error("ExportAccountData VotingLog Decode:", err)
+			return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+		}
+		votingLogs = append(votingLogs, row)
+	}
+
+	return helpers.NewResponse(http.StatusOK, "Export account data success", nil, map[string]interface{}{
+		"profile":         member,
+		"purchases":       purchases,
+		"ticketPurchases": ticketPurchases,
+		"votingLogs":      votingLogs,
+		"exportedAt":      time.Now(),
+	})
+}
+
+func (u *memberAppUsecase) DeleteAccount(ctx context.Context, claim jwt_helpers.MemberJWTClaims, payload request.DeleteAccountRequest) helpers.Response {
+	ctx, cancel := context.WithTimeout(ctx, u.contextTimeout)
+	defer cancel()
+
+	// check member
+	member, err := u.mongoDbRepo.FetchOneMember(ctx, map[string]interface{}{
+		"id": claim.UserID,
+	})
+	if err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+	if member == nil {
+		return helpers.NewResponse(http.StatusBadRequest, "User not found", nil, nil)
+	}
+
+	// validate payload, social login members without a password only need the session
+	errValidation := make(map[string]string)
+	if member.Password != "" && payload.Password == "" {
+		errValidation["password"] = "Password field is required"
+	}
+	if len(errValidation) > 0 {
+		return helpers.NewResponse(http.StatusUnprocessableEntity, "Validation Error", errValidation, nil)
+	}
+
+	// check password
+	if member.Password != "" {
+		if err := bcrypt.CompareHashAndPassword([]byte(member.Password), []byte(payload.Password)); err != nil {
+			return helpers.NewResponse(http.StatusBadRequest, "Wrong password", nil, nil)
+		}
+	}
+
+	// a pending invoice could still be paid, wait until it is paid or expired
+	totalPending := u.mongoDbRepo.CountPurchase(ctx, map[string]interface{}{
+		"memberId": claim.UserID,
+		"status":   mongo_model.PurchaseStatusPending,
+	})
+	if totalPending > 0 {
+		return helpers.NewResponse(http.StatusBadRequest, "Account has pending payment, please wait until it is paid or expired", nil, nil)
+	}
+
+	// anonymize the member copy on financial records, the records themselves are kept
+	anonymized := mongo_model.AnonymizedMemberPurchaseFK(claim.UserID)
+	memberFields := map[string]interface{}{
+		"member": anonymized,
+	}
+	if err := u.mongoDbRepo.UpdateManyPurchasePartial(ctx, map[string]interface{}{
+		"memberId": claim.UserID,
+	}, memberFields); err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+	if err := u.mongoDbRepo.UpdateManyTicketPurchasePartial(ctx, map[string]interface{}{
+		"memberId": claim.UserID,
+	}, memberFields); err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+	if err := u.mongoDbRepo.UpdateManyRefundPartial(ctx, map[string]interface{}{
+		"memberId": claim.UserID,
+	}, memberFields); err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+	if err := u.mongoDbRepo.UpdateManyTicketPurchaseReissueLogPartial(ctx, map[string]interface{}{
+		"memberId": claim.UserID,
+	}, memberFields); err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+
+	// forget the failed login counter keyed by the email
+	_ = u.mongoDbRepo.UpdatePartialLoginAttempt(ctx, map[string]interface{}{
+		"role":       mongo_model.ActorRoleMember,
+		"type":       mongo_model.LoginAttemptTypeAccount,
+		"identifier": member.Email,
+	}, map[string]interface{}{
+		"identifier": anonymized.Email,
+	})
+
+	// anonymize and delete member, the id stays so voting logs keep counting
+	now := time.Now()
+	if _, err := u.mongoDbRepo.UpdatePartialMemberBumpTokenVersion(ctx, map[string]interface{}{
+		"id": member.ID,
+	}, map[string]interface{}{
+		"name":                   anonymized.Name,
+		"email":                  anonymized.Email,
+		"pendingEmail":           nil,
+		"password":               "",
+		"phone":                  nil,
+		"age":                    nil,
+		"gender":                 nil,
+		"emailToken":             "",
+		"emailTokenExpiredAt":    nil,
+		"emailTokenSentAt":       nil,
+		"passwordToken":          "",
+		"passwordTokenExpiredAt": nil,
+		"oidc":                   nil,
+		"updatedAt":              now,
+		"deletedAt":              now,
+	}); err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+
+	// revoke every session
+	if err := shared_usecase.RevokeAuthSessions(ctx, u.mongoDbRepo, mongo_model.ActorRoleMember, claim.UserID, now); err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+
+	return helpers.NewResponse(http.StatusOK, "Account deleted", nil, nil)
+}
@@ -5,14 +5,13 @@ import (
 	"app/domain/request"
 	"app/helpers"
 	jwt_helpers "app/helpers/jwt"
+	mailing_helpers "app/helpers/mailing"
 	"context"
 	"fmt"
 	"net/http"
 	"net/url"
 	"time"
 
-	"github.com/golang-jwt/jwt/v5"
-	"github.com/google/uuid"
 	"github.com/sirupsen/logrus"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	"golang.org/x/crypto/bcrypt"
@@ -39,8 +38,7 @@ func (u *memberAppUsecase) Register(ctx context.Context, payload request.MemberR
 	} else {
 		if !helpers.IsValidLengthPassword(payload.Password) {
 			errValidation["password"] = "Password must be at least 8 characters"
-		}
-		if !helpers.IsStrongPassword(payload.Password) {
+		} else if !helpers.IsStrongPassword(payload.Password) {
 			errValidation["password"] = "Password must contain at least one uppercase letter, one lowercase letter, and one number"
 		}
 	}
@@ -62,9 +60,6 @@ func (u *memberAppUsecase) Register(ctx context.Context, payload request.MemberR
 	// hash password
 	hashedPassword, _ := bcrypt.GenerateFromPassword([]byte(payload.Password), bcrypt.DefaultCost)
 
-	// generate email token
-	emailToken, _ := helpers.GenerateSecureRandomChar(64)
-
 	now := time.Now()
 	// create member
 	member = &mongo_model.Member{
@@ -72,12 +67,14 @@ func (u *memberAppUsecase) Register(ctx context.Context, payload request.MemberR
 		Name:       payload.Name,
 		Email:      payload.Email,
 		Password:   string(hashedPassword),
-		EmailToken: emailToken,
 		IsVerified: false,
 		CreatedAt:  now,
 		UpdatedAt:  now,
 	}
 
+	// generate email token
+	setEmailToken(member, now)
+
 	// save
 	if err := u.mongoDbRepo.CreateOneMember(ctx, member); err != nil {
 		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
@@ -94,17 +91,32 @@ func (u *memberAppUsecase) Register(ctx context.Context, payload request.MemberR
 	)
 }
 
+// setEmailToken generate a new verification token valid for the configured ttl
+func setEmailToken(member *mongo_model.Member, now time.Time) {
+	emailToken, _ := helpers.GenerateSecureRandomChar(64)
+	expiredAt := now.Add(time.Duration(helpers.GetEmailVerificationTTL()) * time.Minute)
+	member.EmailToken = emailToken
+	member.EmailTokenExpiredAt = &expiredAt
+	member.EmailTokenSentAt = &now
+}
+
 func sendEmailVerification(member *mongo_model.Member) {
 	// helper email
 	mailer := helpers.NewSMTPMailer()
 
+	// a changed email is verified on the new address
+	email := member.Email
+	if member.PendingEmail != nil {
+		email = *member.PendingEmail
+	}
+
 	// set verification link
 	baseFeUrl := helpers.GetFEUrl()
 	verificationLink := fmt.Sprintf(
 		"%s/verification/%s?email=%s",
 		baseFeUrl,
 		url.PathEscape(member.EmailToken),
-		url.PathEscape(member.Email))
+		url.PathEscape(email))
 
 	// get template
 	subject, body := helpers.GetEmailVerificationTemplate()
@@ -117,13 +129,13 @@ func sendEmailVerification(member *mongo_model.Member) {
 	finalBody := helpers.StringReplacer(body, dataReplace)
 
 	// setup mail content
-	mailer.To([]string{member.Email})
+	mailer.To([]string{email})
 	mailer.Subject(subject)
 	mailer.Body(finalBody)
 
 	// send
 	if err := mailer.Send(); err != nil {
-		logrus.Errorf("Send Email to %s error %v", member.Email, err)
+		logrus.Errorf("Send Email to %s error %v", email, err)
 	}
 }
 
@@ -150,21 +162,48 @@ func (u *memberAppUsecase) VerifyEmail(ctx context.Context, payload request.Veri
 	if member == nil {
 		return helpers.NewResponse(http.StatusBadRequest, "Token is invalid", nil, nil)
 	}
-	if member.IsVerified {
+	if member.IsVerified && member.PendingEmail == nil {
 		return helpers.NewResponse(http.StatusBadRequest, "Email already verified", nil, nil)
 	}
 
-	// update member
+	// tokens without expiry were issued before expiry existed and are treated as expired
 	now := time.Now()
+	if member.EmailTokenExpiredAt == nil || now.After(*member.EmailTokenExpiredAt) {
+		return helpers.NewResponse(http.StatusBadRequest, "Token is expired, please request a new verification email", nil, nil)
+	}
+
+	// update member
 	member.IsVerified = true
 	member.VerifiedAt = &now
+	updateFields := map[string]interface{}{
+		"isVerified":          member.IsVerified,
+		"verifiedAt":          member.VerifiedAt,
+		"emailToken":          "",
+		"emailTokenExpiredAt": nil,
+	}
+
+	// swap to the changed email once it is verified
+	if member.PendingEmail != nil {
+		existing, err := u.mongoDbRepo.FetchOneMember(ctx, map[string]interface{}{
+			"email": *member.PendingEmail,
+		})
+		if err != nil {
+			return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+		}
+		if existing != nil && existing.ID != member.ID {
+			return helpers.NewResponse(http.StatusBadRequest, "Email already used", nil, nil)
+		}
+
+		member.Email = *member.PendingEmail
+		member.PendingEmail = nil
+		updateFields["email"] = member.Email
+		updateFields["pendingEmail"] = nil
+		updateFields["updatedAt"] = now
+	}
+
 	if err := u.mongoDbRepo.UpdatePartialMember(ctx, map[string]interface{}{
 		"emailToken": payload.Token,
-	}, map[string]interface{}{
-		"isVerified": member.IsVerified,
-		"verifiedAt": member.VerifiedAt,
-		"emailToken": "",
-	}); err != nil {
+	}, updateFields); err != nil {
 		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
 	}
 
@@ -194,19 +233,35 @@ func (u *memberAppUsecase) ResendEmailVerification(ctx context.Context, payload
 	if member == nil {
 		return helpers.NewResponse(http.StatusBadRequest, "User not found", nil, nil)
 	}
-	if member.IsVerified {
+	if member.IsVerified && member.PendingEmail == nil {
 		return helpers.NewResponse(http.StatusBadRequest, "Email already verified", nil, nil)
 	}
 
+	// throttle resend per email
+	now := time.Now()
+	if member.EmailTokenSentAt != nil {
+		retryAt := member.EmailTokenSentAt.Add(time.Duration(helpers.GetEmailVerificationResendInterval()) * time.Second)
+		if now.Before(retryAt) {
+			return helpers.NewResponse(
+				http.StatusTooManyRequests,
+				fmt.Sprintf("Please wait %d seconds before requesting another verification email", int64(retryAt.Sub(now).Seconds())+1),
+				nil,
+				nil,
+			)
+		}
+	}
+
 	// update email token member
-	emailToken, _ := helpers.GenerateSecureRandomChar(64)
-	member.EmailToken = emailToken
+	setEmailToken(member, now)
 
 	// save
 	if err := u.mongoDbRepo.UpdatePartialMember(ctx, map[string]interface{}{
 		"id": member.ID,
 	}, map[string]interface{}{
-		"emailToken": member.EmailToken,
+		"emailToken":          member.EmailToken,
+		"emailTokenExpiredAt": member.EmailTokenExpiredAt,
+		"emailTokenSentAt":    member.EmailTokenSentAt,
+		"updatedAt":           now,
 	}); err != nil {
 		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
 	}
@@ -217,7 +272,7 @@ func (u *memberAppUsecase) ResendEmailVerification(ctx context.Context, payload
 	return helpers.NewResponse(http.StatusOK, "Resend email verification successful", nil, nil)
 }
 
-func (u *memberAppUsecase) Login(ctx context.Context, payload request.MemberLoginRequest) helpers.Response {
+func (u *memberAppUsecase) Login(ctx context.Context, payload request.MemberLoginRequest, clientIP string) helpers.Response {
 	ctx, cancel := context.WithTimeout(ctx, u.contextTimeout)
 	defer cancel()
 
@@ -233,6 +288,15 @@ func (u *memberAppUsecase) Login(ctx context.Context, payload request.MemberLogi
 		return helpers.NewResponse(http.StatusUnprocessableEntity, "Validation Error", errValidation, nil)
 	}
 
+	// check failed login backoff and lockout
+	message, err := u.checkLoginAttempt(ctx, payload.Email, clientIP)
+	if err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+	if message != "" {
+		return helpers.NewResponse(http.StatusTooManyRequests, message, nil, nil)
+	}
+
 	// check member
 	member, err := u.mongoDbRepo.FetchOneMember(ctx, map[string]interface{}{
 		"email": payload.Email,
@@ -241,6 +305,7 @@ func (u *memberAppUsecase) Login(ctx context.Context, payload request.MemberLogi
 		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
 	}
 	if member == nil {
+		u.recordLoginFailure(ctx, payload.Email, clientIP, nil)
 		return helpers.NewResponse(http.StatusBadRequest, "User not found", nil, nil)
 	}
 	if !member.IsVerified {
@@ -249,31 +314,137 @@ func (u *memberAppUsecase) Login(ctx context.Context, payload request.MemberLogi
 
 	// check password
 	if err := bcrypt.CompareHashAndPassword([]byte(member.Password), []byte(payload.Password)); err != nil {
+		u.recordLoginFailure(ctx, payload.Email, clientIP, member)
 		return helpers.NewResponse(http.StatusBadRequest, "Wrong password", nil, nil)
 	}
+	u.clearLoginAttempt(ctx, payload.Email)
 
-	// generate token
+	// create session and generate token
+	result, err := u.createAuthSession(ctx, member)
+	if err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+	result["user"] = member
+
+	return helpers.NewResponse(http.StatusOK, "Login successful", nil, result)
+}
+
+func (u *memberAppUsecase) ForgotPassword(ctx context.Context, payload request.ForgotPasswordRequest) helpers.Response {
+	ctx, cancel := context.WithTimeout(ctx, u.contextTimeout)
+	defer cancel()
+
+	// validate payload
+	errValidation := make(map[string]string)
+	if payload.Email == "" {
+		errValidation["email"] = "Email field is required"
+	}
+	if len(errValidation) > 0 {
+		return helpers.NewResponse(http.StatusUnprocessableEntity, "Validation Error", errValidation, nil)
+	}
+
+	// same response whether the account exists or not
+	successMessage := "If the email is registered, a reset password link has been sent"
+
+	// check member
+	member, err := u.mongoDbRepo.FetchOneMember(ctx, map[string]interface{}{
+		"email": payload.Email,
+	})
+	if err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+	if member == nil {
+		return helpers.NewResponse(http.StatusOK, successMessage, nil, nil)
+	}
+
+	// generate password token, only the hash is stored
+	passwordToken, err := helpers.GenerateSecureRandomChar(64)
+	if err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
 	now := time.Now()
-	expiredAt := now.Add(time.Duration(jwt_helpers.GetJWTTTL()) * time.Minute)
-	token, err := jwt_helpers.GenerateJWTTokenMember(jwt_helpers.MemberJWTClaims{
-		UserID: member.ID.Hex(),
-		RegisteredClaims: jwt.RegisteredClaims{
-			ID:        uuid.NewString(),
-			Issuer:    "member",
-			IssuedAt:  jwt.NewNumericDate(now),
-			NotBefore: jwt.NewNumericDate(now),
-			ExpiresAt: jwt.NewNumericDate(expiredAt),
-		},
+	expiredAt := now.Add(time.Duration(helpers.GetPasswordResetTTL()) * time.Minute)
+
+	// save
+	if err := u.mongoDbRepo.UpdatePartialMember(ctx, map[string]interface{}{
+		"id": member.ID,
+	}, map[string]interface{}{
+		"passwordToken":          helpers.HashToken(passwordToken),
+		"passwordTokenExpiredAt": expiredAt,
+		"updatedAt":              now,
+	}); err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+
+	// send email reset password
+	resetLink := fmt.Sprintf(
+		"%s/reset-password/%s",
+		helpers.GetFEUrl(),
+		url.PathEscape(passwordToken))
+	go mailing_helpers.SendResetPassword(member.Name, member.Email, resetLink)
+
+	return helpers.NewResponse(http.StatusOK, successMessage, nil, nil)
+}
+
+func (u *memberAppUsecase) ResetPassword(ctx context.Context, payload request.ResetPasswordRequest) helpers.Response {
+	ctx, cancel := context.WithTimeout(ctx, u.contextTimeout)
+	defer cancel()
+
+	// validate payload
+	errValidation := make(map[string]string)
+	if payload.Token == "" {
+		errValidation["token"] = "Token field is required"
+	}
+	if payload.Password == "" {
+		errValidation["password"] = "Password field is required"
+	} else {
+		if !helpers.IsValidLengthPassword(payload.Password) {
+			errValidation["password"] = "Password must be at least 8 characters"
+		} else if !helpers.IsStrongPassword(payload.Password) {
+			errValidation["password"] = "Password must contain at least one uppercase letter, one lowercase letter, and one number"
+		}
+	}
+	if len(errValidation) > 0 {
+		return helpers.NewResponse(http.StatusUnprocessableEntity, "Validation Error", errValidation, nil)
+	}
+
+	// check member
+	hashedToken := helpers.HashToken(payload.Token)
+	member, err := u.mongoDbRepo.FetchOneMember(ctx, map[string]interface{}{
+		"passwordToken": hashedToken,
 	})
 	if err != nil {
 		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
 	}
+	if member == nil {
+		return helpers.NewResponse(http.StatusBadRequest, "Token is invalid", nil, nil)
+	}
+	now := time.Now()
+	if member.PasswordTokenExpiredAt == nil || now.After(*member.PasswordTokenExpiredAt) {
+		return helpers.NewResponse(http.StatusBadRequest, "Token is expired", nil, nil)
+	}
+
+	// hash password
+	hashedPassword, _ := bcrypt.GenerateFromPassword([]byte(payload.Password), bcrypt.DefaultCost)
 
-	return helpers.NewResponse(http.StatusOK, "Login successful", nil, map[string]any{
-		"token":     token,
-		"expiredAt": expiredAt,
-		"user":      member,
+	// clear the token so it can only be used once, and bump token version to revoke older sessions
+	matched, err := u.mongoDbRepo.UpdatePartialMemberBumpTokenVersion(ctx, map[string]interface{}{
+		"id":            member.ID,
+		"passwordToken": hashedToken,
+	}, map[string]interface{}{
+		"password":               string(hashedPassword),
+		"passwordToken":          "",
+		"passwordTokenExpiredAt": nil,
+		"passwordChangedAt":      now,
+		"updatedAt":              now,
 	})
+	if err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+	if !matched {
+		return helpers.NewResponse(http.StatusBadRequest, "Token is invalid", nil, nil)
+	}
+
+	return helpers.NewResponse(http.StatusOK, "Reset password successful", nil, nil)
 }
 
 func (u *memberAppUsecase) GetProfile(ctx context.Context, claim jwt_helpers.MemberJWTClaims) helpers.Response {
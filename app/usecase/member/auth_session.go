@@ -0,0 +1,81 @@
+package member_usecase
+
+import (
+	shared_usecase "app/app/usecase/shared"
+	mongo_model "app/domain/model/mongo"
+	"app/domain/request"
+	"app/helpers"
+	jwt_helpers "app/helpers/jwt"
+	"context"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+)
+
+// authSession the member side of the shared device session flow
+func (u *memberAppUsecase) authSession() shared_usecase.AuthSessionActor {
+	return shared_usecase.AuthSessionActor{
+		Role: mongo_model.ActorRoleMember,
+		FetchUser: func(ctx context.Context, userId string) (*shared_usecase.AuthSessionUser, error) {
+			member, err := u.mongoDbRepo.FetchOneMember(ctx, map[string]interface{}{
+				"id": userId,
+			})
+			if err != nil || member == nil {
+				return nil, err
+			}
+			return &shared_usecase.AuthSessionUser{TokenVersion: member.TokenVersion}, nil
+		},
+		BumpTokenVersion: func(ctx context.Context, userId string, now time.Time) (bool, error) {
+			return u.mongoDbRepo.UpdatePartialMemberBumpTokenVersion(ctx, map[string]interface{}{
+				"id": userId,
+			}, map[string]interface{}{
+				"updatedAt": now,
+			})
+		},
+		GenerateToken: generateMemberToken,
+	}
+}
+
+// createAuthSession start a new device session and issue its first access and refresh token
+func (u *memberAppUsecase) createAuthSession(ctx context.Context, member *mongo_model.Member) (map[string]any, error) {
+	return u.authSession().Create(ctx, u.mongoDbRepo, member.ID.Hex(), member.TokenVersion)
+}
+
+func generateMemberToken(ctx context.Context, session *mongo_model.AuthSession, now time.Time) (string, time.Time, map[string]any, error) {
+	expiredAt := now.Add(time.Duration(jwt_helpers.GetJWTTTL()) * time.Minute)
+	token, err := jwt_helpers.GenerateJWTTokenMember(jwt_helpers.MemberJWTClaims{
+		UserID:       session.UserID,
+		SessionID:    session.ID.Hex(),
+		TokenVersion: session.TokenVersion,
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        uuid.NewString(),
+			Issuer:    "member",
+			IssuedAt:  jwt.NewNumericDate(now),
+			NotBefore: jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(expiredAt),
+		},
+	})
+	return token, expiredAt, nil, err
+}
+
+func (u *memberAppUsecase) RefreshToken(ctx context.Context, payload request.RefreshTokenRequest) helpers.Response {
+	ctx, cancel := context.WithTimeout(ctx, u.contextTimeout)
+	defer cancel()
+
+	return u.authSession().Refresh(ctx, u.mongoDbRepo, payload)
+}
+
+func (u *memberAppUsecase) Logout(ctx context.Context, claim jwt_helpers.MemberJWTClaims) helpers.Response {
+	ctx, cancel := context.WithTimeout(ctx, u.contextTimeout)
+	defer cancel()
+
+	return u.authSession().Logout(ctx, u.mongoDbRepo, claim.SessionID, claim.UserID)
+}
+
+func (u *memberAppUsecase) LogoutAllDevices(ctx context.Context, claim jwt_helpers.MemberJWTClaims) helpers.Response {
+	ctx, cancel := context.WithTimeout(ctx, u.contextTimeout)
+	defer cancel()
+
+	return u.authSession().LogoutAllDevices(ctx, u.mongoDbRepo, claim.UserID)
+}
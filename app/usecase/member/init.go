@@ -8,18 +8,21 @@ import (
 type memberAppUsecase struct {
 	mongoDbRepo    domain.MongoDbRepo
 	xenditRepo     domain.XenditRepo
+	oidcRepo       domain.OIDCRepo
 	contextTimeout time.Duration
 }
 
 type RepoInjection struct {
 	MongoDbRepo domain.MongoDbRepo
 	XenditRepo  domain.XenditRepo
+	OIDCRepo    domain.OIDCRepo
 }
 
 func NewMemberAppUsecase(repoInjection RepoInjection, timeout time.Duration) domain.MemberAppUsecase {
 	return &memberAppUsecase{
 		mongoDbRepo:    repoInjection.MongoDbRepo,
 		xenditRepo:     repoInjection.XenditRepo,
+		oidcRepo:       repoInjection.OIDCRepo,
 		contextTimeout: timeout,
 	}
 }
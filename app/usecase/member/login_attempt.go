@@ -0,0 +1,25 @@
+package member_usecase
+
+import (
+	shared_usecase "app/app/usecase/shared"
+	mongo_model "app/domain/model/mongo"
+	mailing_helpers "app/helpers/mailing"
+	"context"
+)
+
+// checkLoginAttempt returns the refusal message while the account or ip is in backoff or locked
+func (u *memberAppUsecase) checkLoginAttempt(ctx context.Context, account, ip string) (string, error) {
+	return shared_usecase.CheckLoginAttempt(ctx, u.mongoDbRepo, mongo_model.ActorRoleMember, account, ip)
+}
+
+// recordLoginFailure count the failure on the account and ip, the owner is emailed when the account gets locked
+func (u *memberAppUsecase) recordLoginFailure(ctx context.Context, account, ip string, owner *mongo_model.Member) {
+	if lockedUntil := shared_usecase.RecordLoginFailure(ctx, u.mongoDbRepo, mongo_model.ActorRoleMember, account, ip); lockedUntil != nil && owner != nil {
+		go mailing_helpers.SendAccountLocked(owner.Name, owner.Email, *lockedUntil)
+	}
+}
+
+// clearLoginAttempt reset the account counter after a successful login
+func (u *memberAppUsecase) clearLoginAttempt(ctx context.Context, account string) {
+	shared_usecase.ClearLoginAttempt(ctx, u.mongoDbRepo, mongo_model.ActorRoleMember, account)
+}
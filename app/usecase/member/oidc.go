@@ -0,0 +1,212 @@
+package member_usecase
+
+import (
+	shared_usecase "app/app/usecase/shared"
+	mongo_model "app/domain/model/mongo"
+	"app/domain/request"
+	"app/helpers"
+	"context"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func (u *memberAppUsecase) OIDCAuthorize(ctx context.Context) helpers.Response {
+	ctx, cancel := context.WithTimeout(ctx, u.contextTimeout)
+	defer cancel()
+
+	if !u.oidcRepo.IsEnabled() {
+		return helpers.NewResponse(http.StatusNotFound, "Social login is not configured", nil, nil)
+	}
+
+	// generate state, nonce and pkce verifier
+	state, err := helpers.GenerateSecureRandomChar(32)
+	if err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+	nonce, err := helpers.GenerateSecureRandomChar(32)
+	if err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+	codeVerifier, err := helpers.GenerateSecureRandomChar(64)
+	if err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+
+	// build authorization url
+	authorizationUrl, err := u.oidcRepo.AuthorizationURL(ctx, state, nonce, helpers.PKCECodeChallenge(codeVerifier))
+	if err != nil {
+		return helpers.NewResponse(http.StatusBadGateway, "Identity provider is unavailable", nil, nil)
+	}
+
+	// save state until the callback
+	now := time.Now()
+	oidcState := &mongo_model.OIDCState{
+		ID:           primitive.NewObjectID(),
+		State:        state,
+		CodeVerifier: codeVerifier,
+		Nonce:        nonce,
+		ExpiredAt:    now.Add(time.Duration(helpers.GetOIDCStateTTL()) * time.Minute),
+		CreatedAt:    now,
+		UpdatedAt:    now,
+	}
+	if err := u.mongoDbRepo.CreateOneOIDCState(ctx, oidcState); err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+
+	return helpers.NewResponse(http.StatusOK, "Redirect to the authorization url", nil, map[string]any{
+		"authorizationUrl": authorizationUrl,
+		"state":            state,
+		"expiredAt":        oidcState.ExpiredAt,
+	})
+}
+
+func (u *memberAppUsecase) OIDCCallback(ctx context.Context, payload request.OIDCCallbackRequest) helpers.Response {
+	ctx, cancel := context.WithTimeout(ctx, u.contextTimeout)
+	defer cancel()
+
+	// validate payload
+	errValidation := make(map[string]string)
+	if payload.Code == "" {
+		errValidation["code"] = "Code field is required"
+	}
+	if payload.State == "" {
+		errValidation["state"] = "State field is required"
+	}
+	if len(errValidation) > 0 {
+		return helpers.NewResponse(http.StatusUnprocessableEntity, "Validation Error", errValidation, nil)
+	}
+
+	if !u.oidcRepo.IsEnabled() {
+		return helpers.NewResponse(http.StatusNotFound, "Social login is not configured", nil, nil)
+	}
+
+	// check state, it can only be used once
+	now := time.Now()
+	oidcState, err := u.mongoDbRepo.ConsumeOIDCState(ctx, payload.State, now)
+	if err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+	if oidcState == nil {
+		return helpers.NewResponse(http.StatusBadRequest, "Login request is invalid or expired, please try again", nil, nil)
+	}
+
+	// exchange code and validate id token
+	token, err := u.oidcRepo.ExchangeCode(ctx, payload.Code, oidcState.CodeVerifier)
+	if err != nil {
+		return helpers.NewResponse(http.StatusBadRequest, err.Error(), nil, nil)
+	}
+	claims, err := u.oidcRepo.VerifyIDToken(ctx, token.IDToken, oidcState.Nonce)
+	if err != nil {
+		logrus.Warn("OIDCCallback VerifyIDToken:", err)
+		return helpers.NewResponse(http.StatusUnauthorized, "Invalid id token", nil, nil)
+	}
+	if claims.Email == "" || !claims.IsEmailVerified() {
+		return helpers.NewResponse(http.StatusBadRequest, "Provider account has no verified email", nil, nil)
+	}
+
+	// find the linked member first, then an existing member by email
+	member, err := u.mongoDbRepo.FetchOneMember(ctx, map[string]interface{}{
+		"oidcIssuer":  claims.Issuer,
+		"oidcSubject": claims.Subject,
+	})
+	if err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+	isNewMember := false
+	if member == nil {
+		member, err = u.mongoDbRepo.FetchOneMember(ctx, map[string]interface{}{
+			"email": claims.Email,
+		})
+		if err != nil {
+			return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+		}
+
+		oidc := &mongo_model.MemberOIDC{
+			Issuer:   claims.Issuer,
+			Subject:  claims.Subject,
+			LinkedAt: now,
+		}
+		if member != nil {
+			if member.OIDC != nil {
+				return helpers.NewResponse(http.StatusBadRequest, "Email already linked to another provider account", nil, nil)
+			}
+
+			// the provider verified the email, so the member is verified too
+			member.OIDC = oidc
+			member.UpdatedAt = now
+			fields := map[string]interface{}{
+				"oidc":      member.OIDC,
+				"updatedAt": member.UpdatedAt,
+			}
+			if member.IsVerified {
+				if err := u.mongoDbRepo.UpdatePartialMember(ctx, map[string]interface{}{
+					"id": member.ID,
+				}, fields); err != nil {
+					return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+				}
+			} else {
+				// whoever registered the unverified account never proved owning the email,
+				// drop their password and sessions so only the provider account can log in
+				member.IsVerified = true
+				member.VerifiedAt = &now
+				member.Password = ""
+				member.PasswordToken = ""
+				member.PasswordTokenExpiredAt = nil
+				if member.PendingEmail == nil {
+					member.EmailToken = ""
+					member.EmailTokenExpiredAt = nil
+				}
+				fields["isVerified"] = member.IsVerified
+				fields["verifiedAt"] = member.VerifiedAt
+				fields["password"] = member.Password
+				fields["passwordToken"] = member.PasswordToken
+				fields["passwordTokenExpiredAt"] = member.PasswordTokenExpiredAt
+				fields["emailToken"] = member.EmailToken
+				fields["emailTokenExpiredAt"] = member.EmailTokenExpiredAt
+				if _, err := u.mongoDbRepo.UpdatePartialMemberBumpTokenVersion(ctx, map[string]interface{}{
+					"id": member.ID,
+				}, fields); err != nil {
+					return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+				}
+				if err := shared_usecase.RevokeAuthSessions(ctx, u.mongoDbRepo, mongo_model.ActorRoleMember, member.ID.Hex(), now); err != nil {
+					return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+				}
+				member.TokenVersion++
+			}
+		} else {
+			// create verified member without password, a password can be set later with forgot password
+			name := claims.Name
+			if name == "" {
+				name = strings.Split(claims.Email, "@")[0]
+			}
+			member = &mongo_model.Member{
+				ID:         primitive.NewObjectID(),
+				Name:       name,
+				Email:      claims.Email,
+				IsVerified: true,
+				VerifiedAt: &now,
+				OIDC:       oidc,
+				CreatedAt:  now,
+				UpdatedAt:  now,
+			}
+			if err := u.mongoDbRepo.CreateOneMember(ctx, member); err != nil {
+				return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+			}
+			isNewMember = true
+		}
+	}
+
+	// create session and generate token
+	result, err := u.createAuthSession(ctx, member)
+	if err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+	result["user"] = member
+	result["isNewMember"] = isNewMember
+
+	return helpers.NewResponse(http.StatusOK, "Login successful", nil, result)
+}
@@ -0,0 +1,121 @@
+package member_usecase
+
+import (
+	mongo_model "app/domain/model/mongo"
+	"app/helpers"
+	"context"
+	"net/http"
+	"net/url"
+
+	"github.com/sirupsen/logrus"
+)
+
+func (u *memberAppUsecase) GetPlayerStatsList(ctx context.Context, queryParam url.Values) helpers.Response {
+	ctx, cancel := context.WithTimeout(ctx, u.contextTimeout)
+	defer cancel()
+
+	// get limit offset
+	page, offset, limit := helpers.GetOffsetLimit(queryParam)
+
+	// validate query
+	sortBy := mongo_model.PlayerStatsSortGoal
+	if queryParam.Get("sort") != "" {
+		if !mongo_model.IsValidPlayerStatsSort(queryParam.Get("sort")) {
+			return helpers.NewResponse(http.StatusUnprocessableEntity, "Validation Error", map[string]string{
+				"sort": "Sort must be goal, assist, save, yellowCard, redCard, card or minute",
+			}, nil)
+		}
+		sortBy = mongo_model.PlayerStatsSort(queryParam.Get("sort"))
+	}
+
+	// default to the active season
+	seasonOptions := map[string]interface{}{
+		"status": mongo_model.SeasonStatusActive,
+	}
+	if queryParam.Get("seasonId") != "" {
+		seasonOptions = map[string]interface{}{
+			"id": queryParam.Get("seasonId"),
+		}
+	}
+	season, err := u.mongoDbRepo.FetchOneSeason(ctx, seasonOptions)
+	if err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+	if season == nil {
+		return helpers.NewResponse(http.StatusBadRequest, "Season not found", nil, nil)
+	}
+
+	// fetch matches of the season or series, only played matches count
+	matchOptions := map[string]interface{}{
+		"seasonId": season.ID.Hex(),
+		"statuses": []mongo_model.MatchStatus{
+			mongo_model.MatchStatusLive,
+			mongo_model.MatchStatusFinished,
+		},
+	}
+	if queryParam.Get("seriesId") != "" {
+		series, err := u.mongoDbRepo.FetchOneSeries(ctx, map[string]interface{}{
+			"id": queryParam.Get("seriesId"),
+		})
+		if err != nil {
+			return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+		}
+		if series == nil || series.SeasonID != season.ID.Hex() {
+			return helpers.NewResponse(http.StatusBadRequest, "Series not found in the season", nil, nil)
+		}
+		matchOptions["seriesId"] = series.ID.Hex()
+	}
+	matchCur, err := u.mongoDbRepo.FetchListMatch(ctx, matchOptions)
+	if err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+	defer matchCur.Close(ctx)
+
+	matchIds := make([]string, 0)
+	for matchCur.Next(ctx) {
+		var match mongo_model.Match
+		if err := matchCur.Decode(&match); err != nil {
+			logrus.Error("Match Decode:", err)
+			return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+		}
+		matchIds = append(matchIds, match.ID.Hex())
+	}
+	if len(matchIds) == 0 {
+		return helpers.NewResponse(http.StatusOK, "Success", nil, helpers.PaginatedResponse{
+			List:  []interface{}{},
+			Limit: limit,
+			Page:  page,
+			Total: 0,
+		})
+	}
+
+	// rank and paginate, ranks are within the filtered players
+	statsOptions := map[string]interface{}{
+		"matchIds": matchIds,
+		"sort":     sortBy,
+		"offset":   offset,
+		"limit":    limit,
+	}
+	if queryParam.Get("position") != "" {
+		statsOptions["position"] = queryParam.Get("position")
+	}
+	if queryParam.Get("seasonTeamId") != "" {
+		statsOptions["seasonTeamId"] = queryParam.Get("seasonTeamId")
+	}
+	ranked, total, err := u.mongoDbRepo.AggregatePlayerStats(ctx, statsOptions)
+	if err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+
+	list := make([]interface{}, 0, len(ranked))
+	for _, stats := range ranked {
+		list = append(list, stats)
+	}
+
+	return helpers.NewResponse(http.StatusOK, "Success", nil, helpers.PaginatedResponse{
+		Limit: limit,
+		Page:  page,
+		Total: total,
+		List:  list,
+	})
+}
@@ -0,0 +1,217 @@
+package member_usecase
+
+import (
+	mongo_model "app/domain/model/mongo"
+	"app/domain/request"
+	"app/helpers"
+	jwt_helpers "app/helpers/jwt"
+	"context"
+	"net/http"
+	"time"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+func (u *memberAppUsecase) UpdateProfile(ctx context.Context, claim jwt_helpers.MemberJWTClaims, payload request.MemberProfileUpdateRequest) helpers.Response {
+	ctx, cancel := context.WithTimeout(ctx, u.contextTimeout)
+	defer cancel()
+
+	// validate payload
+	errValidation := make(map[string]string)
+	if payload.Name == "" {
+		errValidation["name"] = "Name field is required"
+	}
+	if payload.Phone != "" && !helpers.IsValidPhoneNumber(payload.Phone) {
+		errValidation["phone"] = "Invalid phone number format, use 08xxx, 628xxx or +628xxx"
+	}
+	if payload.Age != nil && (*payload.Age < 1 || *payload.Age > 120) {
+		errValidation["age"] = "Age must be between 1 and 120"
+	}
+	if payload.Gender != "" && !helpers.InArrayString(mongo_model.GenderList, payload.Gender) {
+		errValidation["gender"] = "Invalid gender, required gender: " + helpers.ArrayStringtoString(mongo_model.GenderList)
+	}
+	if len(errValidation) > 0 {
+		return helpers.NewResponse(http.StatusUnprocessableEntity, "Validation Error", errValidation, nil)
+	}
+
+	// check member
+	member, err := u.mongoDbRepo.FetchOneMember(ctx, map[string]interface{}{
+		"id": claim.UserID,
+	})
+	if err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+	if member == nil {
+		return helpers.NewResponse(http.StatusBadRequest, "User not found", nil, nil)
+	}
+
+	// update member, empty optional fields are cleared
+	member.Name = payload.Name
+	member.Phone = nil
+	if payload.Phone != "" {
+		phone := helpers.NormalizePhoneNumber(payload.Phone)
+		member.Phone = &phone
+	}
+	member.Age = payload.Age
+	member.Gender = nil
+	if payload.Gender != "" {
+		member.Gender = &payload.Gender
+	}
+	member.UpdatedAt = time.Now()
+
+	// save
+	if err := u.mongoDbRepo.UpdatePartialMember(ctx, map[string]interface{}{
+		"id": member.ID,
+	}, map[string]interface{}{
+		"name":      member.Name,
+		"phone":     member.Phone,
+		"age":       member.Age,
+		"gender":    member.Gender,
+		"updatedAt": member.UpdatedAt,
+	}); err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+
+	return helpers.NewResponse(http.StatusOK, "Update profile success", nil, member)
+}
+
+func (u *memberAppUsecase) ChangePassword(ctx context.Context, claim jwt_helpers.MemberJWTClaims, payload request.ChangePasswordRequest) helpers.Response {
+	ctx, cancel := context.WithTimeout(ctx, u.contextTimeout)
+	defer cancel()
+
+	// validate payload
+	errValidation := make(map[string]string)
+	if payload.CurrentPassword == "" {
+		errValidation["currentPassword"] = "Current password field is required"
+	}
+	if payload.NewPassword == "" {
+		errValidation["newPassword"] = "New password field is required"
+	} else {
+		if !helpers.IsValidLengthPassword(payload.NewPassword) {
+			errValidation["newPassword"] = "Password must be at least 8 characters"
+		} else if !helpers.IsStrongPassword(payload.NewPassword) {
+			errValidation["newPassword"] = "Password must contain at least one uppercase letter, one lowercase letter, and one number"
+		}
+	}
+	if len(errValidation) > 0 {
+		return helpers.NewResponse(http.StatusUnprocessableEntity, "Validation Error", errValidation, nil)
+	}
+
+	// check member
+	member, err := u.mongoDbRepo.FetchOneMember(ctx, map[string]interface{}{
+		"id": claim.UserID,
+	})
+	if err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+	if member == nil {
+		return helpers.NewResponse(http.StatusBadRequest, "User not found", nil, nil)
+	}
+
+	// check current password
+	if err := bcrypt.CompareHashAndPassword([]byte(member.Password), []byte(payload.CurrentPassword)); err != nil {
+		return helpers.NewResponse(http.StatusBadRequest, "Wrong current password", nil, nil)
+	}
+
+	// hash password
+	hashedPassword, _ := bcrypt.GenerateFromPassword([]byte(payload.NewPassword), bcrypt.DefaultCost)
+
+	// save
+	now := time.Now()
+	if err := u.mongoDbRepo.UpdatePartialMember(ctx, map[string]interface{}{
+		"id": member.ID,
+	}, map[string]interface{}{
+		"password":          string(hashedPassword),
+		"passwordChangedAt": now,
+		"updatedAt":         now,
+	}); err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+
+	// log out the other devices, the current one stays logged in
+	if err := u.mongoDbRepo.UpdateManyAuthSessionPartial(ctx, map[string]interface{}{
+		"excludeId": claim.SessionID,
+		"role":      mongo_model.ActorRoleMember,
+		"userId":    claim.UserID,
+		"isRevoked": false,
+	}, map[string]interface{}{
+		"revokedAt": now,
+		"updatedAt": now,
+	}); err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+
+	return helpers.NewResponse(http.StatusOK, "Change password success", nil, nil)
+}
+
+func (u *memberAppUsecase) ChangeEmail(ctx context.Context, claim jwt_helpers.MemberJWTClaims, payload request.ChangeEmailRequest) helpers.Response {
+	ctx, cancel := context.WithTimeout(ctx, u.contextTimeout)
+	defer cancel()
+
+	// validate payload
+	errValidation := make(map[string]string)
+	if payload.Email == "" {
+		errValidation["email"] = "Email field is required"
+	} else if !helpers.IsValidEmail(payload.Email) {
+		errValidation["email"] = "Invalid email format"
+	}
+	if payload.Password == "" {
+		errValidation["password"] = "Password field is required"
+	}
+	if len(errValidation) > 0 {
+		return helpers.NewResponse(http.StatusUnprocessableEntity, "Validation Error", errValidation, nil)
+	}
+
+	// check member
+	member, err := u.mongoDbRepo.FetchOneMember(ctx, map[string]interface{}{
+		"id": claim.UserID,
+	})
+	if err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+	if member == nil {
+		return helpers.NewResponse(http.StatusBadRequest, "User not found", nil, nil)
+	}
+	if member.Email == payload.Email {
+		return helpers.NewResponse(http.StatusBadRequest, "New email is the same as current email", nil, nil)
+	}
+
+	// check password
+	if err := bcrypt.CompareHashAndPassword([]byte(member.Password), []byte(payload.Password)); err != nil {
+		return helpers.NewResponse(http.StatusBadRequest, "Wrong password", nil, nil)
+	}
+
+	// check email used
+	existing, err := u.mongoDbRepo.FetchOneMember(ctx, map[string]interface{}{
+		"email": payload.Email,
+	})
+	if err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+	if existing != nil {
+		return helpers.NewResponse(http.StatusBadRequest, "Email already used", nil, nil)
+	}
+
+	// keep the current email until the new one is verified
+	member.PendingEmail = &payload.Email
+	member.UpdatedAt = time.Now()
+	setEmailToken(member, member.UpdatedAt)
+
+	// save
+	if err := u.mongoDbRepo.UpdatePartialMember(ctx, map[string]interface{}{
+		"id": member.ID,
+	}, map[string]interface{}{
+		"pendingEmail":        member.PendingEmail,
+		"emailToken":          member.EmailToken,
+		"emailTokenExpiredAt": member.EmailTokenExpiredAt,
+		"emailTokenSentAt":    member.EmailTokenSentAt,
+		"updatedAt":           member.UpdatedAt,
+	}); err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+
+	// send email verification to the new email
+	go sendEmailVerification(member)
+
+	return helpers.NewResponse(http.StatusOK, "Verification email has been sent to the new email", nil, member)
+}
@@ -1,6 +1,7 @@
 package member_usecase
 
 import (
+	shared_usecase "app/app/usecase/shared"
 	mongo_model "app/domain/model/mongo"
 	xendit_model "app/domain/model/xendit"
 	"app/domain/request"
@@ -123,6 +124,9 @@ func (u *memberAppUsecase) CreatePurchase(ctx context.Context, claim jwt_helpers
 	if ticket == nil {
 		return helpers.NewResponse(http.StatusBadRequest, "Ticket not found", nil, nil)
 	}
+	if ticket.IsCancelled {
+		return helpers.NewResponse(http.StatusBadRequest, "Ticket day has been cancelled", nil, nil)
+	}
 
 	ticket.Format()
 
@@ -158,6 +162,14 @@ func (u *memberAppUsecase) CreatePurchase(ctx context.Context, claim jwt_helpers
 		return helpers.NewResponse(http.StatusBadRequest, "Series not found", nil, nil)
 	}
 
+	// check sale window of series and ticket day
+	if message := saleWindowMessage(series.Name, series.SaleStartAt, series.SaleEndAt); message != "" {
+		return helpers.NewResponse(http.StatusBadRequest, message, nil, nil)
+	}
+	if message := saleWindowMessage(ticket.Name, ticket.SaleStartAt, ticket.SaleEndAt); message != "" {
+		return helpers.NewResponse(http.StatusBadRequest, message, nil, nil)
+	}
+
 	// check season
 	season, err := u.mongoDbRepo.FetchOneSeason(ctx, map[string]interface{}{
 		"id": series.SeasonID,
@@ -174,14 +186,11 @@ func (u *memberAppUsecase) CreatePurchase(ctx context.Context, claim jwt_helpers
 	grandTotal := pricePcs * float64(payload.Amount)
 	now := time.Now()
 
-	// count purchase today for external ID
-	purchaseCount := u.mongoDbRepo.CountPurchase(ctx, map[string]interface{}{
-		"memberId": member.ID.Hex(),
-		"today":    true,
-	})
-
 	// generate external ID
-	externalId := helpers.GenerateInvoiceExternalId(purchaseCount)
+	externalId, err := shared_usecase.GenerateInvoiceExternalId(ctx, u.mongoDbRepo)
+	if err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
 
 	newPurchase := mongo_model.Purchase{
 		ID: primitive.NewObjectID(),
@@ -204,7 +213,7 @@ func (u *memberAppUsecase) CreatePurchase(ctx context.Context, claim jwt_helpers
 				ID:      payload.ProductId,
 				Name:    ticket.Name,
 				Date:    ticket.Date,
-				VenueID: series.VenueID,
+				VenueID: ticket.GetVenueID(),
 			},
 		},
 		Invoice: mongo_model.Invoice{
@@ -214,6 +223,7 @@ func (u *memberAppUsecase) CreatePurchase(ctx context.Context, claim jwt_helpers
 		Price:             pricePcs,
 		GrandTotal:        grandTotal,
 		IsCheckoutPackage: false,
+		Channel:           mongo_model.PurchaseChannelOnline,
 		Status:            mongo_model.PurchaseStatusPending,
 		CreatedAt:         now,
 		UpdatedAt:         now,
@@ -293,9 +303,15 @@ func (u *memberAppUsecase) CreatePackagePurchase(ctx context.Context, claim jwt_
 		return helpers.NewResponse(http.StatusBadRequest, "Series not found", nil, nil)
 	}
 
+	// check sale window of series
+	if message := saleWindowMessage(series.Name, series.SaleStartAt, series.SaleEndAt); message != "" {
+		return helpers.NewResponse(http.StatusBadRequest, message, nil, nil)
+	}
+
 	// check ticket
 	ticketCur, err := u.mongoDbRepo.FetchListTicket(ctx, map[string]interface{}{
-		"seriesId": payload.ProductId,
+		"seriesId":    payload.ProductId,
+		"isCancelled": false,
 	})
 	if err != nil {
 		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
@@ -325,11 +341,14 @@ func (u *memberAppUsecase) CreatePackagePurchase(ctx context.Context, claim jwt_
 		if ticket.Quota.Remaining < payload.Amount {
 			return helpers.NewResponse(http.StatusBadRequest, "Ticket quota is not enough", nil, nil)
 		}
+		if message := saleWindowMessage(ticket.Name, ticket.SaleStartAt, ticket.SaleEndAt); message != "" {
+			return helpers.NewResponse(http.StatusBadRequest, message, nil, nil)
+		}
 		ticketsFK = append(ticketsFK, mongo_model.TicketFK{
 			ID:      ticket.ID.Hex(),
 			Name:    ticket.Name,
 			Date:    ticket.Date,
-			VenueID: series.VenueID,
+			VenueID: ticket.GetVenueID(),
 		})
 	}
 
@@ -365,14 +384,11 @@ func (u *memberAppUsecase) CreatePackagePurchase(ctx context.Context, claim jwt_
 	grandTotal := pricePcs * float64(payload.Amount)
 	now := time.Now()
 
-	// count purchase today for external ID
-	purchaseCount := u.mongoDbRepo.CountPurchase(ctx, map[string]interface{}{
-		"memberId": member.ID.Hex(),
-		"today":    true,
-	})
-
 	// generate external ID
-	externalId := helpers.GenerateInvoiceExternalId(purchaseCount)
+	externalId, err := shared_usecase.GenerateInvoiceExternalId(ctx, u.mongoDbRepo)
+	if err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
 
 	newPurchase := mongo_model.Purchase{
 		ID: primitive.NewObjectID(),
@@ -398,6 +414,7 @@ func (u *memberAppUsecase) CreatePackagePurchase(ctx context.Context, claim jwt_
 		Price:             pricePcs,
 		GrandTotal:        grandTotal,
 		IsCheckoutPackage: true,
+		Channel:           mongo_model.PurchaseChannelOnline,
 		Status:            mongo_model.PurchaseStatusPending,
 		CreatedAt:         now,
 		UpdatedAt:         now,
@@ -444,3 +461,235 @@ func (u *memberAppUsecase) CreatePackagePurchase(ctx context.Context, claim jwt_
 
 	return helpers.NewResponse(http.StatusOK, "Purchase success", nil, newPurchase)
 }
+
+func (u *memberAppUsecase) CreateSeasonPassPurchase(ctx context.Context, claim jwt_helpers.MemberJWTClaims, payload request.CreatePurchaseRequest) helpers.Response {
+	ctx, cancel := context.WithTimeout(ctx, u.contextTimeout)
+	defer cancel()
+
+	// validate payload
+	errValidation := make(map[string]string)
+	if payload.ProductId == "" {
+		errValidation["productId"] = "Product ID field is required"
+	}
+	if payload.Amount <= 0 {
+		errValidation["amount"] = "Amount field is required"
+	}
+	if len(errValidation) > 0 {
+		return helpers.NewResponse(http.StatusUnprocessableEntity, "Validation Error", errValidation, nil)
+	}
+
+	// max amount 4
+	if payload.Amount > 4 {
+		return helpers.NewResponse(http.StatusBadRequest, "Max amount buy is 4", nil, nil)
+	}
+
+	// check season
+	season, err := u.mongoDbRepo.FetchOneSeason(ctx, map[string]interface{}{
+		"id":     payload.ProductId,
+		"status": mongo_model.SeasonStatusActive,
+	})
+	if err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+	if season == nil {
+		return helpers.NewResponse(http.StatusBadRequest, "Season not found", nil, nil)
+	}
+
+	// check season pass
+	if !season.Pass.IsEnabled {
+		return helpers.NewResponse(http.StatusBadRequest, "Season pass is not available", nil, nil)
+	}
+
+	// check active series in season
+	now := time.Now()
+	seriesCur, err := u.mongoDbRepo.FetchListSeries(ctx, map[string]interface{}{
+		"seasonId": season.ID.Hex(),
+		"status":   mongo_model.SeriesStatusActive,
+	})
+	if err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+	defer seriesCur.Close(ctx)
+
+	seriesIds := make([]string, 0)
+	seriesMap := make(map[string]mongo_model.Series)
+	for seriesCur.Next(ctx) {
+		row := mongo_model.Series{}
+		err := seriesCur.Decode(&row)
+		if err != nil {
+			logrus.Error("Series Decode:", err)
+			return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+		}
+
+		// a series whose sale has ended is not added, one still upcoming is covered by the pass
+		if mongo_model.NewSaleWindow(now, row.SaleStartAt, row.SaleEndAt).Status == mongo_model.SaleStatusClosed {
+			continue
+		}
+
+		seriesIds = append(seriesIds, row.ID.Hex())
+		seriesMap[row.ID.Hex()] = row
+	}
+
+	// check ticket of active series, series published later will be synced on publish
+	ticketsFK := make([]mongo_model.TicketFK, 0)
+	if len(seriesIds) > 0 {
+		ticketCur, err := u.mongoDbRepo.FetchListTicket(ctx, map[string]interface{}{
+			"seriesIds":   seriesIds,
+			"isCancelled": false,
+		})
+		if err != nil {
+			return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+		}
+		defer ticketCur.Close(ctx)
+
+		for ticketCur.Next(ctx) {
+			ticket := mongo_model.Ticket{}
+			err := ticketCur.Decode(&ticket)
+			if err != nil {
+				logrus.Error("Ticket Decode:", err)
+				return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+			}
+			if mongo_model.NewSaleWindow(now, ticket.SaleStartAt, ticket.SaleEndAt).Status == mongo_model.SaleStatusClosed {
+				continue
+			}
+
+			ticketsFK = append(ticketsFK, mongo_model.TicketFK{
+				ID:      ticket.ID.Hex(),
+				Name:    ticket.Name,
+				Date:    ticket.Date,
+				VenueID: ticket.GetVenueID(),
+			})
+		}
+	}
+
+	// check member
+	member, err := u.mongoDbRepo.FetchOneMember(ctx, map[string]interface{}{
+		"id": claim.UserID,
+	})
+	if err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+	if member == nil {
+		return helpers.NewResponse(http.StatusBadRequest, "User not found", nil, nil)
+	}
+
+	if member.Phone == nil {
+		phone := ""
+		member.Phone = &phone
+	}
+
+	// reserve season pass and ticket day quota, released again when the purchase can not be saved
+	reservedTicketIds := make([]string, 0, len(ticketsFK))
+	releaseQuota := func() {
+		if err := u.mongoDbRepo.IncrementOneSeason(ctx, season.ID.Hex(), map[string]int64{
+			"pass.quota.used": payload.Amount * -1,
+		}); err != nil {
+			logrus.Error("IncrementOneSeason:", err)
+		}
+		for _, ticketId := range reservedTicketIds {
+			if err := u.mongoDbRepo.IncrementOneTicket(ctx, ticketId, map[string]int64{
+				"quota.used": payload.Amount * -1,
+			}); err != nil {
+				logrus.Error("IncrementOneTicket:", err)
+			}
+		}
+	}
+	reserved, err := u.mongoDbRepo.ReserveSeasonPassQuota(ctx, season.ID.Hex(), payload.Amount)
+	if err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+	if !reserved {
+		return helpers.NewResponse(http.StatusBadRequest, "Season pass quota is not enough", nil, nil)
+	}
+	for _, ticketFK := range ticketsFK {
+		reserved, err := u.mongoDbRepo.ReserveTicketQuota(ctx, ticketFK.ID, payload.Amount)
+		if err != nil {
+			releaseQuota()
+			return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+		}
+		if !reserved {
+			releaseQuota()
+			return helpers.NewResponse(http.StatusBadRequest, "Ticket quota is not enough", nil, nil)
+		}
+		reservedTicketIds = append(reservedTicketIds, ticketFK.ID)
+	}
+
+	// create new purchase
+	pricePcs := season.Pass.Price
+	grandTotal := pricePcs * float64(payload.Amount)
+
+	// generate external ID
+	externalId, err := shared_usecase.GenerateInvoiceExternalId(ctx, u.mongoDbRepo)
+	if err != nil {
+		releaseQuota()
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+
+	newPurchase := mongo_model.Purchase{
+		ID: primitive.NewObjectID(),
+		Member: mongo_model.MemberPurchaseFK{
+			ID:    member.ID.Hex(),
+			Name:  member.Name,
+			Email: member.Email,
+			Phone: *member.Phone,
+		},
+		Season: mongo_model.SeasonFK{
+			ID:   season.ID.Hex(),
+			Name: season.Name,
+		},
+		Tickets: ticketsFK,
+		Invoice: mongo_model.Invoice{
+			InvoiceExternalID: externalId,
+		},
+		Amount:            payload.Amount,
+		Price:             pricePcs,
+		GrandTotal:        grandTotal,
+		IsCheckoutPackage: false,
+		IsSeasonPass:      true,
+		Channel:           mongo_model.PurchaseChannelOnline,
+		Status:            mongo_model.PurchaseStatusPending,
+		CreatedAt:         now,
+		UpdatedAt:         now,
+	}
+
+	if pricePcs > 0 {
+		// generate snap link
+		result, err := u.xenditRepo.GenereteSnapLink(ctx, newPurchase)
+		if err != nil || result.Status != http.StatusOK {
+			releaseQuota()
+			if result.Status != 0 {
+				return helpers.NewResponse(http.StatusBadRequest, result.Message, nil, nil)
+			}
+
+			return helpers.NewResponse(http.StatusBadRequest, err.Error(), nil, nil)
+		}
+		respDataXendit, _ := result.Data.(xendit_model.XenditSnapLinkSuccessResponse)
+
+		newPurchase.Invoice.InvoiceID = respDataXendit.ID
+		newPurchase.Invoice.InvoiceUrl = respDataXendit.InvoiceURL
+		newPurchase.Invoice.MerchantName = respDataXendit.MerchantName
+		newPurchase.ExpiredAt = respDataXendit.ExpiryDate
+	}
+
+	// save purchase
+	err = u.mongoDbRepo.CreateOnePurchase(ctx, &newPurchase)
+	if err != nil {
+		releaseQuota()
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+
+	return helpers.NewResponse(http.StatusOK, "Purchase success", nil, newPurchase)
+}
+
+// saleWindowMessage returns the reason a product can not be bought yet or anymore,
+// empty when the sale is open
+func saleWindowMessage(name string, startAt, endAt *time.Time) string {
+	switch mongo_model.NewSaleWindow(time.Now(), startAt, endAt).Status {
+	case mongo_model.SaleStatusUpcoming:
+		return "Sales for " + name + " open at " + helpers.FormatDateWIB(*startAt, "02 January 2006 15:04") + " WIB"
+	case mongo_model.SaleStatusClosed:
+		return "Sales for " + name + " have ended"
+	}
+
+	return ""
+}
@@ -0,0 +1,202 @@
+package member_usecase
+
+import (
+	shared_usecase "app/app/usecase/shared"
+	mongo_model "app/domain/model/mongo"
+	"app/domain/request"
+	"app/helpers"
+	jwt_helpers "app/helpers/jwt"
+	"context"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func (u *memberAppUsecase) GetRefundsList(ctx context.Context, claim jwt_helpers.MemberJWTClaims, queryParam url.Values) helpers.Response {
+	ctx, cancel := context.WithTimeout(ctx, u.contextTimeout)
+	defer cancel()
+
+	// get limit offset
+	page, offset, limit := helpers.GetOffsetLimit(queryParam)
+
+	fetchOptions := map[string]interface{}{
+		"limit":    limit,
+		"offset":   offset,
+		"memberId": claim.UserID,
+	}
+
+	// filtering
+	if queryParam.Get("purchaseId") != "" {
+		fetchOptions["purchaseId"] = queryParam.Get("purchaseId")
+	}
+
+	// count total
+	total := u.mongoDbRepo.CountRefund(ctx, fetchOptions)
+	if total == 0 {
+		return helpers.NewResponse(http.StatusOK, "Success", nil, helpers.PaginatedResponse{
+			List:  []interface{}{},
+			Limit: limit,
+			Page:  page,
+			Total: total,
+		})
+	}
+
+	// sorting
+	if queryParam.Get("sort") != "" {
+		fetchOptions["sort"] = queryParam.Get("sort")
+	}
+	if queryParam.Get("dir") != "" {
+		fetchOptions["dir"] = queryParam.Get("dir")
+	}
+
+	// fetch list
+	cur, err := u.mongoDbRepo.FetchListRefund(ctx, fetchOptions)
+	if err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+	defer cur.Close(ctx)
+
+	var list []interface{}
+	for cur.Next(ctx) {
+		row := mongo_model.Refund{}
+		err = cur.Decode(&row)
+		if err != nil {
+			logrus.Error("Refund Decode:", err)
+			return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+		}
+
+		list = append(list, row.Format())
+	}
+
+	return helpers.NewResponse(http.StatusOK, "Success", nil, helpers.PaginatedResponse{
+		Limit: limit,
+		Page:  page,
+		Total: total,
+		List:  list,
+	})
+}
+
+// RequestRescheduleRefund lets holder of a rescheduled ticket day give up its tickets for a refund
+// while the opt in window is still open
+func (u *memberAppUsecase) RequestRescheduleRefund(ctx context.Context, claim jwt_helpers.MemberJWTClaims, purchaseId string, payload request.RescheduleRefundRequest) helpers.Response {
+	ctx, cancel := context.WithTimeout(ctx, u.contextTimeout)
+	defer cancel()
+
+	// validate payload
+	errValidation := make(map[string]string)
+	if payload.TicketID == "" {
+		errValidation["ticketId"] = "Ticket ID field is required"
+	}
+	if len(errValidation) > 0 {
+		return helpers.NewResponse(http.StatusUnprocessableEntity, "Validation Error", errValidation, nil)
+	}
+
+	// check purchase
+	purchase, err := u.mongoDbRepo.FetchOnePurchase(ctx, map[string]interface{}{
+		"id":       purchaseId,
+		"memberId": claim.UserID,
+		"ticketId": payload.TicketID,
+	})
+	if err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+	if purchase == nil {
+		return helpers.NewResponse(http.StatusBadRequest, "Purchase not found", nil, nil)
+	}
+	if purchase.Status != mongo_model.PurchaseStatusPaid {
+		return helpers.NewResponse(http.StatusBadRequest, "Only paid purchase can be refunded", nil, nil)
+	}
+
+	// check ticket
+	ticket, err := u.mongoDbRepo.FetchOneTicket(ctx, map[string]interface{}{
+		"id": payload.TicketID,
+	})
+	if err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+	if ticket == nil {
+		return helpers.NewResponse(http.StatusBadRequest, "Ticket not found", nil, nil)
+	}
+	if ticket.Reschedule == nil {
+		return helpers.NewResponse(http.StatusBadRequest, "Ticket day has not been rescheduled", nil, nil)
+	}
+	now := time.Now()
+	if now.After(ticket.Reschedule.RefundOptInUntil) {
+		return helpers.NewResponse(http.StatusBadRequest, "Refund window closed at "+helpers.FormatDateWIB(ticket.Reschedule.RefundOptInUntil, "02 January 2006 15:04")+" WIB", nil, nil)
+	}
+
+	// check refund already requested
+	existingRefund, err := u.mongoDbRepo.FetchOneRefund(ctx, map[string]interface{}{
+		"purchaseId": purchase.ID.Hex(),
+		"ticketId":   ticket.ID.Hex(),
+	})
+	if err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+	if existingRefund != nil {
+		return helpers.NewResponse(http.StatusBadRequest, "Refund already requested", nil, nil)
+	}
+
+	// used ticket can not be refunded
+	ticketPurchaseOptions := map[string]interface{}{
+		"purchaseId": purchase.ID.Hex(),
+		"ticketId":   ticket.ID.Hex(),
+		"isVoided":   false,
+	}
+	usedOptions := map[string]interface{}{
+		"isUsed": true,
+	}
+	for key, value := range ticketPurchaseOptions {
+		usedOptions[key] = value
+	}
+	if u.mongoDbRepo.CountTicketPurchase(ctx, usedOptions) > 0 {
+		return helpers.NewResponse(http.StatusBadRequest, "Ticket already used", nil, nil)
+	}
+
+	// void ticket purchases of this day
+	err = u.mongoDbRepo.UpdateManyTicketPurchasePartial(ctx, ticketPurchaseOptions, map[string]interface{}{
+		"isVoided":   true,
+		"voidedAt":   now,
+		"voidReason": "Refund requested after reschedule",
+		"updatedAt":  now,
+	})
+	if err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+
+	// queue refund
+	refunded, err := shared_usecase.FetchRefundedTotal(ctx, u.mongoDbRepo, purchase.ID.Hex())
+	if err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+	refund := mongo_model.Refund{
+		ID:         primitive.NewObjectID(),
+		PurchaseID: purchase.ID.Hex(),
+		Invoice:    purchase.Invoice,
+		Channel:    purchase.Format().Channel,
+		Member:     purchase.Member,
+		Ticket: mongo_model.TicketFK{
+			ID:      ticket.ID.Hex(),
+			Name:    ticket.Name,
+			Date:    ticket.Date,
+			VenueID: ticket.GetVenueID(),
+		},
+		Reason:    mongo_model.RefundReasonTicketDayRescheduled,
+		Amount:    purchase.RefundShare(ticket.ID.Hex(), ticket.Price, refunded),
+		Status:    mongo_model.RefundStatusPending,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	created, err := u.mongoDbRepo.CreateOneRefundIfNotExists(ctx, &refund)
+	if err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+	if !created {
+		return helpers.NewResponse(http.StatusBadRequest, "Refund already requested", nil, nil)
+	}
+
+	return helpers.NewResponse(http.StatusCreated, "Success", nil, refund.Format())
+}
@@ -1,6 +1,7 @@
 package member_usecase
 
 import (
+	shared_usecase "app/app/usecase/shared"
 	mongo_model "app/domain/model/mongo"
 	"app/helpers"
 	"context"
@@ -65,16 +66,26 @@ func (u *memberAppUsecase) GetTicketsList(ctx context.Context, queryParam url.Va
 		tickets = append(tickets, row)
 	}
 
-	// check venue
-	venue, err := u.mongoDbRepo.FetchOneVenue(ctx, map[string]interface{}{
-		"id": tickets[0].Matchs[0].VenueID,
-	})
+	// check venue without duplicate
+	venueIdSet := make(map[string]struct{})
+	for _, ticket := range tickets {
+		venueIdSet[ticket.GetVenueID()] = struct{}{}
+		for _, match := range ticket.Matchs {
+			venueIdSet[match.VenueID] = struct{}{}
+		}
+	}
+
+	// set ids to slice
+	venueIds := make([]string, 0, len(venueIdSet))
+	for id := range venueIdSet {
+		venueIds = append(venueIds, id)
+	}
+
+	// fetch venue
+	venueMap, err := shared_usecase.FetchVenueMap(ctx, u.mongoDbRepo, venueIds)
 	if err != nil {
 		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
 	}
-	if venue == nil {
-		venue = &mongo_model.Venue{}
-	}
 
 	// check season team without duplicate
 	seasonTeamIdSet := make(map[string]struct{})
@@ -116,10 +127,16 @@ func (u *memberAppUsecase) GetTicketsList(ctx context.Context, queryParam url.Va
 	// set list
 	var list []interface{}
 	for _, ticket := range tickets {
+		ticket.VenueID = ticket.GetVenueID()
+		ticket.Venue = mongo_model.VenueFK{
+			ID:   ticket.VenueID,
+			Name: venueMap[ticket.VenueID].Name,
+		}
+
 		for i := range ticket.Matchs {
 			ticket.Matchs[i].Venue = mongo_model.VenueFK{
-				ID:   venue.ID.Hex(),
-				Name: venue.Name,
+				ID:   ticket.Matchs[i].VenueID,
+				Name: venueMap[ticket.Matchs[i].VenueID].Name,
 			}
 
 			homeSeasonTeam, ok := seasonTeamMap[ticket.Matchs[i].HomeSeasonTeamID]
@@ -181,16 +198,23 @@ func (u *memberAppUsecase) GetTicketDetail(ctx context.Context, id string) helpe
 		return helpers.NewResponse(http.StatusBadRequest, "Ticket not found", nil, nil)
 	}
 
-	// check venue
-	venue, err := u.mongoDbRepo.FetchOneVenue(ctx, map[string]interface{}{
-		"id": ticket.Matchs[0].VenueID,
-	})
+	// check venue without duplicate
+	venueIdSet := map[string]struct{}{ticket.GetVenueID(): {}}
+	for _, match := range ticket.Matchs {
+		venueIdSet[match.VenueID] = struct{}{}
+	}
+
+	// set ids to slice
+	venueIds := make([]string, 0, len(venueIdSet))
+	for id := range venueIdSet {
+		venueIds = append(venueIds, id)
+	}
+
+	// fetch venue
+	venueMap, err := shared_usecase.FetchVenueMap(ctx, u.mongoDbRepo, venueIds)
 	if err != nil {
 		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
 	}
-	if venue == nil {
-		venue = &mongo_model.Venue{}
-	}
 
 	// check season team without duplicate
 	seasonTeamIdSet := make(map[string]struct{})
@@ -228,10 +252,15 @@ func (u *memberAppUsecase) GetTicketDetail(ctx context.Context, id string) helpe
 	}
 
 	// set detail ticket
+	ticket.VenueID = ticket.GetVenueID()
+	ticket.Venue = mongo_model.VenueFK{
+		ID:   ticket.VenueID,
+		Name: venueMap[ticket.VenueID].Name,
+	}
 	for i := range ticket.Matchs {
 		ticket.Matchs[i].Venue = mongo_model.VenueFK{
-			ID:   venue.ID.Hex(),
-			Name: venue.Name,
+			ID:   ticket.Matchs[i].VenueID,
+			Name: venueMap[ticket.Matchs[i].VenueID].Name,
 		}
 
 		homeSeasonTeam, ok := seasonTeamMap[ticket.Matchs[i].HomeSeasonTeamID]
@@ -327,9 +356,23 @@ func (u *memberAppUsecase) getTicketsBySeriesIDs(ctx context.Context, seriesIds
 		seasonTeamMap[st.ID.Hex()] = st
 	}
 
-	// set season teams to tickets
+	// fetch venue of each ticket day
+	venueIds := helpers.ExtractIds(tickets, func(m mongo_model.Ticket) string {
+		return m.GetVenueID()
+	})
+	venueMap, err := shared_usecase.FetchVenueMap(ctx, u.mongoDbRepo, venueIds)
+	if err != nil {
+		return nil, err
+	}
+
+	// set season teams and venue to tickets
 	for i := range tickets {
 		tickets[i].Format()
+		tickets[i].VenueID = tickets[i].GetVenueID()
+		tickets[i].Venue = mongo_model.VenueFK{
+			ID:   tickets[i].VenueID,
+			Name: venueMap[tickets[i].VenueID].Name,
+		}
 		for j := range tickets[i].Matchs {
 			homeID := tickets[i].Matchs[j].HomeSeasonTeamID
 			if st, ok := seasonTeamMap[homeID]; ok {
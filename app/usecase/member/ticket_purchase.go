@@ -1,7 +1,9 @@
 package member_usecase
 
 import (
+	shared_usecase "app/app/usecase/shared"
 	mongo_model "app/domain/model/mongo"
+	"app/domain/request"
 	"app/helpers"
 	jwt_helpers "app/helpers/jwt"
 	"context"
@@ -59,6 +61,11 @@ func (u *memberAppUsecase) GetTicketPurchasesList(ctx context.Context, claim jwt
 			return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
 		}
 
+		// the code is the entry credential, support staff viewing as the member must not see it
+		if claim.IsImpersonated() {
+			row.Code = ""
+		}
+
 		list = append(list, row)
 	}
 
@@ -69,3 +76,26 @@ func (u *memberAppUsecase) GetTicketPurchasesList(ctx context.Context, claim jwt
 		List:  list,
 	})
 }
+
+func (u *memberAppUsecase) ReissueTicketPurchase(ctx context.Context, claim jwt_helpers.MemberJWTClaims, id string, payload request.TicketPurchaseReissueRequest) helpers.Response {
+	ctx, cancel := context.WithTimeout(ctx, u.contextTimeout)
+	defer cancel()
+
+	// check ticket purchase
+	ticketPurchase, err := u.mongoDbRepo.FetchOneTicketPurchase(ctx, map[string]interface{}{
+		"id":       id,
+		"memberId": claim.UserID,
+	})
+	if err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+	if ticketPurchase == nil {
+		return helpers.NewResponse(http.StatusBadRequest, "Ticket not found", nil, nil)
+	}
+
+	return shared_usecase.ReissueTicketPurchase(ctx, u.mongoDbRepo, ticketPurchase, payload.Reason, mongo_model.ActorFK{
+		ID:   claim.UserID,
+		Name: ticketPurchase.Member.Name,
+		Role: mongo_model.ActorRoleMember,
+	})
+}
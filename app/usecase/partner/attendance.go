@@ -0,0 +1,111 @@
+package partner_usecase
+
+import (
+	shared_usecase "app/app/usecase/shared"
+	mongo_model "app/domain/model/mongo"
+	"app/helpers"
+	"context"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+type ticketAttendance struct {
+	TicketID    string              `json:"ticketId"`
+	Name        string              `json:"name"`
+	Date        time.Time           `json:"date"`
+	Venue       mongo_model.VenueFK `json:"venue"`
+	IsCancelled bool                `json:"isCancelled"`
+	Stock       int64               `json:"stock"`
+	Sold        int64               `json:"sold"`
+	Issued      int64               `json:"issued"`
+	CheckedIn   int64               `json:"checkedIn"`
+}
+
+func (u *partnerAppUsecase) GetAttendanceList(ctx context.Context, queryParam url.Values) helpers.Response {
+	ctx, cancel := context.WithTimeout(ctx, u.contextTimeout)
+	defer cancel()
+
+	// ticket days of a single day, default today
+	fetchOptions := map[string]interface{}{
+		"sort": "date",
+		"dir":  "asc",
+	}
+	if queryParam.Get("ticketId") != "" {
+		fetchOptions["id"] = queryParam.Get("ticketId")
+	} else {
+		date := time.Now()
+		if queryParam.Get("date") != "" {
+			loc, _ := time.LoadLocation("Asia/Jakarta")
+			parsedDate, err := time.ParseInLocation("2006-01-02", queryParam.Get("date"), loc)
+			if err != nil {
+				return helpers.NewResponse(http.StatusUnprocessableEntity, "Validation Error", map[string]string{
+					"date": "Date must be in format YYYY-MM-DD",
+				}, nil)
+			}
+			date = parsedDate
+		}
+		fetchOptions["dateFrom"] = helpers.SetToStartOfDayWIB(date)
+		fetchOptions["dateTo"] = helpers.SetToEndOfDayWIB(date)
+	}
+
+	// fetch tickets
+	cur, err := u.mongoDbRepo.FetchListTicket(ctx, fetchOptions)
+	if err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+	defer cur.Close(ctx)
+
+	var tickets []mongo_model.Ticket
+	for cur.Next(ctx) {
+		row := mongo_model.Ticket{}
+		err := cur.Decode(&row)
+		if err != nil {
+			logrus.Error("Ticket Decode:", err)
+			return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+		}
+
+		tickets = append(tickets, row)
+	}
+
+	// fetch venue
+	venueIds := make([]string, 0, len(tickets))
+	for _, ticket := range tickets {
+		venueIds = append(venueIds, ticket.GetVenueID())
+	}
+	venueMap, err := shared_usecase.FetchVenueMap(ctx, u.mongoDbRepo, venueIds)
+	if err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+
+	// count issued and checked in ticket purchases of each ticket day
+	list := make([]ticketAttendance, 0, len(tickets))
+	for _, ticket := range tickets {
+		venueId := ticket.GetVenueID()
+		list = append(list, ticketAttendance{
+			TicketID: ticket.ID.Hex(),
+			Name:     ticket.Name,
+			Date:     ticket.Date,
+			Venue: mongo_model.VenueFK{
+				ID:   venueId,
+				Name: venueMap[venueId].Name,
+			},
+			IsCancelled: ticket.IsCancelled,
+			Stock:       ticket.Quota.Stock,
+			Sold:        ticket.Quota.Used,
+			Issued: u.mongoDbRepo.CountTicketPurchase(ctx, map[string]interface{}{
+				"ticketId": ticket.ID.Hex(),
+				"isVoided": false,
+			}),
+			CheckedIn: u.mongoDbRepo.CountTicketPurchase(ctx, map[string]interface{}{
+				"ticketId": ticket.ID.Hex(),
+				"isVoided": false,
+				"isUsed":   true,
+			}),
+		})
+	}
+
+	return helpers.NewResponse(http.StatusOK, "Success", nil, list)
+}
@@ -0,0 +1,155 @@
+package partner_usecase
+
+import (
+	shared_usecase "app/app/usecase/shared"
+	mongo_model "app/domain/model/mongo"
+	"app/helpers"
+	"context"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+func (u *partnerAppUsecase) GetFixturesList(ctx context.Context, queryParam url.Values) helpers.Response {
+	ctx, cancel := context.WithTimeout(ctx, u.contextTimeout)
+	defer cancel()
+
+	// get limit offset
+	page, offset, limit := helpers.GetOffsetLimit(queryParam)
+
+	fetchOptions := map[string]interface{}{
+		"limit":  limit,
+		"offset": offset,
+	}
+
+	// filtering
+	if queryParam.Get("seriesId") != "" {
+		fetchOptions["seriesId"] = queryParam.Get("seriesId")
+	}
+
+	// date range in WIB, both ends inclusive
+	errValidation := make(map[string]string)
+	loc, _ := time.LoadLocation("Asia/Jakarta")
+	if queryParam.Get("startDate") != "" {
+		startDate, err := time.ParseInLocation("2006-01-02", queryParam.Get("startDate"), loc)
+		if err != nil {
+			errValidation["startDate"] = "Start date must be in format YYYY-MM-DD"
+		} else {
+			fetchOptions["dateFrom"] = helpers.SetToStartOfDayWIB(startDate)
+		}
+	}
+	if queryParam.Get("endDate") != "" {
+		endDate, err := time.ParseInLocation("2006-01-02", queryParam.Get("endDate"), loc)
+		if err != nil {
+			errValidation["endDate"] = "End date must be in format YYYY-MM-DD"
+		} else {
+			fetchOptions["dateTo"] = helpers.SetToEndOfDayWIB(endDate)
+		}
+	}
+	if len(errValidation) > 0 {
+		return helpers.NewResponse(http.StatusUnprocessableEntity, "Validation Error", errValidation, nil)
+	}
+
+	// count total
+	total := u.mongoDbRepo.CountTicket(ctx, fetchOptions)
+	if total == 0 {
+		return helpers.NewResponse(http.StatusOK, "Success", nil, helpers.PaginatedResponse{
+			Limit: limit,
+			Page:  page,
+			Total: total,
+			List:  make([]interface{}, 0),
+		})
+	}
+
+	// sorting, fixtures default to the nearest date first
+	fetchOptions["sort"] = "date"
+	fetchOptions["dir"] = "asc"
+	if queryParam.Get("sort") != "" {
+		fetchOptions["sort"] = queryParam.Get("sort")
+	}
+	if queryParam.Get("dir") != "" {
+		fetchOptions["dir"] = queryParam.Get("dir")
+	}
+
+	// fetching
+	cur, err := u.mongoDbRepo.FetchListTicket(ctx, fetchOptions)
+	if err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+	defer cur.Close(ctx)
+
+	var tickets []mongo_model.Ticket
+	for cur.Next(ctx) {
+		row := mongo_model.Ticket{}
+		err := cur.Decode(&row)
+		if err != nil {
+			logrus.Error("Ticket Decode:", err)
+			return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+		}
+
+		tickets = append(tickets, row)
+	}
+
+	// check venue and season team without duplicate
+	venueIdSet := make(map[string]struct{})
+	seasonTeamIdSet := make(map[string]struct{})
+	for _, ticket := range tickets {
+		venueIdSet[ticket.GetVenueID()] = struct{}{}
+		for _, match := range ticket.Matchs {
+			venueIdSet[match.VenueID] = struct{}{}
+			seasonTeamIdSet[match.HomeSeasonTeamID] = struct{}{}
+			seasonTeamIdSet[match.AwaySeasonTeamID] = struct{}{}
+		}
+	}
+
+	// set ids to slice
+	venueIds := make([]string, 0, len(venueIdSet))
+	for id := range venueIdSet {
+		venueIds = append(venueIds, id)
+	}
+	seasonTeamIds := make([]string, 0, len(seasonTeamIdSet))
+	for id := range seasonTeamIdSet {
+		seasonTeamIds = append(seasonTeamIds, id)
+	}
+
+	// fetch venue
+	venueMap, err := shared_usecase.FetchVenueMap(ctx, u.mongoDbRepo, venueIds)
+	if err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+
+	// fetch season team
+	seasonTeamMap, err := shared_usecase.FetchSeasonTeamMap(ctx, u.mongoDbRepo, seasonTeamIds)
+	if err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+
+	// set list
+	var list []interface{}
+	for _, ticket := range tickets {
+		ticket.VenueID = ticket.GetVenueID()
+		ticket.Venue = mongo_model.VenueFK{
+			ID:   ticket.VenueID,
+			Name: venueMap[ticket.VenueID].Name,
+		}
+
+		for i := range ticket.Matchs {
+			ticket.Matchs[i].Venue = mongo_model.VenueFK{
+				ID:   ticket.Matchs[i].VenueID,
+				Name: venueMap[ticket.Matchs[i].VenueID].Name,
+			}
+			ticket.Matchs[i].HomeSeasonTeam = shared_usecase.ToSeasonTeamFK(seasonTeamMap[ticket.Matchs[i].HomeSeasonTeamID])
+			ticket.Matchs[i].AwaySeasonTeam = shared_usecase.ToSeasonTeamFK(seasonTeamMap[ticket.Matchs[i].AwaySeasonTeamID])
+		}
+		list = append(list, ticket.Format())
+	}
+
+	return helpers.NewResponse(http.StatusOK, "Success", nil, helpers.PaginatedResponse{
+		Limit: limit,
+		Page:  page,
+		Total: total,
+		List:  list,
+	})
+}
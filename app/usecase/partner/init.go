@@ -0,0 +1,22 @@
+package partner_usecase
+
+import (
+	"app/domain"
+	"time"
+)
+
+type partnerAppUsecase struct {
+	mongoDbRepo    domain.MongoDbRepo
+	contextTimeout time.Duration
+}
+
+type RepoInjection struct {
+	MongoDbRepo domain.MongoDbRepo
+}
+
+func NewPartnerAppUsecase(repoInjection RepoInjection, timeout time.Duration) domain.PartnerAppUsecase {
+	return &partnerAppUsecase{
+		mongoDbRepo:    repoInjection.MongoDbRepo,
+		contextTimeout: timeout,
+	}
+}
@@ -0,0 +1,15 @@
+package partner_usecase
+
+import (
+	shared_usecase "app/app/usecase/shared"
+	"app/helpers"
+	"context"
+	"net/url"
+)
+
+func (u *partnerAppUsecase) GetStandingsList(ctx context.Context, queryParam url.Values) helpers.Response {
+	ctx, cancel := context.WithTimeout(ctx, u.contextTimeout)
+	defer cancel()
+
+	return shared_usecase.GetStandingsList(ctx, u.mongoDbRepo, queryParam)
+}
@@ -0,0 +1,41 @@
+package shared_usecase
+
+import (
+	"app/domain"
+	mongo_model "app/domain/model/mongo"
+	"app/helpers"
+	"context"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// RecordAuditLog saves who changed the entity and how, before is a snapshot taken before the change,
+// a failure is only logged so the mutation itself is never rolled back
+func RecordAuditLog(ctx context.Context, repo domain.MongoDbRepo, action mongo_model.AuditAction, entityType mongo_model.AuditEntity, entityId string, before bson.M, after interface{}) {
+	actor, _ := helpers.GetAuditActor(ctx)
+	changedBefore, changedAfter := helpers.AuditDiff(before, helpers.AuditSnapshot(after))
+
+	now := time.Now()
+	if err := repo.CreateOneAuditLog(ctx, &mongo_model.AuditLog{
+		ID: primitive.NewObjectID(),
+		Actor: mongo_model.ActorFK{
+			ID:   actor.ID,
+			Name: actor.Name,
+			Role: mongo_model.ActorRole(actor.Role),
+		},
+		Action:     action,
+		EntityType: entityType,
+		EntityID:   entityId,
+		Before:     changedBefore,
+		After:      changedAfter,
+		IP:         actor.IP,
+		UserAgent:  actor.UserAgent,
+		CreatedAt:  now,
+		UpdatedAt:  now,
+	}); err != nil {
+		logrus.Errorf("RecordAuditLog %s %s %s: %v", action, entityType, entityId, err)
+	}
+}
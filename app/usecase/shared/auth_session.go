@@ -0,0 +1,207 @@
+package shared_usecase
+
+import (
+	"app/domain"
+	mongo_model "app/domain/model/mongo"
+	"app/domain/request"
+	"app/helpers"
+	jwt_helpers "app/helpers/jwt"
+	"context"
+	"net/http"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// AuthSessionUser is the state of the account an auth session belongs to
+type AuthSessionUser struct {
+	TokenVersion int
+	IsDisabled   bool
+}
+
+// AuthSessionActor runs the device session flow of one actor role.
+// The role supplies how its users are loaded, how their token version is bumped and how their access token is signed
+type AuthSessionActor struct {
+	Role mongo_model.ActorRole
+	// FetchUser returns nil when the user does not exist
+	FetchUser func(ctx context.Context, userId string) (*AuthSessionUser, error)
+	// BumpTokenVersion increments the token version, matched is false when the user does not exist
+	BumpTokenVersion func(ctx context.Context, userId string, now time.Time) (matched bool, err error)
+	// GenerateToken signs the access token of the session
+	GenerateToken func(ctx context.Context, session *mongo_model.AuthSession, now time.Time) (token string, expiredAt time.Time, extra map[string]any, err error)
+}
+
+// Create start a new device session and issue its first access and refresh token
+func (a AuthSessionActor) Create(ctx context.Context, repo domain.MongoDbRepo, userId string, tokenVersion int) (map[string]any, error) {
+	refreshToken, err := helpers.GenerateSecureRandomChar(64)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	session := &mongo_model.AuthSession{
+		ID:               primitive.NewObjectID(),
+		Role:             a.Role,
+		UserID:           userId,
+		RefreshTokenHash: helpers.HashToken(refreshToken),
+		TokenVersion:     tokenVersion,
+		ExpiredAt:        now.Add(time.Duration(jwt_helpers.GetJWTRefreshTTL()) * time.Minute),
+		CreatedAt:        now,
+		UpdatedAt:        now,
+	}
+	if err := repo.CreateOneAuthSession(ctx, session); err != nil {
+		return nil, err
+	}
+
+	return a.generateTokenPair(ctx, session, refreshToken, now)
+}
+
+func (a AuthSessionActor) generateTokenPair(ctx context.Context, session *mongo_model.AuthSession, refreshToken string, now time.Time) (map[string]any, error) {
+	token, expiredAt, extra, err := a.GenerateToken(ctx, session, now)
+	if err != nil {
+		return nil, err
+	}
+
+	result := map[string]any{
+		"token":            token,
+		"expiredAt":        expiredAt,
+		"refreshToken":     refreshToken,
+		"refreshExpiredAt": session.ExpiredAt,
+	}
+	for key, value := range extra {
+		result[key] = value
+	}
+	return result, nil
+}
+
+// Refresh rotates the refresh token and issues a new access token of the same session
+func (a AuthSessionActor) Refresh(ctx context.Context, repo domain.MongoDbRepo, payload request.RefreshTokenRequest) helpers.Response {
+	// validate payload
+	errValidation := make(map[string]string)
+	if payload.RefreshToken == "" {
+		errValidation["refreshToken"] = "Refresh token field is required"
+	}
+	if len(errValidation) > 0 {
+		return helpers.NewResponse(http.StatusUnprocessableEntity, "Validation Error", errValidation, nil)
+	}
+
+	now := time.Now()
+	refreshTokenHash := helpers.HashToken(payload.RefreshToken)
+
+	// check session
+	session, err := repo.FetchOneAuthSession(ctx, map[string]interface{}{
+		"role":             a.Role,
+		"refreshTokenHash": refreshTokenHash,
+	})
+	if err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+	if session == nil {
+		// a rotated refresh token used again means it has leaked, end the whole session
+		reusedSession, err := repo.FetchOneAuthSession(ctx, map[string]interface{}{
+			"role":                     a.Role,
+			"previousRefreshTokenHash": refreshTokenHash,
+			"isRevoked":                false,
+		})
+		if err != nil {
+			return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+		}
+		if reusedSession != nil {
+			if err := repo.UpdatePartialAuthSession(ctx, map[string]interface{}{
+				"id": reusedSession.ID,
+			}, map[string]interface{}{
+				"revokedAt": now,
+				"updatedAt": now,
+			}); err != nil {
+				return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+			}
+		}
+		return helpers.NewResponse(http.StatusUnauthorized, "Refresh token is invalid", nil, nil)
+	}
+	if !session.IsActive(now) {
+		return helpers.NewResponse(http.StatusUnauthorized, "Refresh token is expired or revoked", nil, nil)
+	}
+
+	// check user
+	user, err := a.FetchUser(ctx, session.UserID)
+	if err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+	if user == nil {
+		return helpers.NewResponse(http.StatusUnauthorized, "User not found", nil, nil)
+	}
+	if user.IsDisabled {
+		return helpers.NewResponse(http.StatusUnauthorized, "Account is disabled", nil, nil)
+	}
+	if user.TokenVersion != session.TokenVersion {
+		return helpers.NewResponse(http.StatusUnauthorized, "Session revoked", nil, nil)
+	}
+
+	// rotate refresh token, only one of concurrent refreshes with the same token wins
+	refreshToken, err := helpers.GenerateSecureRandomChar(64)
+	if err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+	session, err = repo.RotateAuthSessionRefreshToken(ctx, session.ID.Hex(), refreshTokenHash, helpers.HashToken(refreshToken), now)
+	if err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+	if session == nil {
+		return helpers.NewResponse(http.StatusUnauthorized, "Refresh token is invalid", nil, nil)
+	}
+
+	// generate token
+	result, err := a.generateTokenPair(ctx, session, refreshToken, now)
+	if err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+
+	return helpers.NewResponse(http.StatusOK, "Refresh token successful", nil, result)
+}
+
+// Logout revokes the current session
+func (a AuthSessionActor) Logout(ctx context.Context, repo domain.MongoDbRepo, sessionId, userId string) helpers.Response {
+	now := time.Now()
+	if err := repo.UpdatePartialAuthSession(ctx, map[string]interface{}{
+		"id":     sessionId,
+		"role":   a.Role,
+		"userId": userId,
+	}, map[string]interface{}{
+		"revokedAt": now,
+		"updatedAt": now,
+	}); err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+
+	return helpers.NewResponse(http.StatusOK, "Logout successful", nil, nil)
+}
+
+// LogoutAllDevices bumps the token version so every issued access token is rejected, then revokes every session
+func (a AuthSessionActor) LogoutAllDevices(ctx context.Context, repo domain.MongoDbRepo, userId string) helpers.Response {
+	now := time.Now()
+	matched, err := a.BumpTokenVersion(ctx, userId, now)
+	if err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+	if !matched {
+		return helpers.NewResponse(http.StatusBadRequest, "User not found", nil, nil)
+	}
+
+	if err := RevokeAuthSessions(ctx, repo, a.Role, userId, now); err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+
+	return helpers.NewResponse(http.StatusOK, "Logout from all devices successful", nil, nil)
+}
+
+// RevokeAuthSessions ends every active session of the user
+func RevokeAuthSessions(ctx context.Context, repo domain.MongoDbRepo, role mongo_model.ActorRole, userId string, now time.Time) error {
+	return repo.UpdateManyAuthSessionPartial(ctx, map[string]interface{}{
+		"role":      role,
+		"userId":    userId,
+		"isRevoked": false,
+	}, map[string]interface{}{
+		"revokedAt": now,
+		"updatedAt": now,
+	})
+}
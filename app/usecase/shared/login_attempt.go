@@ -0,0 +1,83 @@
+package shared_usecase
+
+import (
+	"app/domain"
+	mongo_model "app/domain/model/mongo"
+	"app/helpers"
+	"context"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+// CheckLoginAttempt returns the refusal message while the account or ip is in backoff or locked
+func CheckLoginAttempt(ctx context.Context, repo domain.MongoDbRepo, role mongo_model.ActorRole, account, ip string) (string, error) {
+	now := time.Now()
+	for attemptType, identifier := range mongo_model.LoginAttemptIdentifiers(account, ip) {
+		attempt, err := repo.FetchOneLoginAttempt(ctx, map[string]interface{}{
+			"role":       role,
+			"type":       attemptType,
+			"identifier": identifier,
+		})
+		if err != nil {
+			return "", err
+		}
+		if attempt == nil {
+			continue
+		}
+		if blockedUntil := attempt.BlockedUntil(now); blockedUntil != nil {
+			return mongo_model.LoginBlockedMessage(*blockedUntil, now), nil
+		}
+	}
+
+	return "", nil
+}
+
+// RecordLoginFailure count the failure on the account and ip,
+// returns until when the account is locked when this failure locked it
+func RecordLoginFailure(ctx context.Context, repo domain.MongoDbRepo, role mongo_model.ActorRole, account, ip string) (accountLockedUntil *time.Time) {
+	now := time.Now()
+	windowStart := now.Add(-time.Duration(helpers.GetLoginAttemptWindow()) * time.Minute)
+	for attemptType, identifier := range mongo_model.LoginAttemptIdentifiers(account, ip) {
+		policy := mongo_model.LoginAttemptPolicy{
+			MaxAttempts:     helpers.GetLoginMaxAttempts(),
+			BackoffBase:     time.Duration(helpers.GetLoginBackoffBase()) * time.Second,
+			LockoutDuration: time.Duration(helpers.GetLoginLockoutDuration()) * time.Minute,
+		}
+		if attemptType == mongo_model.LoginAttemptTypeIP {
+			policy.MaxAttempts = helpers.GetLoginIPMaxAttempts()
+		}
+
+		attempt, err := repo.IncrementLoginAttempt(ctx, role, attemptType, identifier, policy, now, windowStart)
+		if err != nil {
+			continue
+		}
+
+		// a locking failure resets the counter
+		if attempt.Failures == 0 && attempt.LockedUntil != nil {
+			logrus.Warnf("Login locked for %s %s %s until %s", role, attemptType, identifier, attempt.LockedUntil)
+			if attemptType == mongo_model.LoginAttemptTypeAccount {
+				accountLockedUntil = attempt.LockedUntil
+			}
+		}
+	}
+
+	return accountLockedUntil
+}
+
+// ClearLoginAttempt reset the account counter after a successful login
+func ClearLoginAttempt(ctx context.Context, repo domain.MongoDbRepo, role mongo_model.ActorRole, account string) {
+	if err := repo.UpdatePartialLoginAttempt(ctx, map[string]interface{}{
+		"role":       role,
+		"type":       mongo_model.LoginAttemptTypeAccount,
+		"identifier": account,
+	}, map[string]interface{}{
+		"failures":    0,
+		"lockCount":   0,
+		"retryAt":     nil,
+		"lockedUntil": nil,
+		"updatedAt":   time.Now(),
+	}); err != nil {
+		logrus.Error("ClearLoginAttempt:", err)
+	}
+}
@@ -0,0 +1,34 @@
+package shared_usecase
+
+import (
+	"app/domain"
+	mongo_model "app/domain/model/mongo"
+	"context"
+
+	"github.com/sirupsen/logrus"
+)
+
+// FetchMatchEvents returns the events of a match in the order they happened
+func FetchMatchEvents(ctx context.Context, repo domain.MongoDbRepo, matchId string) ([]mongo_model.MatchEvent, error) {
+	cur, err := repo.FetchListMatchEvent(ctx, map[string]interface{}{
+		"matchId": matchId,
+		"sort":    "minute",
+		"dir":     "asc",
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer cur.Close(ctx)
+
+	events := make([]mongo_model.MatchEvent, 0)
+	for cur.Next(ctx) {
+		var event mongo_model.MatchEvent
+		if err := cur.Decode(&event); err != nil {
+			logrus.Error("MatchEvent Decode:", err)
+			return nil, err
+		}
+		events = append(events, event)
+	}
+
+	return events, nil
+}
@@ -0,0 +1,20 @@
+package shared_usecase
+
+import (
+	"app/domain"
+	"app/helpers"
+	"context"
+	"time"
+)
+
+// GenerateInvoiceExternalId takes the next invoice sequence of the day, the sequence is shared by every
+// channel so two purchases never get the same external ID
+func GenerateInvoiceExternalId(ctx context.Context, repo domain.MongoDbRepo) (string, error) {
+	now := time.Now()
+	sequence, err := repo.IncrementCounter(ctx, "invoice:"+now.Format("20060102"))
+	if err != nil {
+		return "", err
+	}
+
+	return helpers.GenerateInvoiceExternalId(now, sequence), nil
+}
@@ -0,0 +1,35 @@
+package shared_usecase
+
+import (
+	"app/domain"
+	mongo_model "app/domain/model/mongo"
+	"context"
+
+	"github.com/sirupsen/logrus"
+)
+
+// FetchRefundedTotal sums the refunds of a purchase that are not rejected
+func FetchRefundedTotal(ctx context.Context, repo domain.MongoDbRepo, purchaseId string) (float64, error) {
+	cur, err := repo.FetchListRefund(ctx, map[string]interface{}{
+		"purchaseId": purchaseId,
+	})
+	if err != nil {
+		return 0, err
+	}
+	defer cur.Close(ctx)
+
+	var total float64
+	for cur.Next(ctx) {
+		var refund mongo_model.Refund
+		if err := cur.Decode(&refund); err != nil {
+			logrus.Error("Refund Decode:", err)
+			return 0, err
+		}
+		if refund.Status == mongo_model.RefundStatusRejected {
+			continue
+		}
+		total += refund.Amount
+	}
+
+	return total, nil
+}
@@ -0,0 +1,51 @@
+package shared_usecase
+
+import (
+	"app/domain"
+	mongo_model "app/domain/model/mongo"
+	"context"
+
+	"github.com/sirupsen/logrus"
+)
+
+// FetchSeasonTeamMap returns the season teams of the given ids keyed by their hex id
+func FetchSeasonTeamMap(ctx context.Context, repo domain.MongoDbRepo, ids []string) (map[string]mongo_model.SeasonTeam, error) {
+	seasonTeamMap := make(map[string]mongo_model.SeasonTeam)
+
+	cur, err := repo.FetchListSeasonTeam(ctx, map[string]interface{}{
+		"ids": ids,
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer cur.Close(ctx)
+
+	for cur.Next(ctx) {
+		var seasonTeam mongo_model.SeasonTeam
+		if err := cur.Decode(&seasonTeam); err != nil {
+			logrus.Error("SeasonTeam Decode:", err)
+			return nil, err
+		}
+		seasonTeamMap[seasonTeam.ID.Hex()] = seasonTeam
+	}
+
+	return seasonTeamMap, nil
+}
+
+// ToSeasonTeamFK an unknown season team gives an empty reference
+func ToSeasonTeamFK(seasonTeam mongo_model.SeasonTeam) mongo_model.SeasonTeamFK {
+	if seasonTeam.ID.IsZero() {
+		return mongo_model.SeasonTeamFK{}
+	}
+
+	return mongo_model.SeasonTeamFK{
+		ID:       seasonTeam.ID.Hex(),
+		SeasonID: seasonTeam.SeasonID,
+		TeamID:   seasonTeam.Team.ID,
+		Team: mongo_model.TeamFK{
+			ID:   seasonTeam.Team.ID,
+			Name: seasonTeam.Team.Name,
+			Logo: seasonTeam.Team.Logo,
+		},
+	}
+}
@@ -0,0 +1,195 @@
+package shared_usecase
+
+import (
+	"app/domain"
+	mongo_model "app/domain/model/mongo"
+	"app/helpers"
+	"context"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+// standingLockDuration is how long a run may compute the standings of a season before another run takes over
+const standingLockDuration = 2 * time.Minute
+
+// GetStandingsList returns the tables of the requested season, the active season by default
+func GetStandingsList(ctx context.Context, repo domain.MongoDbRepo, queryParam url.Values) helpers.Response {
+	seasonOptions := map[string]interface{}{
+		"status": mongo_model.SeasonStatusActive,
+	}
+	if queryParam.Get("seasonId") != "" {
+		seasonOptions = map[string]interface{}{
+			"id": queryParam.Get("seasonId"),
+		}
+	}
+	season, err := repo.FetchOneSeason(ctx, seasonOptions)
+	if err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+	if season == nil {
+		return helpers.NewResponse(http.StatusBadRequest, "Season not found", nil, nil)
+	}
+
+	fetchOptions := map[string]interface{}{
+		"seasonId": season.ID.Hex(),
+		"sort":     "createdAt",
+		"dir":      "asc",
+	}
+
+	// filtering, the table of the whole season has an empty series id and group
+	if queryParam.Has("seriesId") {
+		fetchOptions["seriesId"] = queryParam.Get("seriesId")
+	}
+	if queryParam.Has("group") {
+		fetchOptions["group"] = queryParam.Get("group")
+	}
+
+	// fetch data
+	cur, err := repo.FetchListStanding(ctx, fetchOptions)
+	if err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+	defer cur.Close(ctx)
+
+	list := make([]interface{}, 0)
+	for cur.Next(ctx) {
+		row := mongo_model.Standing{}
+		err := cur.Decode(&row)
+		if err != nil {
+			logrus.Error("Standing Decode:", err)
+			return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+		}
+
+		list = append(list, row)
+	}
+
+	return helpers.NewResponse(http.StatusOK, "Success", nil, map[string]interface{}{
+		"season": mongo_model.SeasonFK{
+			ID:   season.ID.Hex(),
+			Name: season.Name,
+		},
+		"list": list,
+	})
+}
+
+// RefreshStandings recompute the cached standings of a season.
+// One run at a time holds the lock of the season, a request made while it is held bumps the standing version
+// so the running one computes again with the latest results before releasing the lock
+func RefreshStandings(ctx context.Context, repo domain.MongoDbRepo, seasonId string) error {
+	if err := repo.IncrementOneSeason(ctx, seasonId, map[string]int64{
+		"standingVersion": 1,
+	}); err != nil {
+		return err
+	}
+
+	now := time.Now()
+	claimed, err := repo.ClaimSeasonStandingLock(ctx, seasonId, now.Add(standingLockDuration), now)
+	if err != nil {
+		return err
+	}
+	if !claimed {
+		return nil
+	}
+
+	for {
+		// read the version before the results so a request made in between fails the release
+		season, err := repo.FetchOneSeason(ctx, map[string]interface{}{
+			"id": seasonId,
+		})
+		if err != nil {
+			releaseStandingLock(ctx, repo, seasonId)
+			return err
+		}
+		if season == nil {
+			return nil
+		}
+
+		if err := computeStandings(ctx, repo, season); err != nil {
+			releaseStandingLock(ctx, repo, seasonId)
+			return err
+		}
+
+		released, err := repo.ReleaseSeasonStandingLock(ctx, seasonId, season.StandingVersion)
+		if err != nil {
+			releaseStandingLock(ctx, repo, seasonId)
+			return err
+		}
+		if released {
+			return nil
+		}
+	}
+}
+
+// releaseStandingLock releases the lock after a failed run so the next request computes the standings
+func releaseStandingLock(ctx context.Context, repo domain.MongoDbRepo, seasonId string) {
+	if err := repo.UpdatePartialSeason(ctx, map[string]interface{}{
+		"id": seasonId,
+	}, map[string]interface{}{
+		"standingLockedUntil": nil,
+	}); err != nil {
+		logrus.Error("releaseStandingLock UpdatePartialSeason:", err)
+	}
+}
+
+// computeStandings save the tables of a season and remove the ones no longer computed, like a removed group
+func computeStandings(ctx context.Context, repo domain.MongoDbRepo, season *mongo_model.Season) error {
+	// fetch season teams
+	seasonTeamCur, err := repo.FetchListSeasonTeam(ctx, map[string]interface{}{
+		"seasonId": season.ID.Hex(),
+	})
+	if err != nil {
+		return err
+	}
+	defer seasonTeamCur.Close(ctx)
+
+	seasonTeams := make([]mongo_model.SeasonTeam, 0)
+	for seasonTeamCur.Next(ctx) {
+		var seasonTeam mongo_model.SeasonTeam
+		if err := seasonTeamCur.Decode(&seasonTeam); err != nil {
+			logrus.Error("SeasonTeam Decode:", err)
+			return err
+		}
+		seasonTeams = append(seasonTeams, seasonTeam)
+	}
+
+	// fetch matches
+	matchCur, err := repo.FetchListMatch(ctx, map[string]interface{}{
+		"seasonId": season.ID.Hex(),
+	})
+	if err != nil {
+		return err
+	}
+	defer matchCur.Close(ctx)
+
+	matches := make([]mongo_model.Match, 0)
+	for matchCur.Next(ctx) {
+		var match mongo_model.Match
+		if err := matchCur.Decode(&match); err != nil {
+			logrus.Error("Match Decode:", err)
+			return err
+		}
+		matches = append(matches, match)
+	}
+
+	// save
+	now := time.Now()
+	standings := mongo_model.BuildStandings(season, seasonTeams, matches, now)
+	for i := range standings {
+		if err := repo.UpsertStanding(ctx, &standings[i]); err != nil {
+			return err
+		}
+	}
+	if err := repo.UpdateManyStandingPartial(ctx, map[string]interface{}{
+		"seasonId":         season.ID.Hex(),
+		"computedAtBefore": now,
+	}, map[string]interface{}{
+		"deletedAt": now,
+	}); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,103 @@
+package shared_usecase
+
+import (
+	"app/domain"
+	mongo_model "app/domain/model/mongo"
+	"app/helpers"
+	mailing_helpers "app/helpers/mailing"
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// ReissueTicketPurchase revokes the current code of a ticket purchase and sends the new one to the member.
+// The code is swapped atomically so a concurrent reissue or scan can not lose a revoked code
+func ReissueTicketPurchase(ctx context.Context, repo domain.MongoDbRepo, ticketPurchase *mongo_model.TicketPurchase, reason string, reissuedBy mongo_model.ActorFK) helpers.Response {
+	if ticketPurchase.IsUsed {
+		return helpers.NewResponse(http.StatusBadRequest, "Ticket already used", nil, nil)
+	}
+	if ticketPurchase.IsVoided {
+		return helpers.NewResponse(http.StatusBadRequest, "Ticket has been voided", nil, nil)
+	}
+
+	// revoke old code and generate new one
+	now := time.Now()
+	oldCode := ticketPurchase.Code
+	newCode := uuid.NewString()
+
+	matched, err := repo.ReissueTicketPurchaseCode(ctx, ticketPurchase.ID.Hex(), oldCode, newCode, now)
+	if err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+	if !matched {
+		return helpers.NewResponse(http.StatusBadRequest, "Ticket has been changed, please try again", nil, nil)
+	}
+
+	ticketPurchase.RevokedCodes = append(ticketPurchase.RevokedCodes, oldCode)
+	ticketPurchase.Code = newCode
+	ticketPurchase.UpdatedAt = now
+
+	// save reissue log
+	err = repo.CreateOneTicketPurchaseReissueLog(ctx, &mongo_model.TicketPurchaseReissueLog{
+		ID:               primitive.NewObjectID(),
+		TicketPurchaseID: ticketPurchase.ID.Hex(),
+		PurchaseID:       ticketPurchase.PurchaseID,
+		Member:           ticketPurchase.Member,
+		OldCode:          oldCode,
+		NewCode:          newCode,
+		Reason:           reason,
+		ReissuedBy:       reissuedBy,
+		CreatedAt:        now,
+		UpdatedAt:        now,
+	})
+	if err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+
+	// send new qr to member
+	go mailing_helpers.SendTicketPurchaseReissue(ticketPurchase)
+
+	return helpers.NewResponse(http.StatusOK, "Ticket reissued successfully", nil, ticketPurchase)
+}
+
+// IssueTicketPurchases issues one ticket purchase per seat of each ticket day, seats which were already issued
+// are skipped so running it again does nothing. The member gets the new codes by email when the purchase has one
+func IssueTicketPurchases(ctx context.Context, repo domain.MongoDbRepo, purchase *mongo_model.Purchase, tickets []mongo_model.TicketFK, venueMap map[string]mongo_model.Venue) ([]*mongo_model.TicketPurchase, error) {
+	now := time.Now()
+	var ticketPurchases []*mongo_model.TicketPurchase
+	for _, ticket := range tickets {
+		for seat := int64(1); seat <= purchase.Amount; seat++ {
+			ticketPurchases = append(ticketPurchases, &mongo_model.TicketPurchase{
+				ID:     primitive.NewObjectID(),
+				Member: purchase.Member,
+				Ticket: ticket,
+				Venue: mongo_model.VenueFK{
+					ID:   ticket.VenueID,
+					Name: venueMap[ticket.VenueID].Name,
+				},
+				PurchaseID: purchase.ID.Hex(),
+				Seat:       seat,
+				Code:       uuid.NewString(),
+				IsUsed:     false,
+				UsedAt:     nil,
+				CreatedAt:  now,
+				UpdatedAt:  now,
+			})
+		}
+	}
+
+	inserted, err := repo.CreateManyTicketPurchaseSkipDuplicate(ctx, ticketPurchases)
+	if err != nil {
+		return nil, err
+	}
+
+	// send email to member
+	if len(inserted) > 0 && purchase.Member.Email != "" {
+		go mailing_helpers.SendTicketPurchase(inserted)
+	}
+
+	return inserted, nil
+}
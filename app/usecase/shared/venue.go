@@ -0,0 +1,33 @@
+package shared_usecase
+
+import (
+	"app/domain"
+	mongo_model "app/domain/model/mongo"
+	"context"
+
+	"github.com/sirupsen/logrus"
+)
+
+// FetchVenueMap returns the venues of the given ids keyed by their hex id
+func FetchVenueMap(ctx context.Context, repo domain.MongoDbRepo, ids []string) (map[string]mongo_model.Venue, error) {
+	venueMap := make(map[string]mongo_model.Venue)
+
+	cur, err := repo.FetchListVenue(ctx, map[string]interface{}{
+		"ids": ids,
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer cur.Close(ctx)
+
+	for cur.Next(ctx) {
+		var venue mongo_model.Venue
+		if err := cur.Decode(&venue); err != nil {
+			logrus.Error("Venue Decode:", err)
+			return nil, err
+		}
+		venueMap[venue.ID.Hex()] = venue
+	}
+
+	return venueMap, nil
+}
@@ -0,0 +1,404 @@
+package superadmin_usecase
+
+import (
+	shared_usecase "app/app/usecase/shared"
+	mongo_model "app/domain/model/mongo"
+	"app/domain/request"
+	"app/helpers"
+	mailing_helpers "app/helpers/mailing"
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func (u *superadminAppUsecase) GetAdminsList(ctx context.Context, queryParam url.Values) helpers.Response {
+	ctx, cancel := context.WithTimeout(ctx, u.contextTimeout)
+	defer cancel()
+
+	// get limit offset
+	page, offset, limit := helpers.GetOffsetLimit(queryParam)
+
+	fetchOptions := map[string]interface{}{
+		"limit":  limit,
+		"offset": offset,
+	}
+
+	// filtering
+	if queryParam.Get("search") != "" {
+		fetchOptions["search"] = queryParam.Get("search")
+	}
+	if queryParam.Get("venueId") != "" {
+		fetchOptions["venueId"] = queryParam.Get("venueId")
+	}
+	if queryParam.Get("isDisabled") != "" {
+		fetchOptions["isDisabled"] = queryParam.Get("isDisabled") == "true"
+	}
+
+	// count total
+	total := u.mongoDbRepo.CountAdmin(ctx, fetchOptions)
+	if total == 0 {
+		return helpers.NewResponse(http.StatusOK, "Success", nil, helpers.PaginatedResponse{
+			List:  []interface{}{},
+			Limit: limit,
+			Page:  page,
+			Total: total,
+		})
+	}
+
+	// sorting
+	if queryParam.Get("sort") != "" {
+		fetchOptions["sort"] = queryParam.Get("sort")
+	}
+	if queryParam.Get("dir") != "" {
+		fetchOptions["dir"] = queryParam.Get("dir")
+	}
+
+	// fetch data
+	cur, err := u.mongoDbRepo.FetchListAdmin(ctx, fetchOptions)
+	if err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+	defer cur.Close(ctx)
+
+	var list []interface{}
+	for cur.Next(ctx) {
+		row := mongo_model.Admin{}
+		err := cur.Decode(&row)
+		if err != nil {
+			logrus.Error("GetListAdmin Decode:", err)
+			return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+		}
+
+		list = append(list, row)
+	}
+
+	return helpers.NewResponse(http.StatusOK, "Success", nil, helpers.PaginatedResponse{
+		Limit: limit,
+		Page:  page,
+		Total: total,
+		List:  list,
+	})
+}
+
+func (u *superadminAppUsecase) GetAdminDetail(ctx context.Context, id string) helpers.Response {
+	ctx, cancel := context.WithTimeout(ctx, u.contextTimeout)
+	defer cancel()
+
+	admin, err := u.mongoDbRepo.FetchOneAdmin(ctx, map[string]interface{}{
+		"id": id,
+	})
+	if err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+	if admin == nil {
+		return helpers.NewResponse(http.StatusBadRequest, "Admin not found", nil, nil)
+	}
+
+	return helpers.NewResponse(http.StatusOK, "Success", nil, admin)
+}
+
+func (u *superadminAppUsecase) CreateAdmin(ctx context.Context, payload request.AdminCreateRequest) helpers.Response {
+	ctx, cancel := context.WithTimeout(ctx, u.contextTimeout)
+	defer cancel()
+
+	// validate payload
+	errValidation, venue, err := u.validateAdminPayload(ctx, nil, payload.Name, payload.Email, payload.Username, payload.VenueID)
+	if err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+	if len(errValidation) > 0 {
+		return helpers.NewResponse(http.StatusUnprocessableEntity, "Validation Error", errValidation, nil)
+	}
+
+	// create admin, the password is set by the admin through the invite link
+	now := time.Now()
+	admin := mongo_model.Admin{
+		ID:       primitive.NewObjectID(),
+		Name:     payload.Name,
+		Email:    payload.Email,
+		Username: payload.Username,
+		Venue: mongo_model.VenueFK{
+			ID:   venue.ID.Hex(),
+			Name: venue.Name,
+		},
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	// save
+	err = u.mongoDbRepo.CreateOneAdmin(ctx, &admin)
+	if err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+
+	// send invite
+	if err := u.inviteAdmin(ctx, &admin); err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+	u.recordAuditLog(ctx, mongo_model.AuditActionCreate, mongo_model.AuditEntityAdmin, admin.ID.Hex(), nil, admin)
+
+	return helpers.NewResponse(http.StatusCreated, "Create admin success", nil, admin)
+}
+
+func (u *superadminAppUsecase) UpdateAdmin(ctx context.Context, id string, payload request.AdminUpdateRequest) helpers.Response {
+	ctx, cancel := context.WithTimeout(ctx, u.contextTimeout)
+	defer cancel()
+
+	// get admin
+	admin, err := u.mongoDbRepo.FetchOneAdmin(ctx, map[string]interface{}{
+		"id": id,
+	})
+	if err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+	if admin == nil {
+		return helpers.NewResponse(http.StatusBadRequest, "Admin not found", nil, nil)
+	}
+	before := helpers.AuditSnapshot(admin)
+
+	// validate payload
+	errValidation, venue, err := u.validateAdminPayload(ctx, admin, payload.Name, payload.Email, payload.Username, payload.VenueID)
+	if err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+	if len(errValidation) > 0 {
+		return helpers.NewResponse(http.StatusUnprocessableEntity, "Validation Error", errValidation, nil)
+	}
+
+	// update admin
+	admin.Name = payload.Name
+	admin.Email = payload.Email
+	admin.Username = payload.Username
+	admin.Venue = mongo_model.VenueFK{
+		ID:   venue.ID.Hex(),
+		Name: venue.Name,
+	}
+	admin.UpdatedAt = time.Now()
+
+	// save
+	err = u.mongoDbRepo.UpdatePartialAdmin(ctx, map[string]interface{}{
+		"id": admin.ID,
+	}, map[string]interface{}{
+		"name":      admin.Name,
+		"email":     admin.Email,
+		"username":  admin.Username,
+		"venue":     admin.Venue,
+		"updatedAt": admin.UpdatedAt,
+	})
+	if err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+	u.recordAuditLog(ctx, mongo_model.AuditActionUpdate, mongo_model.AuditEntityAdmin, admin.ID.Hex(), before, admin)
+
+	return helpers.NewResponse(http.StatusOK, "Update admin success", nil, admin)
+}
+
+func (u *superadminAppUsecase) UpdateAdminStatus(ctx context.Context, id string, payload request.AdminStatusUpdateRequest) helpers.Response {
+	ctx, cancel := context.WithTimeout(ctx, u.contextTimeout)
+	defer cancel()
+
+	// get admin
+	admin, err := u.mongoDbRepo.FetchOneAdmin(ctx, map[string]interface{}{
+		"id": id,
+	})
+	if err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+	if admin == nil {
+		return helpers.NewResponse(http.StatusBadRequest, "Admin not found", nil, nil)
+	}
+	before := helpers.AuditSnapshot(admin)
+
+	// update admin
+	now := time.Now()
+	admin.IsDisabled = payload.IsDisabled
+	admin.DisabledAt = nil
+	if admin.IsDisabled {
+		admin.DisabledAt = &now
+	}
+	admin.UpdatedAt = now
+
+	// save
+	err = u.mongoDbRepo.UpdatePartialAdmin(ctx, map[string]interface{}{
+		"id": admin.ID,
+	}, map[string]interface{}{
+		"isDisabled": admin.IsDisabled,
+		"disabledAt": admin.DisabledAt,
+		"updatedAt":  admin.UpdatedAt,
+	})
+	if err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+
+	// end every session of a disabled admin
+	if admin.IsDisabled {
+		if err := u.revokeAdminSessions(ctx, admin.ID.Hex(), now); err != nil {
+			return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+		}
+	}
+	u.recordAuditLog(ctx, mongo_model.AuditActionUpdateStatus, mongo_model.AuditEntityAdmin, admin.ID.Hex(), before, admin)
+
+	return helpers.NewResponse(http.StatusOK, "Update admin status success", nil, admin)
+}
+
+func (u *superadminAppUsecase) ResendAdminInvite(ctx context.Context, id string) helpers.Response {
+	ctx, cancel := context.WithTimeout(ctx, u.contextTimeout)
+	defer cancel()
+
+	// get admin
+	admin, err := u.mongoDbRepo.FetchOneAdmin(ctx, map[string]interface{}{
+		"id": id,
+	})
+	if err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+	if admin == nil {
+		return helpers.NewResponse(http.StatusBadRequest, "Admin not found", nil, nil)
+	}
+	before := helpers.AuditSnapshot(admin)
+	if admin.Password != "" {
+		return helpers.NewResponse(http.StatusBadRequest, "Admin already set up a password, use forgot password instead", nil, nil)
+	}
+
+	// send invite
+	if err := u.inviteAdmin(ctx, admin); err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+	u.recordAuditLog(ctx, mongo_model.AuditActionResendInvite, mongo_model.AuditEntityAdmin, admin.ID.Hex(), before, admin)
+
+	return helpers.NewResponse(http.StatusOK, "Resend admin invite success", nil, admin)
+}
+
+func (u *superadminAppUsecase) DeleteAdmin(ctx context.Context, id string) helpers.Response {
+	ctx, cancel := context.WithTimeout(ctx, u.contextTimeout)
+	defer cancel()
+
+	// get admin
+	admin, err := u.mongoDbRepo.FetchOneAdmin(ctx, map[string]interface{}{
+		"id": id,
+	})
+	if err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+	if admin == nil {
+		return helpers.NewResponse(http.StatusBadRequest, "Admin not found", nil, nil)
+	}
+	before := helpers.AuditSnapshot(admin)
+
+	// delete admin
+	now := time.Now()
+	admin.DeletedAt = &now
+
+	// save
+	err = u.mongoDbRepo.UpdatePartialAdmin(ctx, map[string]interface{}{
+		"id": admin.ID,
+	}, map[string]interface{}{
+		"deletedAt": admin.DeletedAt,
+	})
+	if err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+
+	// end every session
+	if err := u.revokeAdminSessions(ctx, admin.ID.Hex(), now); err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+	u.recordAuditLog(ctx, mongo_model.AuditActionDelete, mongo_model.AuditEntityAdmin, admin.ID.Hex(), before, admin)
+
+	return helpers.NewResponse(http.StatusOK, "Delete admin success", nil, nil)
+}
+
+// validateAdminPayload validate create and update admin payload, current is nil on create
+func (u *superadminAppUsecase) validateAdminPayload(ctx context.Context, current *mongo_model.Admin, name, email, username, venueId string) (map[string]string, *mongo_model.Venue, error) {
+	errValidation := make(map[string]string)
+	if name == "" {
+		errValidation["name"] = "Name field is required"
+	}
+	if email == "" {
+		errValidation["email"] = "Email field is required"
+	} else if !helpers.IsValidEmail(email) {
+		errValidation["email"] = "Invalid email format"
+	}
+	if username == "" {
+		errValidation["username"] = "Username field is required"
+	}
+	if venueId == "" {
+		errValidation["venueId"] = "Venue ID field is required"
+	}
+	if len(errValidation) > 0 {
+		return errValidation, nil, nil
+	}
+
+	// check unique username and email
+	existing, err := u.mongoDbRepo.FetchOneAdmin(ctx, map[string]interface{}{
+		"username": username,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	if existing != nil && (current == nil || existing.ID != current.ID) {
+		errValidation["username"] = "Username already used"
+	}
+	existing, err = u.mongoDbRepo.FetchOneAdmin(ctx, map[string]interface{}{
+		"email": email,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	if existing != nil && (current == nil || existing.ID != current.ID) {
+		errValidation["email"] = "Email already used"
+	}
+
+	// check venue
+	venue, err := u.mongoDbRepo.FetchOneVenue(ctx, map[string]interface{}{
+		"id": venueId,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	if venue == nil {
+		errValidation["venueId"] = "Venue not found"
+	}
+
+	return errValidation, venue, nil
+}
+
+// inviteAdmin issue a password setup token and email it, the admin sets it through the admin reset password endpoint
+func (u *superadminAppUsecase) inviteAdmin(ctx context.Context, admin *mongo_model.Admin) error {
+	passwordToken, err := helpers.GenerateSecureRandomChar(64)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	expiredAt := now.Add(time.Duration(helpers.GetAdminInviteTTL()) * time.Minute)
+	admin.InvitedAt = &now
+
+	if err := u.mongoDbRepo.UpdatePartialAdmin(ctx, map[string]interface{}{
+		"id": admin.ID,
+	}, map[string]interface{}{
+		"passwordToken":          helpers.HashToken(passwordToken),
+		"passwordTokenExpiredAt": expiredAt,
+		"invitedAt":              admin.InvitedAt,
+		"updatedAt":              now,
+	}); err != nil {
+		return err
+	}
+
+	setupLink := fmt.Sprintf(
+		"%s/admin/setup-password/%s",
+		helpers.GetFEUrl(),
+		url.PathEscape(passwordToken))
+	go mailing_helpers.SendAdminInvite(admin, setupLink)
+
+	return nil
+}
+
+func (u *superadminAppUsecase) revokeAdminSessions(ctx context.Context, adminId string, now time.Time) error {
+	return shared_usecase.RevokeAuthSessions(ctx, u.mongoDbRepo, mongo_model.ActorRoleAdmin, adminId, now)
+}
@@ -0,0 +1,312 @@
+package superadmin_usecase
+
+import (
+	mongo_model "app/domain/model/mongo"
+	"app/domain/request"
+	"app/helpers"
+	jwt_helpers "app/helpers/jwt"
+	"context"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+const (
+	apiKeyPrefix       = "fem_"
+	apiKeyRandomLength = 40
+	apiKeyPrefixLength = 12
+)
+
+func (u *superadminAppUsecase) GetAPIKeyScopesList(ctx context.Context) helpers.Response {
+	return helpers.NewResponse(http.StatusOK, "Success", nil, mongo_model.APIKeyScopeList)
+}
+
+func (u *superadminAppUsecase) GetAPIKeysList(ctx context.Context, queryParam url.Values) helpers.Response {
+	ctx, cancel := context.WithTimeout(ctx, u.contextTimeout)
+	defer cancel()
+
+	// get limit offset
+	page, offset, limit := helpers.GetOffsetLimit(queryParam)
+
+	fetchOptions := map[string]interface{}{
+		"limit":  limit,
+		"offset": offset,
+	}
+
+	// filtering
+	now := time.Now()
+	if queryParam.Get("search") != "" {
+		fetchOptions["search"] = queryParam.Get("search")
+	}
+	if queryParam.Get("scope") != "" {
+		fetchOptions["scope"] = mongo_model.APIKeyScope(queryParam.Get("scope"))
+	}
+	switch mongo_model.APIKeyStatus(queryParam.Get("status")) {
+	case mongo_model.APIKeyStatusActive:
+		fetchOptions["isRevoked"] = false
+		fetchOptions["notExpiredAt"] = now
+	case mongo_model.APIKeyStatusExpired:
+		fetchOptions["isRevoked"] = false
+		fetchOptions["expiredAt"] = now
+	case mongo_model.APIKeyStatusRevoked:
+		fetchOptions["isRevoked"] = true
+	}
+
+	// count total
+	total := u.mongoDbRepo.CountAPIKey(ctx, fetchOptions)
+	if total == 0 {
+		return helpers.NewResponse(http.StatusOK, "Success", nil, helpers.PaginatedResponse{
+			List:  []interface{}{},
+			Limit: limit,
+			Page:  page,
+			Total: total,
+		})
+	}
+
+	// sorting
+	if queryParam.Get("sort") != "" {
+		fetchOptions["sort"] = queryParam.Get("sort")
+	}
+	if queryParam.Get("dir") != "" {
+		fetchOptions["dir"] = queryParam.Get("dir")
+	}
+
+	// fetch data
+	cur, err := u.mongoDbRepo.FetchListAPIKey(ctx, fetchOptions)
+	if err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+	defer cur.Close(ctx)
+
+	var list []interface{}
+	for cur.Next(ctx) {
+		row := mongo_model.APIKey{}
+		err := cur.Decode(&row)
+		if err != nil {
+			logrus.Error("GetListAPIKey Decode:", err)
+			return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+		}
+
+		list = append(list, row.Format(now))
+	}
+
+	return helpers.NewResponse(http.StatusOK, "Success", nil, helpers.PaginatedResponse{
+		Limit: limit,
+		Page:  page,
+		Total: total,
+		List:  list,
+	})
+}
+
+func (u *superadminAppUsecase) GetAPIKeyDetail(ctx context.Context, id string) helpers.Response {
+	ctx, cancel := context.WithTimeout(ctx, u.contextTimeout)
+	defer cancel()
+
+	apiKey, err := u.mongoDbRepo.FetchOneAPIKey(ctx, map[string]interface{}{
+		"id": id,
+	})
+	if err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+	if apiKey == nil {
+		return helpers.NewResponse(http.StatusBadRequest, "API key not found", nil, nil)
+	}
+
+	return helpers.NewResponse(http.StatusOK, "Success", nil, apiKey.Format(time.Now()))
+}
+
+func (u *superadminAppUsecase) CreateAPIKey(ctx context.Context, claim jwt_helpers.SuperadminJWTClaims, payload request.APIKeyRequest) helpers.Response {
+	ctx, cancel := context.WithTimeout(ctx, u.contextTimeout)
+	defer cancel()
+
+	// validate payload
+	errValidation, scopes, expiredAt := validateAPIKeyPayload(payload)
+	if len(errValidation) > 0 {
+		return helpers.NewResponse(http.StatusUnprocessableEntity, "Validation Error", errValidation, nil)
+	}
+
+	// check superadmin
+	superadmin, err := u.mongoDbRepo.FetchOneSuperadmin(ctx, map[string]interface{}{
+		"id": claim.UserID,
+	})
+	if err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+	if superadmin == nil {
+		return helpers.NewResponse(http.StatusBadRequest, "User not found", nil, nil)
+	}
+
+	// generate key, only its hash is stored
+	randomChar, err := helpers.GenerateSecureRandomChar(apiKeyRandomLength)
+	if err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+	key := apiKeyPrefix + randomChar
+
+	// create api key
+	now := time.Now()
+	rateLimit := payload.RateLimit
+	if rateLimit == 0 {
+		rateLimit = helpers.GetAPIKeyDefaultRateLimit()
+	}
+	apiKey := mongo_model.APIKey{
+		ID:          primitive.NewObjectID(),
+		Name:        payload.Name,
+		Description: payload.Description,
+		Prefix:      key[:apiKeyPrefixLength],
+		KeyHash:     helpers.HashToken(key),
+		Scopes:      scopes,
+		RateLimit:   rateLimit,
+		ExpiredAt:   expiredAt,
+		CreatedBy: mongo_model.ActorFK{
+			ID:   superadmin.ID.Hex(),
+			Name: superadmin.Name,
+			Role: mongo_model.ActorRoleSuperadmin,
+		},
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	// save
+	if err := u.mongoDbRepo.CreateOneAPIKey(ctx, &apiKey); err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+	u.recordAuditLog(ctx, mongo_model.AuditActionCreate, mongo_model.AuditEntityAPIKey, apiKey.ID.Hex(), nil, apiKey)
+
+	// the plain key can not be shown again
+	return helpers.NewResponse(http.StatusCreated, "Create API key success", nil, map[string]interface{}{
+		"key":    key,
+		"apiKey": apiKey.Format(now),
+	})
+}
+
+func (u *superadminAppUsecase) UpdateAPIKey(ctx context.Context, id string, payload request.APIKeyRequest) helpers.Response {
+	ctx, cancel := context.WithTimeout(ctx, u.contextTimeout)
+	defer cancel()
+
+	// get api key
+	apiKey, err := u.mongoDbRepo.FetchOneAPIKey(ctx, map[string]interface{}{
+		"id": id,
+	})
+	if err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+	if apiKey == nil {
+		return helpers.NewResponse(http.StatusBadRequest, "API key not found", nil, nil)
+	}
+	before := helpers.AuditSnapshot(apiKey)
+	if apiKey.RevokedAt != nil {
+		return helpers.NewResponse(http.StatusBadRequest, "API key is already revoked", nil, nil)
+	}
+
+	// validate payload
+	errValidation, scopes, expiredAt := validateAPIKeyPayload(payload)
+	if len(errValidation) > 0 {
+		return helpers.NewResponse(http.StatusUnprocessableEntity, "Validation Error", errValidation, nil)
+	}
+
+	// update api key
+	now := time.Now()
+	apiKey.Name = payload.Name
+	apiKey.Description = payload.Description
+	apiKey.Scopes = scopes
+	if payload.RateLimit > 0 {
+		apiKey.RateLimit = payload.RateLimit
+	}
+	apiKey.ExpiredAt = expiredAt
+	apiKey.UpdatedAt = now
+
+	// save
+	if err := u.mongoDbRepo.UpdatePartialAPIKey(ctx, map[string]interface{}{
+		"id": apiKey.ID,
+	}, map[string]interface{}{
+		"name":        apiKey.Name,
+		"description": apiKey.Description,
+		"scopes":      apiKey.Scopes,
+		"rateLimit":   apiKey.RateLimit,
+		"expiredAt":   apiKey.ExpiredAt,
+		"updatedAt":   apiKey.UpdatedAt,
+	}); err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+	u.recordAuditLog(ctx, mongo_model.AuditActionUpdate, mongo_model.AuditEntityAPIKey, apiKey.ID.Hex(), before, apiKey)
+
+	return helpers.NewResponse(http.StatusOK, "Update API key success", nil, apiKey.Format(now))
+}
+
+func (u *superadminAppUsecase) RevokeAPIKey(ctx context.Context, id string) helpers.Response {
+	ctx, cancel := context.WithTimeout(ctx, u.contextTimeout)
+	defer cancel()
+
+	// get api key
+	apiKey, err := u.mongoDbRepo.FetchOneAPIKey(ctx, map[string]interface{}{
+		"id": id,
+	})
+	if err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+	if apiKey == nil {
+		return helpers.NewResponse(http.StatusBadRequest, "API key not found", nil, nil)
+	}
+	before := helpers.AuditSnapshot(apiKey)
+	if apiKey.RevokedAt != nil {
+		return helpers.NewResponse(http.StatusBadRequest, "API key is already revoked", nil, nil)
+	}
+
+	// revoke api key, the key is rejected on its next request
+	now := time.Now()
+	apiKey.RevokedAt = &now
+	apiKey.UpdatedAt = now
+
+	// save
+	if err := u.mongoDbRepo.UpdatePartialAPIKey(ctx, map[string]interface{}{
+		"id": apiKey.ID,
+	}, map[string]interface{}{
+		"revokedAt": apiKey.RevokedAt,
+		"updatedAt": apiKey.UpdatedAt,
+	}); err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+	u.recordAuditLog(ctx, mongo_model.AuditActionRevoke, mongo_model.AuditEntityAPIKey, apiKey.ID.Hex(), before, apiKey)
+
+	return helpers.NewResponse(http.StatusOK, "Revoke API key success", nil, apiKey.Format(now))
+}
+
+// validateAPIKeyPayload validate create and update api key payload
+func validateAPIKeyPayload(payload request.APIKeyRequest) (map[string]string, []mongo_model.APIKeyScope, *time.Time) {
+	errValidation := make(map[string]string)
+	if payload.Name == "" {
+		errValidation["name"] = "Name field is required"
+	}
+	if payload.RateLimit < 0 {
+		errValidation["rateLimit"] = "Rate limit must be greater than 0"
+	}
+
+	scopes := make([]mongo_model.APIKeyScope, 0, len(payload.Scopes))
+	seen := make(map[string]bool)
+	if len(payload.Scopes) == 0 {
+		errValidation["scopes"] = "Scopes field is required"
+	}
+	for _, scope := range payload.Scopes {
+		if !mongo_model.IsValidAPIKeyScope(scope) {
+			errValidation["scopes"] = "Invalid scope " + scope
+			break
+		}
+		if !seen[scope] {
+			seen[scope] = true
+			scopes = append(scopes, mongo_model.APIKeyScope(scope))
+		}
+	}
+
+	expiredAt, err := parseOptionalTime(payload.ExpiredAt)
+	if err != nil {
+		errValidation["expiredAt"] = "Expired at is invalid"
+	} else if expiredAt != nil && !expiredAt.After(time.Now()) {
+		errValidation["expiredAt"] = "Expired at must be in the future"
+	}
+
+	return errValidation, scopes, expiredAt
+}
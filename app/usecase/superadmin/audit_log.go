@@ -0,0 +1,134 @@
+package superadmin_usecase
+
+import (
+	shared_usecase "app/app/usecase/shared"
+	mongo_model "app/domain/model/mongo"
+	"app/helpers"
+	"context"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// recordAuditLog saves who changed the entity and how, see shared_usecase.RecordAuditLog
+func (u *superadminAppUsecase) recordAuditLog(ctx context.Context, action mongo_model.AuditAction, entityType mongo_model.AuditEntity, entityId string, before bson.M, after interface{}) {
+	shared_usecase.RecordAuditLog(ctx, u.mongoDbRepo, action, entityType, entityId, before, after)
+}
+
+func (u *superadminAppUsecase) GetAuditLogsList(ctx context.Context, queryParam url.Values) helpers.Response {
+	ctx, cancel := context.WithTimeout(ctx, u.contextTimeout)
+	defer cancel()
+
+	// get limit offset
+	page, offset, limit := helpers.GetOffsetLimit(queryParam)
+
+	fetchOptions := map[string]interface{}{
+		"limit":  limit,
+		"offset": offset,
+	}
+
+	// filtering
+	if queryParam.Get("entityType") != "" {
+		fetchOptions["entityType"] = mongo_model.AuditEntity(queryParam.Get("entityType"))
+	}
+	if queryParam.Get("entityId") != "" {
+		fetchOptions["entityId"] = queryParam.Get("entityId")
+	}
+	if queryParam.Get("action") != "" {
+		fetchOptions["action"] = mongo_model.AuditAction(queryParam.Get("action"))
+	}
+	if queryParam.Get("actorId") != "" {
+		fetchOptions["actorId"] = queryParam.Get("actorId")
+	}
+	if queryParam.Get("actorRole") != "" {
+		fetchOptions["actorRole"] = mongo_model.ActorRole(queryParam.Get("actorRole"))
+	}
+
+	// date range in WIB, both ends inclusive
+	errValidation := make(map[string]string)
+	loc, _ := time.LoadLocation("Asia/Jakarta")
+	if queryParam.Get("startDate") != "" {
+		startDate, err := time.ParseInLocation("2006-01-02", queryParam.Get("startDate"), loc)
+		if err != nil {
+			errValidation["startDate"] = "Start date must be in format YYYY-MM-DD"
+		} else {
+			fetchOptions["createdAtFrom"] = helpers.SetToStartOfDayWIB(startDate)
+		}
+	}
+	if queryParam.Get("endDate") != "" {
+		endDate, err := time.ParseInLocation("2006-01-02", queryParam.Get("endDate"), loc)
+		if err != nil {
+			errValidation["endDate"] = "End date must be in format YYYY-MM-DD"
+		} else {
+			fetchOptions["createdAtTo"] = helpers.SetToEndOfDayWIB(endDate)
+		}
+	}
+	if len(errValidation) > 0 {
+		return helpers.NewResponse(http.StatusUnprocessableEntity, "Validation Error", errValidation, nil)
+	}
+
+	// count total
+	total := u.mongoDbRepo.CountAuditLog(ctx, fetchOptions)
+	if total == 0 {
+		return helpers.NewResponse(http.StatusOK, "Success", nil, helpers.PaginatedResponse{
+			List:  []interface{}{},
+			Limit: limit,
+			Page:  page,
+			Total: total,
+		})
+	}
+
+	// sorting
+	if queryParam.Get("sort") != "" {
+		fetchOptions["sort"] = queryParam.Get("sort")
+	}
+	if queryParam.Get("dir") != "" {
+		fetchOptions["dir"] = queryParam.Get("dir")
+	}
+
+	// fetch data
+	cur, err := u.mongoDbRepo.FetchListAuditLog(ctx, fetchOptions)
+	if err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+	defer cur.Close(ctx)
+
+	var list []interface{}
+	for cur.Next(ctx) {
+		row := mongo_model.AuditLog{}
+		err := cur.Decode(&row)
+		if err != nil {
+			logrus.Error("GetListAuditLog Decode:", err)
+			return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+		}
+
+		list = append(list, row)
+	}
+
+	return helpers.NewResponse(http.StatusOK, "Success", nil, helpers.PaginatedResponse{
+		Limit: limit,
+		Page:  page,
+		Total: total,
+		List:  list,
+	})
+}
+
+func (u *superadminAppUsecase) GetAuditLogDetail(ctx context.Context, id string) helpers.Response {
+	ctx, cancel := context.WithTimeout(ctx, u.contextTimeout)
+	defer cancel()
+
+	auditLog, err := u.mongoDbRepo.FetchOneAuditLog(ctx, map[string]interface{}{
+		"id": id,
+	})
+	if err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+	if auditLog == nil {
+		return helpers.NewResponse(http.StatusBadRequest, "Audit log not found", nil, nil)
+	}
+
+	return helpers.NewResponse(http.StatusOK, "Success", nil, auditLog)
+}
@@ -6,14 +6,11 @@ import (
 	jwt_helpers "app/helpers/jwt"
 	"context"
 	"net/http"
-	"time"
 
-	"github.com/golang-jwt/jwt/v5"
-	"github.com/google/uuid"
 	"golang.org/x/crypto/bcrypt"
 )
 
-func (u *superadminAppUsecase) Login(ctx context.Context, payload request.SuperadminLoginRequest) helpers.Response {
+func (u *superadminAppUsecase) Login(ctx context.Context, payload request.SuperadminLoginRequest, clientIP string) helpers.Response {
 	ctx, cancel := context.WithTimeout(ctx, u.contextTimeout)
 	defer cancel()
 
@@ -29,6 +26,15 @@ func (u *superadminAppUsecase) Login(ctx context.Context, payload request.Supera
 		return helpers.NewResponse(http.StatusUnprocessableEntity, "Validation Error", errValidation, nil)
 	}
 
+	// check failed login backoff and lockout
+	message, err := u.checkLoginAttempt(ctx, payload.Email, clientIP)
+	if err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+	if message != "" {
+		return helpers.NewResponse(http.StatusTooManyRequests, message, nil, nil)
+	}
+
 	// check superadmin
 	superadmin, err := u.mongoDbRepo.FetchOneSuperadmin(ctx, map[string]interface{}{
 		"email": payload.Email,
@@ -37,36 +43,34 @@ func (u *superadminAppUsecase) Login(ctx context.Context, payload request.Supera
 		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
 	}
 	if superadmin == nil {
+		u.recordLoginFailure(ctx, payload.Email, clientIP, nil)
 		return helpers.NewResponse(http.StatusBadRequest, "User not found", nil, nil)
 	}
 
 	// check password
 	if err := bcrypt.CompareHashAndPassword([]byte(superadmin.Password), []byte(payload.Password)); err != nil {
+		u.recordLoginFailure(ctx, payload.Email, clientIP, superadmin)
 		return helpers.NewResponse(http.StatusBadRequest, "Wrong password", nil, nil)
 	}
+	u.clearLoginAttempt(ctx, payload.Email)
 
-	// generate token
-	now := time.Now()
-	expiredAt := now.Add(time.Duration(jwt_helpers.GetJWTTTL()) * time.Minute)
-	token, err := jwt_helpers.GenerateJWTTokenSuperadmin(jwt_helpers.SuperadminJWTClaims{
-		UserID: superadmin.ID.Hex(),
-		RegisteredClaims: jwt.RegisteredClaims{
-			ID:        uuid.NewString(),
-			Issuer:    "superadmin",
-			IssuedAt:  jwt.NewNumericDate(now),
-			NotBefore: jwt.NewNumericDate(now),
-			ExpiresAt: jwt.NewNumericDate(expiredAt),
-		},
-	})
+	// second step, the token is only issued after the authenticator code is verified
+	if superadmin.TwoFactor.IsEnabled || helpers.GetSuperadminTwoFactorRequired() {
+		result, err := issueTwoFactorChallenge(superadmin)
+		if err != nil {
+			return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+		}
+		return helpers.NewResponse(http.StatusOK, "Two factor authentication required", nil, result)
+	}
+
+	// create session and generate token
+	result, err := u.createAuthSession(ctx, superadmin)
 	if err != nil {
 		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
 	}
+	result["user"] = superadmin
 
-	return helpers.NewResponse(http.StatusOK, "Login successful", nil, map[string]any{
-		"token":     token,
-		"expiredAt": expiredAt,
-		"user":      superadmin,
-	})
+	return helpers.NewResponse(http.StatusOK, "Login successful", nil, result)
 }
 
 func (u *superadminAppUsecase) GetProfile(ctx context.Context, claim jwt_helpers.SuperadminJWTClaims) helpers.Response {
@@ -0,0 +1,120 @@
+package superadmin_usecase
+
+import (
+	shared_usecase "app/app/usecase/shared"
+	mongo_model "app/domain/model/mongo"
+	"app/domain/request"
+	"app/helpers"
+	jwt_helpers "app/helpers/jwt"
+	"context"
+	"errors"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+)
+
+// authSession the superadmin side of the shared device session flow
+func (u *superadminAppUsecase) authSession() shared_usecase.AuthSessionActor {
+	return shared_usecase.AuthSessionActor{
+		Role: mongo_model.ActorRoleSuperadmin,
+		FetchUser: func(ctx context.Context, userId string) (*shared_usecase.AuthSessionUser, error) {
+			superadmin, err := u.mongoDbRepo.FetchOneSuperadmin(ctx, map[string]interface{}{
+				"id": userId,
+			})
+			if err != nil || superadmin == nil {
+				return nil, err
+			}
+			return &shared_usecase.AuthSessionUser{TokenVersion: superadmin.TokenVersion}, nil
+		},
+		BumpTokenVersion: func(ctx context.Context, userId string, now time.Time) (bool, error) {
+			return u.mongoDbRepo.UpdatePartialSuperadminBumpTokenVersion(ctx, map[string]interface{}{
+				"id": userId,
+			}, map[string]interface{}{
+				"updatedAt": now,
+			})
+		},
+		GenerateToken: u.generateSuperadminToken,
+	}
+}
+
+// createAuthSession start a new device session and issue its first access and refresh token
+func (u *superadminAppUsecase) createAuthSession(ctx context.Context, superadmin *mongo_model.Superadmin) (map[string]any, error) {
+	return u.authSession().Create(ctx, u.mongoDbRepo, superadmin.ID.Hex(), superadmin.TokenVersion)
+}
+
+// superadminPermissions returns the permissions of the assigned role, owners get every permission
+func (u *superadminAppUsecase) superadminPermissions(ctx context.Context, superadmin *mongo_model.Superadmin) ([]string, error) {
+	if superadmin.Role == nil {
+		return []string{string(mongo_model.PermissionAll)}, nil
+	}
+
+	role, err := u.mongoDbRepo.FetchOneRole(ctx, map[string]interface{}{
+		"id": superadmin.Role.ID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	permissions := make([]string, 0)
+	if role != nil {
+		for _, permission := range role.Permissions {
+			permissions = append(permissions, string(permission))
+		}
+	}
+	return permissions, nil
+}
+
+// generateSuperadminToken the permissions are read on every issue, so a refreshed token carries the current permissions of the role
+func (u *superadminAppUsecase) generateSuperadminToken(ctx context.Context, session *mongo_model.AuthSession, now time.Time) (string, time.Time, map[string]any, error) {
+	superadmin, err := u.mongoDbRepo.FetchOneSuperadmin(ctx, map[string]interface{}{
+		"id": session.UserID,
+	})
+	if err != nil {
+		return "", time.Time{}, nil, err
+	}
+	if superadmin == nil {
+		return "", time.Time{}, nil, errors.New("superadmin not found")
+	}
+	permissions, err := u.superadminPermissions(ctx, superadmin)
+	if err != nil {
+		return "", time.Time{}, nil, err
+	}
+
+	expiredAt := now.Add(time.Duration(jwt_helpers.GetJWTTTL()) * time.Minute)
+	token, err := jwt_helpers.GenerateJWTTokenSuperadmin(jwt_helpers.SuperadminJWTClaims{
+		UserID:       session.UserID,
+		SessionID:    session.ID.Hex(),
+		TokenVersion: session.TokenVersion,
+		Permissions:  permissions,
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        uuid.NewString(),
+			Issuer:    "superadmin",
+			IssuedAt:  jwt.NewNumericDate(now),
+			NotBefore: jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(expiredAt),
+		},
+	})
+	return token, expiredAt, map[string]any{"permissions": permissions}, err
+}
+
+func (u *superadminAppUsecase) RefreshToken(ctx context.Context, payload request.RefreshTokenRequest) helpers.Response {
+	ctx, cancel := context.WithTimeout(ctx, u.contextTimeout)
+	defer cancel()
+
+	return u.authSession().Refresh(ctx, u.mongoDbRepo, payload)
+}
+
+func (u *superadminAppUsecase) Logout(ctx context.Context, claim jwt_helpers.SuperadminJWTClaims) helpers.Response {
+	ctx, cancel := context.WithTimeout(ctx, u.contextTimeout)
+	defer cancel()
+
+	return u.authSession().Logout(ctx, u.mongoDbRepo, claim.SessionID, claim.UserID)
+}
+
+func (u *superadminAppUsecase) LogoutAllDevices(ctx context.Context, claim jwt_helpers.SuperadminJWTClaims) helpers.Response {
+	ctx, cancel := context.WithTimeout(ctx, u.contextTimeout)
+	defer cancel()
+
+	return u.authSession().LogoutAllDevices(ctx, u.mongoDbRepo, claim.UserID)
+}
@@ -155,10 +155,12 @@ func (u *superadminAppUsecase) CreateCandidate(ctx context.Context, payload requ
 	if payload.SeasonTeamPlayerID == "" {
 		errs["seasonTeamPlayerId"] = "SeasonTeamPlayer ID is required"
 	}
-	if payload.Performance.TeamLeaderboard == 0 {
+	// without performance it is pulled from the recorded matches
+	isManualPerformance := payload.Performance != request.CandidatePerformanceRequest{}
+	if isManualPerformance && payload.Performance.TeamLeaderboard == 0 {
 		errs["performance.teamLeaderboard"] = "TeamLeaderboard is required"
 	}
-	if payload.Performance.Goal == 0 && payload.Performance.Assist == 0 && payload.Performance.Save == 0 {
+	if isManualPerformance && payload.Performance.Goal == 0 && payload.Performance.Assist == 0 && payload.Performance.Save == 0 {
 		errs["performance.goal"] = "At least one of goal, assist, or save must be provided"
 		errs["performance.assist"] = "At least one of goal, assist, or save must be provided"
 		errs["performance.save"] = "At least one of goal, assist, or save must be provided"
@@ -208,6 +210,31 @@ func (u *superadminAppUsecase) CreateCandidate(ctx context.Context, payload requ
 		Assist: payload.Performance.Assist,
 		Save:   payload.Performance.Save,
 	}
+	performance := mongo_model.Performance{
+		TeamLeaderboard: payload.Performance.TeamLeaderboard,
+		Goal:            payload.Performance.Goal,
+		Assist:          payload.Performance.Assist,
+		Save:            payload.Performance.Save,
+		Score:           helpers.CalculateScore(scorePoint, candidatePerformanceCount),
+	}
+
+	// or from recorded matches of the voting series
+	if !isManualPerformance {
+		votingPerformance, err := u.fetchVotingPerformance(ctx, voting)
+		if err != nil {
+			return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+		}
+		if votingPerformance == nil {
+			return helpers.NewResponse(http.StatusBadRequest, "No finished matches in the series of the voting, performance is required", nil, nil)
+		}
+		var ok bool
+		performance, ok = votingPerformance.candidatePerformance(seasonTeamPlayer.ID.Hex(), seasonTeam.ID.Hex(), voting.PerformancePoint)
+		if !ok {
+			return helpers.NewResponse(http.StatusUnprocessableEntity, "Validation Error", map[string]string{
+				"performance.teamLeaderboard": "Team has no position in the season standings, performance is required",
+			}, nil)
+		}
+	}
 
 	now := time.Now()
 	candidate := &mongo_model.Candidate{
@@ -226,13 +253,7 @@ func (u *superadminAppUsecase) CreateCandidate(ctx context.Context, payload requ
 			Position:   seasonTeamPlayer.Position,
 			Image:      seasonTeamPlayer.Image.URL,
 		},
-		Performance: mongo_model.Performance{
-			TeamLeaderboard: payload.Performance.TeamLeaderboard,
-			Goal:            payload.Performance.Goal,
-			Assist:          payload.Performance.Assist,
-			Save:            payload.Performance.Save,
-			Score:           helpers.CalculateScore(scorePoint, candidatePerformanceCount),
-		},
+		Performance: performance,
 		Voters: mongo_model.Voters{
 			Count: 0,
 		},
@@ -243,6 +264,7 @@ func (u *superadminAppUsecase) CreateCandidate(ctx context.Context, payload requ
 	if err := u.mongoDbRepo.CreateOneCandidate(ctx, candidate); err != nil {
 		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
 	}
+	u.recordAuditLog(ctx, mongo_model.AuditActionCreate, mongo_model.AuditEntityCandidate, candidate.ID.Hex(), nil, candidate)
 
 	return helpers.NewResponse(http.StatusCreated, "Candidate created", nil, candidate)
 }
@@ -261,6 +283,7 @@ func (u *superadminAppUsecase) UpdateCandidate(ctx context.Context, id string, p
 	if c == nil {
 		return helpers.NewResponse(http.StatusNotFound, "Candidate not found", nil, nil)
 	}
+	before := helpers.AuditSnapshot(c)
 
 	// 2) Prevent edit if voting is active
 	now := time.Now()
@@ -310,6 +333,33 @@ func (u *superadminAppUsecase) UpdateCandidate(ctx context.Context, id string, p
 		fields["seasonTeamPlayer"] = c.SeasonTeamPlayer
 	}
 
+	// without performance it is pulled from the recorded matches of the voting series
+	isManualPerformance := payload.Performance != request.CandidatePerformanceRequest{}
+	if !isManualPerformance {
+		if voting == nil {
+			return helpers.NewResponse(http.StatusBadRequest, "Voting not found", nil, nil)
+		}
+		votingPerformance, err := u.fetchVotingPerformance(ctx, voting)
+		if err != nil {
+			return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+		}
+		if votingPerformance == nil {
+			return helpers.NewResponse(http.StatusBadRequest, "No finished matches in the series of the voting, performance is required", nil, nil)
+		}
+		performance, ok := votingPerformance.candidatePerformance(c.SeasonTeamPlayer.ID, c.SeasonTeam.ID, voting.PerformancePoint)
+		if !ok {
+			return helpers.NewResponse(http.StatusUnprocessableEntity, "Validation Error", map[string]string{
+				"performance.teamLeaderboard": "Team has no position in the season standings, performance is required",
+			}, nil)
+		}
+		payload.Performance = request.CandidatePerformanceRequest{
+			TeamLeaderboard: performance.TeamLeaderboard,
+			Goal:            performance.Goal,
+			Assist:          performance.Assist,
+			Save:            performance.Save,
+		}
+	}
+
 	c.Performance.TeamLeaderboard = payload.Performance.TeamLeaderboard
 	fields["performance.teamLeaderboard"] = c.Performance.TeamLeaderboard
 
@@ -322,7 +372,7 @@ func (u *superadminAppUsecase) UpdateCandidate(ctx context.Context, id string, p
 	c.Performance.Save = payload.Performance.Save
 	fields["performance.save"] = c.Performance.Save
 
-	if payload.Performance.Goal != 0 || payload.Performance.Assist != 0 || payload.Performance.Save != 0 {
+	if !isManualPerformance || payload.Performance.Goal != 0 || payload.Performance.Assist != 0 || payload.Performance.Save != 0 {
 		// score point from voting
 		scorePoint := helpers.PerformancePoint{
 			Goal:   voting.PerformancePoint.Goal,
@@ -336,7 +386,8 @@ func (u *superadminAppUsecase) UpdateCandidate(ctx context.Context, id string, p
 			Assist: c.Performance.Assist,
 			Save:   c.Performance.Save,
 		}
-		fields["performance.score"] = helpers.CalculateScore(scorePoint, candidatePerformanceCount)
+		c.Performance.Score = helpers.CalculateScore(scorePoint, candidatePerformanceCount)
+		fields["performance.score"] = c.Performance.Score
 	} else {
 		errs := map[string]string{}
 		errs["performance.goal"] = "At least one of goal, assist, or save must be provided"
@@ -356,6 +407,7 @@ func (u *superadminAppUsecase) UpdateCandidate(ctx context.Context, id string, p
 	if err := u.mongoDbRepo.UpdatePartialCandidate(ctx, map[string]interface{}{"id": c.ID}, fields); err != nil {
 		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
 	}
+	u.recordAuditLog(ctx, mongo_model.AuditActionUpdate, mongo_model.AuditEntityCandidate, c.ID.Hex(), before, helpers.AuditApply(before, fields))
 
 	return helpers.NewResponse(http.StatusOK, "Candidate updated", nil, c)
 }
@@ -373,6 +425,7 @@ func (u *superadminAppUsecase) DeleteCandidate(ctx context.Context, id string) h
 	if c == nil {
 		return helpers.NewResponse(http.StatusNotFound, "Candidate not found", nil, nil)
 	}
+	before := helpers.AuditSnapshot(c)
 
 	// 2) Prevent delete if voting is active
 	now := time.Now()
@@ -391,6 +444,7 @@ func (u *superadminAppUsecase) DeleteCandidate(ctx context.Context, id string) h
 	}); err != nil {
 		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
 	}
+	u.recordAuditLog(ctx, mongo_model.AuditActionDelete, mongo_model.AuditEntityCandidate, c.ID.Hex(), before, helpers.AuditApply(before, map[string]interface{}{"deletedAt": now}))
 
 	return helpers.NewResponse(http.StatusOK, "Candidate deleted", nil, nil)
 }
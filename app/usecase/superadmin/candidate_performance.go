@@ -0,0 +1,198 @@
+package superadmin_usecase
+
+import (
+	mongo_model "app/domain/model/mongo"
+	"app/helpers"
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+// votingPerformance is the recorded match stats of the finished matches in the series of a voting,
+// the team leaderboard is the position of the team in the standings of the whole season.
+// A season played in groups has no single leaderboard so its teams have no position
+type votingPerformance struct {
+	playerStats   map[string]mongo_model.PlayerStats
+	teamPositions map[string]int64
+}
+
+func (u *superadminAppUsecase) RefreshVotingCandidates(ctx context.Context, id string) helpers.Response {
+	ctx, cancel := context.WithTimeout(ctx, u.contextTimeout)
+	defer cancel()
+
+	// check voting
+	voting, err := u.mongoDbRepo.FetchOneVoting(ctx, map[string]interface{}{
+		"id": id,
+	})
+	if err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+	if voting == nil {
+		return helpers.NewResponse(http.StatusBadRequest, "Voting not found", nil, nil)
+	}
+
+	// recorded match stats
+	performance, err := u.fetchVotingPerformance(ctx, voting)
+	if err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+	if performance == nil {
+		return helpers.NewResponse(http.StatusBadRequest, "No finished matches in the series of the voting", nil, nil)
+	}
+
+	// fetch candidates
+	cur, err := u.mongoDbRepo.FetchListCandidate(ctx, map[string]interface{}{
+		"votingId": voting.ID.Hex(),
+	})
+	if err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+	defer cur.Close(ctx)
+
+	var candidates []mongo_model.Candidate
+	for cur.Next(ctx) {
+		var c mongo_model.Candidate
+		if err := cur.Decode(&c); err != nil {
+			logrus.Error("Candidate Decode Error:", err)
+			return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+		}
+		candidates = append(candidates, c)
+	}
+
+	// every candidate team needs a leaderboard position before any is saved
+	performances := make([]mongo_model.Performance, len(candidates))
+	for i, c := range candidates {
+		candidatePerformance, ok := performance.candidatePerformance(c.SeasonTeamPlayer.ID, c.SeasonTeam.ID, voting.PerformancePoint)
+		if !ok {
+			return helpers.NewResponse(http.StatusUnprocessableEntity, "Validation Error", map[string]string{
+				"teamLeaderboard": "Team " + c.SeasonTeam.Team.Name + " has no position in the season standings",
+			}, nil)
+		}
+		performances[i] = candidatePerformance
+	}
+
+	// save
+	now := time.Now()
+	list := make([]interface{}, 0, len(candidates))
+	for i, c := range candidates {
+		before := helpers.AuditSnapshot(c)
+		c.Performance = performances[i]
+		c.UpdatedAt = now
+
+		fields := map[string]interface{}{
+			"performance": c.Performance,
+			"updatedAt":   c.UpdatedAt,
+		}
+		if err := u.mongoDbRepo.UpdatePartialCandidate(ctx, map[string]interface{}{"id": c.ID}, fields); err != nil {
+			return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+		}
+		if _, changed := helpers.AuditDiff(before, helpers.AuditSnapshot(c)); len(changed) > 0 {
+			u.recordAuditLog(ctx, mongo_model.AuditActionUpdate, mongo_model.AuditEntityCandidate, c.ID.Hex(), before, helpers.AuditApply(before, fields))
+		}
+
+		c.Voting = mongo_model.VotingFK{
+			ID:         voting.ID.Hex(),
+			Title:      voting.Title,
+			TotalVoter: voting.TotalVoter,
+		}
+		list = append(list, *c.Format(&c.Voting))
+	}
+
+	return helpers.NewResponse(http.StatusOK, "Refresh candidates success", nil, list)
+}
+
+// fetchVotingPerformance returns nil when no match of the series of the voting is finished
+func (u *superadminAppUsecase) fetchVotingPerformance(ctx context.Context, voting *mongo_model.Voting) (*votingPerformance, error) {
+	series, err := u.mongoDbRepo.FetchOneSeries(ctx, map[string]interface{}{
+		"id": voting.SeriesID,
+	})
+	if err != nil || series == nil {
+		return nil, err
+	}
+
+	// finished matches of the series, events of a match still played may be corrected
+	matchCur, err := u.mongoDbRepo.FetchListMatch(ctx, map[string]interface{}{
+		"seriesId": series.ID.Hex(),
+		"status":   mongo_model.MatchStatusFinished,
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer matchCur.Close(ctx)
+
+	matchIds := make([]string, 0)
+	for matchCur.Next(ctx) {
+		var match mongo_model.Match
+		if err := matchCur.Decode(&match); err != nil {
+			logrus.Error("Match Decode:", err)
+			return nil, err
+		}
+		matchIds = append(matchIds, match.ID.Hex())
+	}
+	if len(matchIds) == 0 {
+		return nil, nil
+	}
+
+	// stats of every player in the matches
+	playerStats, _, err := u.mongoDbRepo.AggregatePlayerStats(ctx, map[string]interface{}{
+		"matchIds": matchIds,
+	})
+	if err != nil {
+		return nil, err
+	}
+	playerStatsMap := make(map[string]mongo_model.PlayerStats, len(playerStats))
+	for _, stats := range playerStats {
+		playerStatsMap[stats.SeasonTeamPlayer.ID] = stats
+	}
+
+	// team positions
+	teamPositions := make(map[string]int64)
+	standing, err := u.mongoDbRepo.FetchOneStanding(ctx, map[string]interface{}{
+		"seasonId": series.SeasonID,
+		"seriesId": "",
+		"group":    "",
+	})
+	if err != nil {
+		return nil, err
+	}
+	if standing != nil && !standing.HasGroups() {
+		for _, row := range standing.Rows {
+			teamPositions[row.SeasonTeam.ID] = int64(row.Position)
+		}
+	}
+
+	return &votingPerformance{
+		playerStats:   playerStatsMap,
+		teamPositions: teamPositions,
+	}, nil
+}
+
+// candidatePerformance returns false when the team has no position in the season leaderboard
+func (p *votingPerformance) candidatePerformance(seasonTeamPlayerId, seasonTeamId string, point mongo_model.PerformancePoint) (mongo_model.Performance, bool) {
+	teamPosition, ok := p.teamPositions[seasonTeamId]
+	if !ok {
+		return mongo_model.Performance{}, false
+	}
+
+	performance := mongo_model.Performance{
+		TeamLeaderboard: teamPosition,
+	}
+	if stats, ok := p.playerStats[seasonTeamPlayerId]; ok {
+		performance.Goal = stats.Goal
+		performance.Assist = stats.Assist
+		performance.Save = stats.Save
+	}
+	performance.Score = helpers.CalculateScore(helpers.PerformancePoint{
+		Goal:   point.Goal,
+		Assist: point.Assist,
+		Save:   point.Save,
+	}, helpers.CandidatePerformanceCount{
+		Goal:   performance.Goal,
+		Assist: performance.Assist,
+		Save:   performance.Save,
+	})
+
+	return performance, true
+}
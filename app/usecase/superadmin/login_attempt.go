@@ -0,0 +1,165 @@
+package superadmin_usecase
+
+import (
+	shared_usecase "app/app/usecase/shared"
+	mongo_model "app/domain/model/mongo"
+	"app/helpers"
+	jwt_helpers "app/helpers/jwt"
+	mailing_helpers "app/helpers/mailing"
+	"context"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+// checkLoginAttempt returns the refusal message while the account or ip is in backoff or locked
+func (u *superadminAppUsecase) checkLoginAttempt(ctx context.Context, account, ip string) (string, error) {
+	return shared_usecase.CheckLoginAttempt(ctx, u.mongoDbRepo, mongo_model.ActorRoleSuperadmin, account, ip)
+}
+
+// recordLoginFailure count the failure on the account and ip, the owner is emailed when the account gets locked
+func (u *superadminAppUsecase) recordLoginFailure(ctx context.Context, account, ip string, owner *mongo_model.Superadmin) {
+	if lockedUntil := shared_usecase.RecordLoginFailure(ctx, u.mongoDbRepo, mongo_model.ActorRoleSuperadmin, account, ip); lockedUntil != nil && owner != nil && owner.Email != nil {
+		go mailing_helpers.SendAccountLocked(owner.Name, *owner.Email, *lockedUntil)
+	}
+}
+
+// clearLoginAttempt reset the account counter after a successful login
+func (u *superadminAppUsecase) clearLoginAttempt(ctx context.Context, account string) {
+	shared_usecase.ClearLoginAttempt(ctx, u.mongoDbRepo, mongo_model.ActorRoleSuperadmin, account)
+}
+
+func (u *superadminAppUsecase) GetLoginAttemptsList(ctx context.Context, queryParam url.Values) helpers.Response {
+	ctx, cancel := context.WithTimeout(ctx, u.contextTimeout)
+	defer cancel()
+
+	// get limit offset
+	page, offset, limit := helpers.GetOffsetLimit(queryParam)
+
+	fetchOptions := map[string]interface{}{
+		"limit":  limit,
+		"offset": offset,
+	}
+
+	// filtering
+	now := time.Now()
+	if queryParam.Get("search") != "" {
+		fetchOptions["search"] = queryParam.Get("search")
+	}
+	if queryParam.Get("role") != "" {
+		fetchOptions["role"] = mongo_model.ActorRole(queryParam.Get("role"))
+	}
+	if queryParam.Get("type") != "" {
+		fetchOptions["type"] = mongo_model.LoginAttemptType(queryParam.Get("type"))
+	}
+	if queryParam.Get("isLocked") == "true" {
+		fetchOptions["lockedAt"] = now
+	}
+
+	// count total
+	total := u.mongoDbRepo.CountLoginAttempt(ctx, fetchOptions)
+	if total == 0 {
+		return helpers.NewResponse(http.StatusOK, "Success", nil, helpers.PaginatedResponse{
+			List:  []interface{}{},
+			Limit: limit,
+			Page:  page,
+			Total: total,
+		})
+	}
+
+	// sorting
+	if queryParam.Get("sort") != "" {
+		fetchOptions["sort"] = queryParam.Get("sort")
+	}
+	if queryParam.Get("dir") != "" {
+		fetchOptions["dir"] = queryParam.Get("dir")
+	}
+
+	// fetch data
+	cur, err := u.mongoDbRepo.FetchListLoginAttempt(ctx, fetchOptions)
+	if err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+	defer cur.Close(ctx)
+
+	var list []interface{}
+	for cur.Next(ctx) {
+		row := mongo_model.LoginAttempt{}
+		err := cur.Decode(&row)
+		if err != nil {
+			logrus.Error("GetListLoginAttempt Decode:", err)
+			return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+		}
+
+		list = append(list, row.Format(now))
+	}
+
+	return helpers.NewResponse(http.StatusOK, "Success", nil, helpers.PaginatedResponse{
+		Limit: limit,
+		Page:  page,
+		Total: total,
+		List:  list,
+	})
+}
+
+func (u *superadminAppUsecase) UnlockLoginAttempt(ctx context.Context, claim jwt_helpers.SuperadminJWTClaims, id string) helpers.Response {
+	ctx, cancel := context.WithTimeout(ctx, u.contextTimeout)
+	defer cancel()
+
+	// get superadmin
+	superadmin, err := u.mongoDbRepo.FetchOneSuperadmin(ctx, map[string]interface{}{
+		"id": claim.UserID,
+	})
+	if err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+	if superadmin == nil {
+		return helpers.NewResponse(http.StatusBadRequest, "User not found", nil, nil)
+	}
+
+	// get login attempt
+	attempt, err := u.mongoDbRepo.FetchOneLoginAttempt(ctx, map[string]interface{}{
+		"id": id,
+	})
+	if err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+	if attempt == nil {
+		return helpers.NewResponse(http.StatusBadRequest, "Login attempt not found", nil, nil)
+	}
+	before := helpers.AuditSnapshot(attempt)
+
+	// unlock
+	now := time.Now()
+	attempt.Failures = 0
+	attempt.LockCount = 0
+	attempt.RetryAt = nil
+	attempt.LockedUntil = nil
+	attempt.UnlockedBy = &mongo_model.ActorFK{
+		ID:   superadmin.ID.Hex(),
+		Name: superadmin.Name,
+		Role: mongo_model.ActorRoleSuperadmin,
+	}
+	attempt.UnlockedAt = &now
+	attempt.UpdatedAt = now
+
+	// save
+	if err := u.mongoDbRepo.UpdatePartialLoginAttempt(ctx, map[string]interface{}{
+		"id": attempt.ID,
+	}, map[string]interface{}{
+		"failures":    attempt.Failures,
+		"lockCount":   attempt.LockCount,
+		"retryAt":     attempt.RetryAt,
+		"lockedUntil": attempt.LockedUntil,
+		"unlockedBy":  attempt.UnlockedBy,
+		"unlockedAt":  attempt.UnlockedAt,
+		"updatedAt":   attempt.UpdatedAt,
+	}); err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+	u.recordAuditLog(ctx, mongo_model.AuditActionUnlock, mongo_model.AuditEntityLoginAttempt, attempt.ID.Hex(), before, attempt)
+
+	return helpers.NewResponse(http.StatusOK, "Unlock login success", nil, attempt.Format(now))
+}
@@ -0,0 +1,789 @@
+package superadmin_usecase
+
+import (
+	shared_usecase "app/app/usecase/shared"
+	mongo_model "app/domain/model/mongo"
+	"app/domain/request"
+	"app/helpers"
+	"context"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func (u *superadminAppUsecase) GetMatchesList(ctx context.Context, queryParam url.Values) helpers.Response {
+	ctx, cancel := context.WithTimeout(ctx, u.contextTimeout)
+	defer cancel()
+
+	// get limit offset
+	page, offset, limit := helpers.GetOffsetLimit(queryParam)
+
+	fetchOptions := map[string]interface{}{
+		"limit":  limit,
+		"offset": offset,
+	}
+
+	// filtering
+	if queryParam.Get("seasonId") != "" {
+		fetchOptions["seasonId"] = queryParam.Get("seasonId")
+	}
+	if queryParam.Get("seriesId") != "" {
+		fetchOptions["seriesId"] = queryParam.Get("seriesId")
+	}
+	if queryParam.Get("ticketId") != "" {
+		fetchOptions["ticketId"] = queryParam.Get("ticketId")
+	}
+	if queryParam.Get("seasonTeamId") != "" {
+		fetchOptions["seasonTeamId"] = queryParam.Get("seasonTeamId")
+	}
+	if queryParam.Get("status") != "" {
+		fetchOptions["status"] = mongo_model.MatchStatus(queryParam.Get("status"))
+	}
+
+	// kickoff range in WIB, both ends inclusive
+	errValidation := make(map[string]string)
+	loc, _ := time.LoadLocation("Asia/Jakarta")
+	if queryParam.Get("startDate") != "" {
+		startDate, err := time.ParseInLocation("2006-01-02", queryParam.Get("startDate"), loc)
+		if err != nil {
+			errValidation["startDate"] = "Start date must be in format YYYY-MM-DD"
+		} else {
+			fetchOptions["kickoffAtFrom"] = helpers.SetToStartOfDayWIB(startDate)
+		}
+	}
+	if queryParam.Get("endDate") != "" {
+		endDate, err := time.ParseInLocation("2006-01-02", queryParam.Get("endDate"), loc)
+		if err != nil {
+			errValidation["endDate"] = "End date must be in format YYYY-MM-DD"
+		} else {
+			fetchOptions["kickoffAtTo"] = helpers.SetToEndOfDayWIB(endDate)
+		}
+	}
+	if len(errValidation) > 0 {
+		return helpers.NewResponse(http.StatusUnprocessableEntity, "Validation Error", errValidation, nil)
+	}
+
+	// count total
+	total := u.mongoDbRepo.CountMatch(ctx, fetchOptions)
+	if total == 0 {
+		return helpers.NewResponse(http.StatusOK, "Success", nil, helpers.PaginatedResponse{
+			List:  []interface{}{},
+			Limit: limit,
+			Page:  page,
+			Total: total,
+		})
+	}
+
+	// sorting, default kickoff ascending
+	fetchOptions["sort"] = "kickoffAt"
+	fetchOptions["dir"] = "asc"
+	if queryParam.Get("sort") != "" {
+		fetchOptions["sort"] = queryParam.Get("sort")
+	}
+	if queryParam.Get("dir") != "" {
+		fetchOptions["dir"] = queryParam.Get("dir")
+	}
+
+	// fetch data
+	cur, err := u.mongoDbRepo.FetchListMatch(ctx, fetchOptions)
+	if err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+	defer cur.Close(ctx)
+
+	var matches []*mongo_model.Match
+	for cur.Next(ctx) {
+		row := mongo_model.Match{}
+		err := cur.Decode(&row)
+		if err != nil {
+			logrus.Error("GetListMatch Decode:", err)
+			return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+		}
+
+		matches = append(matches, &row)
+	}
+
+	// set venue and season teams
+	if err := u.setMatchRelations(ctx, matches); err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+
+	list := make([]interface{}, 0, len(matches))
+	for _, match := range matches {
+		list = append(list, match)
+	}
+
+	return helpers.NewResponse(http.StatusOK, "Success", nil, helpers.PaginatedResponse{
+		Limit: limit,
+		Page:  page,
+		Total: total,
+		List:  list,
+	})
+}
+
+func (u *superadminAppUsecase) GetMatchDetail(ctx context.Context, id string) helpers.Response {
+	ctx, cancel := context.WithTimeout(ctx, u.contextTimeout)
+	defer cancel()
+
+	match, err := u.mongoDbRepo.FetchOneMatch(ctx, map[string]interface{}{
+		"id": id,
+	})
+	if err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+	if match == nil {
+		return helpers.NewResponse(http.StatusBadRequest, "Match not found", nil, nil)
+	}
+
+	// set venue and season teams
+	if err := u.setMatchRelations(ctx, []*mongo_model.Match{match}); err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+
+	return helpers.NewResponse(http.StatusOK, "Success", nil, match)
+}
+
+func (u *superadminAppUsecase) GetMatchEventsList(ctx context.Context, id string) helpers.Response {
+	ctx, cancel := context.WithTimeout(ctx, u.contextTimeout)
+	defer cancel()
+
+	match, err := u.mongoDbRepo.FetchOneMatch(ctx, map[string]interface{}{
+		"id": id,
+	})
+	if err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+	if match == nil {
+		return helpers.NewResponse(http.StatusBadRequest, "Match not found", nil, nil)
+	}
+
+	events, err := shared_usecase.FetchMatchEvents(ctx, u.mongoDbRepo, match.ID.Hex())
+	if err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+
+	return helpers.NewResponse(http.StatusOK, "Success", nil, events)
+}
+
+func (u *superadminAppUsecase) CreateMatch(ctx context.Context, payload request.MatchRequest) helpers.Response {
+	ctx, cancel := context.WithTimeout(ctx, u.contextTimeout)
+	defer cancel()
+
+	// validate payload
+	errValidation, kickoffAt := validateMatchPayload(payload)
+	if len(errValidation) > 0 {
+		return helpers.NewResponse(http.StatusUnprocessableEntity, "Validation Error", errValidation, nil)
+	}
+
+	// check ticket day
+	var ticket *mongo_model.Ticket
+	if payload.TicketID != "" {
+		var err error
+		ticket, err = u.mongoDbRepo.FetchOneTicket(ctx, map[string]interface{}{
+			"id": payload.TicketID,
+		})
+		if err != nil {
+			return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+		}
+		if ticket == nil {
+			return helpers.NewResponse(http.StatusBadRequest, "Ticket not found", nil, nil)
+		}
+	}
+
+	// create match
+	now := time.Now()
+	match := mongo_model.Match{
+		ID:        primitive.NewObjectID(),
+		CreatedAt: now,
+	}
+	message, err := u.setMatchFromPayload(ctx, &match, ticket, payload, kickoffAt)
+	if err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+	if message != "" {
+		return helpers.NewResponse(http.StatusBadRequest, message, nil, nil)
+	}
+	match.UpdatedAt = now
+
+	// save
+	if err := u.mongoDbRepo.CreateOneMatch(ctx, &match); err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+	u.recordAuditLog(ctx, mongo_model.AuditActionCreate, mongo_model.AuditEntityMatch, match.ID.Hex(), nil, match)
+
+	// add match to its ticket day
+	if ticket != nil {
+		before := helpers.AuditSnapshot(ticket)
+		ticket.Matchs = append(ticket.Matchs, toTicketMatch(match))
+		if err := u.saveTicketMatchs(ctx, ticket, now); err != nil {
+			return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+		}
+		u.recordAuditLog(ctx, mongo_model.AuditActionUpdate, mongo_model.AuditEntityTicket, ticket.ID.Hex(), before, ticket)
+
+		// update match count in related series in bg
+		go u.updateSeriesMatchCount(context.Background(), ticket.SeriesID)
+	}
+
+	// update standings in bg
+	go u.refreshStandings(context.Background(), match.SeasonID)
+
+	return helpers.NewResponse(http.StatusCreated, "Create match success", nil, match)
+}
+
+func (u *superadminAppUsecase) UpdateMatch(ctx context.Context, id string, payload request.MatchRequest) helpers.Response {
+	ctx, cancel := context.WithTimeout(ctx, u.contextTimeout)
+	defer cancel()
+
+	// get match
+	match, err := u.mongoDbRepo.FetchOneMatch(ctx, map[string]interface{}{
+		"id": id,
+	})
+	if err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+	if match == nil {
+		return helpers.NewResponse(http.StatusBadRequest, "Match not found", nil, nil)
+	}
+	before := helpers.AuditSnapshot(match)
+
+	// validate payload, the ticket day link is kept
+	payload.TicketID = match.TicketID
+	if payload.VenueID == "" {
+		payload.VenueID = match.VenueID
+	}
+	errValidation, kickoffAt := validateMatchPayload(payload)
+	if len(errValidation) > 0 {
+		return helpers.NewResponse(http.StatusUnprocessableEntity, "Validation Error", errValidation, nil)
+	}
+
+	// check ticket day
+	var ticket *mongo_model.Ticket
+	if match.TicketID != "" {
+		ticket, err = u.mongoDbRepo.FetchOneTicket(ctx, map[string]interface{}{
+			"id": match.TicketID,
+		})
+		if err != nil {
+			return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+		}
+	}
+
+	// recorded events
+	events, err := shared_usecase.FetchMatchEvents(ctx, u.mongoDbRepo, match.ID.Hex())
+	if err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+	if len(events) > 0 && (payload.HomeSeasonTeamID != match.HomeSeasonTeamID || payload.AwaySeasonTeamID != match.AwaySeasonTeamID) {
+		return helpers.NewResponse(http.StatusBadRequest, "Match teams can not be changed once events are recorded", nil, nil)
+	}
+
+	// update match
+	now := time.Now()
+	previousSeasonId := match.SeasonID
+	message, err := u.setMatchFromPayload(ctx, match, ticket, payload, kickoffAt)
+	if err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+	if message != "" {
+		return helpers.NewResponse(http.StatusBadRequest, message, nil, nil)
+	}
+	if len(events) > 0 {
+		// the score of a match with recorded events is derived from them
+		score := match.ScoreFromEvents(events)
+		match.Score = &score
+	}
+	match.UpdatedAt = now
+
+	// save
+	if err := u.mongoDbRepo.UpdatePartialMatch(ctx, map[string]interface{}{
+		"id": match.ID,
+	}, map[string]interface{}{
+		"seasonId":         match.SeasonID,
+		"homeSeasonTeamId": match.HomeSeasonTeamID,
+		"awaySeasonTeamId": match.AwaySeasonTeamID,
+		"venueId":          match.VenueID,
+		"kickoffAt":        match.KickoffAt,
+		"status":           match.Status,
+		"score":            match.Score,
+		"duration":         match.Duration,
+		"updatedAt":        match.UpdatedAt,
+	}); err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+	u.recordAuditLog(ctx, mongo_model.AuditActionUpdate, mongo_model.AuditEntityMatch, match.ID.Hex(), before, match)
+
+	// sync the match on its ticket day
+	if ticket != nil {
+		ticketBefore := helpers.AuditSnapshot(ticket)
+		for i := range ticket.Matchs {
+			if ticket.Matchs[i].MatchID == match.ID.Hex() {
+				ticket.Matchs[i] = toTicketMatch(*match)
+			}
+		}
+		if err := u.saveTicketMatchs(ctx, ticket, now); err != nil {
+			return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+		}
+		u.recordAuditLog(ctx, mongo_model.AuditActionUpdate, mongo_model.AuditEntityTicket, ticket.ID.Hex(), ticketBefore, ticket)
+	}
+
+	// update standings in bg, of the previous season too when the teams moved to another season
+	go u.refreshStandings(context.Background(), match.SeasonID)
+	if previousSeasonId != match.SeasonID {
+		go u.refreshStandings(context.Background(), previousSeasonId)
+	}
+
+	return helpers.NewResponse(http.StatusOK, "Update match success", nil, match)
+}
+
+func (u *superadminAppUsecase) DeleteMatch(ctx context.Context, id string) helpers.Response {
+	ctx, cancel := context.WithTimeout(ctx, u.contextTimeout)
+	defer cancel()
+
+	// get match
+	match, err := u.mongoDbRepo.FetchOneMatch(ctx, map[string]interface{}{
+		"id": id,
+	})
+	if err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+	if match == nil {
+		return helpers.NewResponse(http.StatusBadRequest, "Match not found", nil, nil)
+	}
+	before := helpers.AuditSnapshot(match)
+
+	// delete match
+	now := time.Now()
+	match.DeletedAt = &now
+
+	// save
+	if err := u.mongoDbRepo.UpdatePartialMatch(ctx, map[string]interface{}{
+		"id": match.ID,
+	}, map[string]interface{}{
+		"deletedAt": match.DeletedAt,
+	}); err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+	u.recordAuditLog(ctx, mongo_model.AuditActionDelete, mongo_model.AuditEntityMatch, match.ID.Hex(), before, match)
+
+	// remove the match from its ticket day
+	if match.TicketID != "" {
+		ticket, err := u.mongoDbRepo.FetchOneTicket(ctx, map[string]interface{}{
+			"id": match.TicketID,
+		})
+		if err != nil {
+			return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+		}
+		if ticket != nil {
+			ticketBefore := helpers.AuditSnapshot(ticket)
+			matchs := make([]mongo_model.TicketMatch, 0, len(ticket.Matchs))
+			for _, ticketMatch := range ticket.Matchs {
+				if ticketMatch.MatchID != match.ID.Hex() {
+					matchs = append(matchs, ticketMatch)
+				}
+			}
+			ticket.Matchs = matchs
+			if err := u.saveTicketMatchs(ctx, ticket, now); err != nil {
+				return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+			}
+			u.recordAuditLog(ctx, mongo_model.AuditActionUpdate, mongo_model.AuditEntityTicket, ticket.ID.Hex(), ticketBefore, ticket)
+
+			// update match count in related series in bg
+			go u.updateSeriesMatchCount(context.Background(), ticket.SeriesID)
+		}
+	}
+
+	// update standings in bg
+	go u.refreshStandings(context.Background(), match.SeasonID)
+
+	return helpers.NewResponse(http.StatusOK, "Delete match success", nil, nil)
+}
+
+// validateMatchPayload validate create and update match payload, returns the parsed kickoff
+func validateMatchPayload(payload request.MatchRequest) (map[string]string, time.Time) {
+	errValidation := make(map[string]string)
+	if payload.HomeSeasonTeamID == "" {
+		errValidation["homeSeasonTeamId"] = "Home Season Team ID is required"
+	}
+	if payload.AwaySeasonTeamID == "" {
+		errValidation["awaySeasonTeamId"] = "Away Season Team ID is required"
+	}
+	if payload.HomeSeasonTeamID != "" && payload.HomeSeasonTeamID == payload.AwaySeasonTeamID {
+		errValidation["homeSeasonTeamId"] = "Home Season Team ID and Away Season Team ID cannot be the same"
+	}
+	if payload.TicketID == "" && payload.VenueID == "" {
+		errValidation["venueId"] = "Venue ID is required when the match is not on a ticket day"
+	}
+
+	var kickoffAt time.Time
+	if payload.KickoffAt == "" {
+		errValidation["kickoffAt"] = "Kickoff at is required"
+	} else {
+		parsed, err := time.Parse(time.RFC3339, payload.KickoffAt)
+		if err != nil {
+			errValidation["kickoffAt"] = "Kickoff at is invalid"
+		}
+		kickoffAt = parsed
+	}
+
+	status := mongo_model.MatchStatus(payload.Status)
+	if payload.Status != "" && !mongo_model.IsValidMatchStatus(payload.Status) {
+		errValidation["status"] = "Status must be scheduled, live, finished or postponed"
+	}
+	if payload.Score != nil && (payload.Score.Home < 0 || payload.Score.Away < 0) {
+		errValidation["score"] = "Score must not be negative"
+	}
+	if status == mongo_model.MatchStatusFinished && payload.Score == nil {
+		errValidation["score"] = "Score is required when the match is finished"
+	}
+	if payload.Score != nil && status != mongo_model.MatchStatusLive && status != mongo_model.MatchStatusFinished {
+		errValidation["score"] = "Score can only be set when the match is live or finished"
+	}
+	if payload.Duration < 0 {
+		errValidation["duration"] = "Duration must not be negative"
+	} else if payload.Duration > 0 && status != mongo_model.MatchStatusFinished {
+		errValidation["duration"] = "Duration can only be set when the match is finished"
+	}
+
+	return errValidation, kickoffAt
+}
+
+// setMatchFromPayload set the validated payload on the match, returns a bad request message when a
+// season team or the venue is not found, or the kickoff does not fit the ticket day
+func (u *superadminAppUsecase) setMatchFromPayload(ctx context.Context, match *mongo_model.Match, ticket *mongo_model.Ticket, payload request.MatchRequest, kickoffAt time.Time) (string, error) {
+	// check season teams
+	seasonTeamMap, err := shared_usecase.FetchSeasonTeamMap(ctx, u.mongoDbRepo, []string{payload.HomeSeasonTeamID, payload.AwaySeasonTeamID})
+	if err != nil {
+		return "", err
+	}
+	homeSeasonTeam, ok := seasonTeamMap[payload.HomeSeasonTeamID]
+	if !ok {
+		return "Home Season Team " + payload.HomeSeasonTeamID + " not found", nil
+	}
+	awaySeasonTeam, ok := seasonTeamMap[payload.AwaySeasonTeamID]
+	if !ok {
+		return "Away Season Team " + payload.AwaySeasonTeamID + " not found", nil
+	}
+	if homeSeasonTeam.SeasonID != awaySeasonTeam.SeasonID {
+		return "Home and away season team must be in the same season", nil
+	}
+
+	// check ticket day
+	venueId := payload.VenueID
+	if ticket != nil {
+		if !helpers.IsSameDateWIB(kickoffAt, ticket.Date) {
+			return "Kickoff must be on the ticket day " + helpers.FormatDateWIB(ticket.Date, "02 January 2006"), nil
+		}
+		clock := helpers.FormatDateWIB(kickoffAt, "15:04")
+		for _, ticketMatch := range ticket.Matchs {
+			if ticketMatch.MatchID != match.ID.Hex() && ticketMatch.Time == clock {
+				return "Duplicate match time in this ticket is not allowed", nil
+			}
+		}
+		if venueId == "" {
+			venueId = ticket.GetVenueID()
+		}
+		match.SeriesID = ticket.SeriesID
+		match.TicketID = ticket.ID.Hex()
+	}
+
+	// check venue
+	venue, err := u.mongoDbRepo.FetchOneVenue(ctx, map[string]interface{}{
+		"id": venueId,
+	})
+	if err != nil {
+		return "", err
+	}
+	if venue == nil {
+		return "Venue " + venueId + " not found", nil
+	}
+
+	status := mongo_model.MatchStatus(payload.Status)
+	if status == "" {
+		status = mongo_model.MatchStatusScheduled
+	}
+	match.SeasonID = homeSeasonTeam.SeasonID
+	match.HomeSeasonTeamID = payload.HomeSeasonTeamID
+	match.AwaySeasonTeamID = payload.AwaySeasonTeamID
+	match.VenueID = venueId
+	match.KickoffAt = kickoffAt
+	match.Status = status
+	match.Score = nil
+	if payload.Score != nil {
+		match.Score = &mongo_model.MatchScore{
+			Home: payload.Score.Home,
+			Away: payload.Score.Away,
+		}
+	}
+	match.Duration = 0
+	if status == mongo_model.MatchStatusFinished {
+		match.Duration = payload.Duration
+		if match.Duration == 0 {
+			match.Duration = mongo_model.MatchDefaultDuration
+		}
+	}
+	match.HomeSeasonTeam = shared_usecase.ToSeasonTeamFK(homeSeasonTeam)
+	match.AwaySeasonTeam = shared_usecase.ToSeasonTeamFK(awaySeasonTeam)
+	match.Venue = mongo_model.VenueFK{
+		ID:   venue.ID.Hex(),
+		Name: venue.Name,
+	}
+
+	return "", nil
+}
+
+// syncTicketMatches keep the matches collection in line with the matchs of a ticket day,
+// a ticket match without match id keeps the previous match of the same teams so its result survives
+// the edit, previous matches no longer on the ticket day are deleted
+func (u *superadminAppUsecase) syncTicketMatches(ctx context.Context, seasonId string, ticket *mongo_model.Ticket, previous []mongo_model.TicketMatch) error {
+	claimed := make(map[string]bool)
+	for _, ticketMatch := range ticket.Matchs {
+		if ticketMatch.MatchID != "" {
+			claimed[ticketMatch.MatchID] = true
+		}
+	}
+	for i := range ticket.Matchs {
+		if ticket.Matchs[i].MatchID != "" {
+			continue
+		}
+		for _, previousMatch := range previous {
+			if previousMatch.MatchID == "" || claimed[previousMatch.MatchID] {
+				continue
+			}
+			if previousMatch.HomeSeasonTeamID == ticket.Matchs[i].HomeSeasonTeamID && previousMatch.AwaySeasonTeamID == ticket.Matchs[i].AwaySeasonTeamID {
+				ticket.Matchs[i].MatchID = previousMatch.MatchID
+				claimed[previousMatch.MatchID] = true
+				break
+			}
+		}
+	}
+
+	now := time.Now()
+	for i, ticketMatch := range ticket.Matchs {
+		kickoffAt, err := helpers.SetTimeOfDayWIB(ticket.Date, ticketMatch.Time)
+		if err != nil {
+			return err
+		}
+
+		// create match
+		if ticketMatch.MatchID == "" {
+			match := mongo_model.Match{
+				ID:               primitive.NewObjectID(),
+				SeasonID:         seasonId,
+				SeriesID:         ticket.SeriesID,
+				TicketID:         ticket.ID.Hex(),
+				HomeSeasonTeamID: ticketMatch.HomeSeasonTeamID,
+				AwaySeasonTeamID: ticketMatch.AwaySeasonTeamID,
+				VenueID:          ticketMatch.VenueID,
+				KickoffAt:        kickoffAt,
+				Status:           mongo_model.MatchStatusScheduled,
+				CreatedAt:        now,
+				UpdatedAt:        now,
+			}
+			if err := u.mongoDbRepo.CreateOneMatch(ctx, &match); err != nil {
+				return err
+			}
+			u.recordAuditLog(ctx, mongo_model.AuditActionCreate, mongo_model.AuditEntityMatch, match.ID.Hex(), nil, match)
+			ticket.Matchs[i].MatchID = match.ID.Hex()
+			continue
+		}
+
+		// update match, status and score are kept
+		match, err := u.mongoDbRepo.FetchOneMatch(ctx, map[string]interface{}{
+			"id": ticketMatch.MatchID,
+		})
+		if err != nil {
+			return err
+		}
+		if match == nil {
+			continue
+		}
+		before := helpers.AuditSnapshot(match)
+		field := map[string]interface{}{
+			"seasonId":         seasonId,
+			"seriesId":         ticket.SeriesID,
+			"ticketId":         ticket.ID.Hex(),
+			"homeSeasonTeamId": ticketMatch.HomeSeasonTeamID,
+			"awaySeasonTeamId": ticketMatch.AwaySeasonTeamID,
+			"venueId":          ticketMatch.VenueID,
+			"kickoffAt":        kickoffAt,
+			"updatedAt":        now,
+		}
+		if err := u.mongoDbRepo.UpdatePartialMatch(ctx, map[string]interface{}{
+			"id": match.ID,
+		}, field); err != nil {
+			return err
+		}
+		after := helpers.AuditApply(before, field)
+		if _, changed := helpers.AuditDiff(before, after); len(changed) > 0 {
+			u.recordAuditLog(ctx, mongo_model.AuditActionUpdate, mongo_model.AuditEntityMatch, match.ID.Hex(), before, after)
+		}
+	}
+
+	// delete matches removed from the ticket day
+	kept := make(map[string]bool)
+	for _, ticketMatch := range ticket.Matchs {
+		kept[ticketMatch.MatchID] = true
+	}
+	for _, previousMatch := range previous {
+		if previousMatch.MatchID == "" || kept[previousMatch.MatchID] {
+			continue
+		}
+		match, err := u.mongoDbRepo.FetchOneMatch(ctx, map[string]interface{}{
+			"id": previousMatch.MatchID,
+		})
+		if err != nil {
+			return err
+		}
+		if match == nil {
+			continue
+		}
+		before := helpers.AuditSnapshot(match)
+		match.DeletedAt = &now
+		if err := u.mongoDbRepo.UpdatePartialMatch(ctx, map[string]interface{}{
+			"id": match.ID,
+		}, map[string]interface{}{
+			"deletedAt": match.DeletedAt,
+		}); err != nil {
+			return err
+		}
+		u.recordAuditLog(ctx, mongo_model.AuditActionDelete, mongo_model.AuditEntityMatch, match.ID.Hex(), before, match)
+	}
+
+	return nil
+}
+
+// BackfillTicketMatches gives the matchs of ticket days saved before the matches collection their match,
+// matches already created for the ticket day are reused so running it again creates no duplicate
+func (u *superadminAppUsecase) BackfillTicketMatches(ctx context.Context) {
+	listCtx, cancel := context.WithTimeout(ctx, u.contextTimeout)
+	defer cancel()
+
+	cur, err := u.mongoDbRepo.FetchListTicket(listCtx, map[string]interface{}{
+		"missingMatchId": true,
+	})
+	if err != nil {
+		logrus.Error("BackfillTicketMatches FetchListTicket:", err)
+		return
+	}
+	defer cur.Close(listCtx)
+
+	tickets := make([]mongo_model.Ticket, 0)
+	for cur.Next(listCtx) {
+		var ticket mongo_model.Ticket
+		if err := cur.Decode(&ticket); err != nil {
+			logrus.Error("Ticket Decode:", err)
+			return
+		}
+		tickets = append(tickets, ticket)
+	}
+
+	for i := range tickets {
+		if err := u.backfillTicketMatch(ctx, &tickets[i]); err != nil {
+			logrus.Error("BackfillTicketMatches "+tickets[i].ID.Hex()+":", err)
+		}
+	}
+}
+
+func (u *superadminAppUsecase) backfillTicketMatch(ctx context.Context, ticket *mongo_model.Ticket) error {
+	ctx, cancel := context.WithTimeout(ctx, u.contextTimeout)
+	defer cancel()
+
+	series, err := u.mongoDbRepo.FetchOneSeries(ctx, map[string]interface{}{
+		"id": ticket.SeriesID,
+	})
+	if err != nil {
+		return err
+	}
+	if series == nil {
+		return nil
+	}
+
+	// matches already created for the ticket day
+	cur, err := u.mongoDbRepo.FetchListMatch(ctx, map[string]interface{}{
+		"ticketId": ticket.ID.Hex(),
+	})
+	if err != nil {
+		return err
+	}
+	defer cur.Close(ctx)
+
+	previous := make([]mongo_model.TicketMatch, 0)
+	for cur.Next(ctx) {
+		var match mongo_model.Match
+		if err := cur.Decode(&match); err != nil {
+			logrus.Error("Match Decode:", err)
+			return err
+		}
+		previous = append(previous, toTicketMatch(match))
+	}
+
+	if err := u.syncTicketMatches(ctx, series.SeasonID, ticket, previous); err != nil {
+		return err
+	}
+
+	return u.saveTicketMatchs(ctx, ticket, time.Now())
+}
+
+// saveTicketMatchs save the matchs of a ticket day after one of its matches changed
+func (u *superadminAppUsecase) saveTicketMatchs(ctx context.Context, ticket *mongo_model.Ticket, now time.Time) error {
+	ticket.UpdatedAt = now
+	return u.mongoDbRepo.UpdatePartialTicket(ctx, map[string]interface{}{
+		"id": ticket.ID,
+	}, map[string]interface{}{
+		"matchs":    ticket.Matchs,
+		"updatedAt": ticket.UpdatedAt,
+	})
+}
+
+// setMatchRelations set the venue and season teams of the matches
+func (u *superadminAppUsecase) setMatchRelations(ctx context.Context, matches []*mongo_model.Match) error {
+	venueIdSet := make(map[string]struct{})
+	seasonTeamIdSet := make(map[string]struct{})
+	for _, match := range matches {
+		venueIdSet[match.VenueID] = struct{}{}
+		seasonTeamIdSet[match.HomeSeasonTeamID] = struct{}{}
+		seasonTeamIdSet[match.AwaySeasonTeamID] = struct{}{}
+	}
+
+	// set ids to slice
+	venueIds := make([]string, 0, len(venueIdSet))
+	for id := range venueIdSet {
+		venueIds = append(venueIds, id)
+	}
+	seasonTeamIds := make([]string, 0, len(seasonTeamIdSet))
+	for id := range seasonTeamIdSet {
+		seasonTeamIds = append(seasonTeamIds, id)
+	}
+
+	venueMap, err := shared_usecase.FetchVenueMap(ctx, u.mongoDbRepo, venueIds)
+	if err != nil {
+		return err
+	}
+	seasonTeamMap, err := shared_usecase.FetchSeasonTeamMap(ctx, u.mongoDbRepo, seasonTeamIds)
+	if err != nil {
+		return err
+	}
+
+	for _, match := range matches {
+		match.Venue = mongo_model.VenueFK{
+			ID:   match.VenueID,
+			Name: venueMap[match.VenueID].Name,
+		}
+		match.HomeSeasonTeam = shared_usecase.ToSeasonTeamFK(seasonTeamMap[match.HomeSeasonTeamID])
+		match.AwaySeasonTeam = shared_usecase.ToSeasonTeamFK(seasonTeamMap[match.AwaySeasonTeamID])
+	}
+
+	return nil
+}
+
+func toTicketMatch(match mongo_model.Match) mongo_model.TicketMatch {
+	return mongo_model.TicketMatch{
+		MatchID:          match.ID.Hex(),
+		HomeSeasonTeamID: match.HomeSeasonTeamID,
+		AwaySeasonTeamID: match.AwaySeasonTeamID,
+		VenueID:          match.VenueID,
+		Time:             helpers.FormatDateWIB(match.KickoffAt, "15:04"),
+	}
+}
@@ -0,0 +1,103 @@
+package superadmin_usecase
+
+import (
+	mongo_model "app/domain/model/mongo"
+	"app/domain/request"
+	"app/helpers"
+	jwt_helpers "app/helpers/jwt"
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// ImpersonateMember issues a short lived read only member token so support can see what the member sees,
+// the session has no refresh token and ends with the member's logout all devices like any other session
+func (u *superadminAppUsecase) ImpersonateMember(ctx context.Context, claim jwt_helpers.SuperadminJWTClaims, id string, payload request.MemberImpersonateRequest) helpers.Response {
+	ctx, cancel := context.WithTimeout(ctx, u.contextTimeout)
+	defer cancel()
+
+	// validate payload
+	errValidation := make(map[string]string)
+	if payload.Reason == "" {
+		errValidation["reason"] = "Reason field is required"
+	}
+	if len(errValidation) > 0 {
+		return helpers.NewResponse(http.StatusUnprocessableEntity, "Validation Error", errValidation, nil)
+	}
+
+	// check superadmin
+	superadmin, err := u.mongoDbRepo.FetchOneSuperadmin(ctx, map[string]interface{}{
+		"id": claim.UserID,
+	})
+	if err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+	if superadmin == nil {
+		return helpers.NewResponse(http.StatusBadRequest, "User not found", nil, nil)
+	}
+
+	// check member
+	member, err := u.mongoDbRepo.FetchOneMember(ctx, map[string]interface{}{
+		"id": id,
+	})
+	if err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+	if member == nil {
+		return helpers.NewResponse(http.StatusBadRequest, "Member not found", nil, nil)
+	}
+
+	// create impersonation session
+	now := time.Now()
+	session := &mongo_model.AuthSession{
+		ID:           primitive.NewObjectID(),
+		Role:         mongo_model.ActorRoleMember,
+		UserID:       member.ID.Hex(),
+		TokenVersion: member.TokenVersion,
+		ExpiredAt:    now.Add(time.Duration(jwt_helpers.GetJWTImpersonationTTL()) * time.Minute),
+		ImpersonatedBy: &mongo_model.ActorFK{
+			ID:   superadmin.ID.Hex(),
+			Name: superadmin.Name,
+			Role: mongo_model.ActorRoleSuperadmin,
+		},
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	if err := u.mongoDbRepo.CreateOneAuthSession(ctx, session); err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+
+	// generate token, it expires together with the session
+	token, err := jwt_helpers.GenerateJWTTokenMember(jwt_helpers.MemberJWTClaims{
+		UserID:         member.ID.Hex(),
+		SessionID:      session.ID.Hex(),
+		TokenVersion:   member.TokenVersion,
+		ImpersonatorID: superadmin.ID.Hex(),
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        uuid.NewString(),
+			Issuer:    "member",
+			IssuedAt:  jwt.NewNumericDate(now),
+			NotBefore: jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(session.ExpiredAt),
+		},
+	})
+	if err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+	u.recordAuditLog(ctx, mongo_model.AuditActionImpersonate, mongo_model.AuditEntityMember, member.ID.Hex(), nil, bson.M{
+		"sessionId": session.ID.Hex(),
+		"reason":    payload.Reason,
+		"expiredAt": session.ExpiredAt,
+	})
+
+	return helpers.NewResponse(http.StatusOK, "Impersonate member success", nil, map[string]any{
+		"token":     token,
+		"expiredAt": session.ExpiredAt,
+		"member":    member,
+	})
+}
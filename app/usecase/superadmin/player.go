@@ -139,6 +139,7 @@ func (u *superadminAppUsecase) CreatePlayer(ctx context.Context, payload request
 	if err != nil {
 		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
 	}
+	u.recordAuditLog(ctx, mongo_model.AuditActionCreate, mongo_model.AuditEntityPlayer, player.ID.Hex(), nil, player)
 
 	return helpers.NewResponse(http.StatusCreated, "Create player success", nil, player)
 }
@@ -157,6 +158,7 @@ func (u *superadminAppUsecase) UpdatePlayer(ctx context.Context, id string, payl
 	if player == nil {
 		return helpers.NewResponse(http.StatusBadRequest, "Player not found", nil, nil)
 	}
+	before := helpers.AuditSnapshot(player)
 
 	// update player
 	if payload.Name != "" {
@@ -197,6 +199,7 @@ func (u *superadminAppUsecase) UpdatePlayer(ctx context.Context, id string, payl
 
 	// update season team player in bg
 	go u.updateActiveSeasonTeamPlayerBackground(context.Background(), player)
+	u.recordAuditLog(ctx, mongo_model.AuditActionUpdate, mongo_model.AuditEntityPlayer, player.ID.Hex(), before, player)
 
 	return helpers.NewResponse(http.StatusOK, "Update player success", nil, player)
 }
@@ -215,6 +218,7 @@ func (u *superadminAppUsecase) DeletePlayer(ctx context.Context, id string) help
 	if player == nil {
 		return helpers.NewResponse(http.StatusBadRequest, "Player not found", nil, nil)
 	}
+	before := helpers.AuditSnapshot(player)
 
 	// check if player is in active season team player
 	activeSeason, err := u.mongoDbRepo.FetchOneSeason(ctx, map[string]interface{}{
@@ -249,6 +253,7 @@ func (u *superadminAppUsecase) DeletePlayer(ctx context.Context, id string) help
 	if err != nil {
 		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
 	}
+	u.recordAuditLog(ctx, mongo_model.AuditActionDelete, mongo_model.AuditEntityPlayer, player.ID.Hex(), before, player)
 
 	return helpers.NewResponse(http.StatusOK, "Delete player success", nil, nil)
 }
@@ -0,0 +1,167 @@
+package superadmin_usecase
+
+import (
+	mongo_model "app/domain/model/mongo"
+	"app/domain/request"
+	"app/helpers"
+	jwt_helpers "app/helpers/jwt"
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+func (u *superadminAppUsecase) GetRefundsList(ctx context.Context, queryParam url.Values) helpers.Response {
+	ctx, cancel := context.WithTimeout(ctx, u.contextTimeout)
+	defer cancel()
+
+	// get limit offset
+	page, offset, limit := helpers.GetOffsetLimit(queryParam)
+
+	fetchOptions := map[string]interface{}{
+		"limit":  limit,
+		"offset": offset,
+	}
+
+	// filtering
+	if queryParam.Get("purchaseId") != "" {
+		fetchOptions["purchaseId"] = queryParam.Get("purchaseId")
+	}
+	if queryParam.Get("ticketId") != "" {
+		fetchOptions["ticketId"] = queryParam.Get("ticketId")
+	}
+	if queryParam.Get("memberId") != "" {
+		fetchOptions["memberId"] = queryParam.Get("memberId")
+	}
+	if queryParam.Get("jobId") != "" {
+		fetchOptions["jobId"] = queryParam.Get("jobId")
+	}
+	if queryParam.Get("reason") != "" {
+		fetchOptions["reason"] = mongo_model.RefundReason(queryParam.Get("reason"))
+	}
+	if queryParam.Get("status") != "" {
+		status, err := strconv.Atoi(queryParam.Get("status"))
+		if err == nil {
+			fetchOptions["status"] = mongo_model.RefundStatus(status)
+		}
+	}
+
+	// count total
+	total := u.mongoDbRepo.CountRefund(ctx, fetchOptions)
+	if total == 0 {
+		return helpers.NewResponse(http.StatusOK, "Success", nil, helpers.PaginatedResponse{
+			List:  []interface{}{},
+			Limit: limit,
+			Page:  page,
+			Total: total,
+		})
+	}
+
+	// sorting
+	if queryParam.Get("sort") != "" {
+		fetchOptions["sort"] = queryParam.Get("sort")
+	}
+	if queryParam.Get("dir") != "" {
+		fetchOptions["dir"] = queryParam.Get("dir")
+	}
+
+	// fetch list
+	cur, err := u.mongoDbRepo.FetchListRefund(ctx, fetchOptions)
+	if err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+	defer cur.Close(ctx)
+
+	var list []interface{}
+	for cur.Next(ctx) {
+		row := mongo_model.Refund{}
+		err = cur.Decode(&row)
+		if err != nil {
+			logrus.Error("Refund Decode:", err)
+			return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+		}
+
+		list = append(list, row.Format())
+	}
+
+	return helpers.NewResponse(http.StatusOK, "Success", nil, helpers.PaginatedResponse{
+		Limit: limit,
+		Page:  page,
+		Total: total,
+		List:  list,
+	})
+}
+
+func (u *superadminAppUsecase) UpdateRefundStatus(ctx context.Context, claim jwt_helpers.SuperadminJWTClaims, id string, payload request.RefundStatusUpdateRequest) helpers.Response {
+	ctx, cancel := context.WithTimeout(ctx, u.contextTimeout)
+	defer cancel()
+
+	// validate payload
+	errValidation := make(map[string]string)
+	if payload.Status != mongo_model.RefundStatusProcessed && payload.Status != mongo_model.RefundStatusRejected {
+		errValidation["status"] = "Status must be processed or rejected"
+	}
+	if payload.Status == mongo_model.RefundStatusRejected && payload.Note == "" {
+		errValidation["note"] = "Note field is required when rejecting refund"
+	}
+	if len(errValidation) > 0 {
+		return helpers.NewResponse(http.StatusUnprocessableEntity, "Validation Error", errValidation, nil)
+	}
+
+	// check superadmin
+	superadmin, err := u.mongoDbRepo.FetchOneSuperadmin(ctx, map[string]interface{}{
+		"id": claim.UserID,
+	})
+	if err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+	if superadmin == nil {
+		return helpers.NewResponse(http.StatusBadRequest, "User not found", nil, nil)
+	}
+
+	// check refund
+	refund, err := u.mongoDbRepo.FetchOneRefund(ctx, map[string]interface{}{
+		"id": id,
+	})
+	if err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+	if refund == nil {
+		return helpers.NewResponse(http.StatusBadRequest, "Refund not found", nil, nil)
+	}
+	before := helpers.AuditSnapshot(refund)
+	if refund.Status != mongo_model.RefundStatusPending {
+		return helpers.NewResponse(http.StatusBadRequest, "Refund is already "+mongo_model.RefundStatusMap[refund.Status].Name, nil, nil)
+	}
+
+	// update refund
+	now := time.Now()
+	refund.Status = payload.Status
+	refund.Note = payload.Note
+	refund.ProcessedBy = &mongo_model.ActorFK{
+		ID:   superadmin.ID.Hex(),
+		Name: superadmin.Name,
+		Role: mongo_model.ActorRoleSuperadmin,
+	}
+	refund.ProcessedAt = &now
+	refund.UpdatedAt = now
+
+	err = u.mongoDbRepo.UpdatePartialRefund(ctx, map[string]interface{}{
+		"id": refund.ID,
+	}, map[string]interface{}{
+		"status":      refund.Status,
+		"note":        refund.Note,
+		"processedBy": refund.ProcessedBy,
+		"processedAt": refund.ProcessedAt,
+		"updatedAt":   refund.UpdatedAt,
+	})
+	if err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+	u.recordAuditLog(ctx, mongo_model.AuditActionUpdateStatus, mongo_model.AuditEntityRefund, refund.ID.Hex(), before, refund)
+
+	return helpers.NewResponse(http.StatusOK, "Success", nil, refund.Format())
+}
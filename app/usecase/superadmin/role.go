@@ -0,0 +1,335 @@
+package superadmin_usecase
+
+import (
+	shared_usecase "app/app/usecase/shared"
+	mongo_model "app/domain/model/mongo"
+	"app/domain/request"
+	"app/helpers"
+	jwt_helpers "app/helpers/jwt"
+	"context"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func (u *superadminAppUsecase) GetPermissionsList(ctx context.Context) helpers.Response {
+	return helpers.NewResponse(http.StatusOK, "Success", nil, mongo_model.PermissionList)
+}
+
+func (u *superadminAppUsecase) GetRolesList(ctx context.Context, queryParam url.Values) helpers.Response {
+	ctx, cancel := context.WithTimeout(ctx, u.contextTimeout)
+	defer cancel()
+
+	// get limit offset
+	page, offset, limit := helpers.GetOffsetLimit(queryParam)
+
+	fetchOptions := map[string]interface{}{
+		"limit":  limit,
+		"offset": offset,
+	}
+
+	// filtering
+	if queryParam.Get("search") != "" {
+		fetchOptions["search"] = queryParam.Get("search")
+	}
+
+	// count total
+	total := u.mongoDbRepo.CountRole(ctx, fetchOptions)
+	if total == 0 {
+		return helpers.NewResponse(http.StatusOK, "Success", nil, helpers.PaginatedResponse{
+			List:  []interface{}{},
+			Limit: limit,
+			Page:  page,
+			Total: total,
+		})
+	}
+
+	// sorting
+	if queryParam.Get("sort") != "" {
+		fetchOptions["sort"] = queryParam.Get("sort")
+	}
+	if queryParam.Get("dir") != "" {
+		fetchOptions["dir"] = queryParam.Get("dir")
+	}
+
+	// fetch data
+	cur, err := u.mongoDbRepo.FetchListRole(ctx, fetchOptions)
+	if err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+	defer cur.Close(ctx)
+
+	var list []interface{}
+	for cur.Next(ctx) {
+		row := mongo_model.Role{}
+		err := cur.Decode(&row)
+		if err != nil {
+			logrus.Error("GetListRole Decode:", err)
+			return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+		}
+
+		list = append(list, row)
+	}
+
+	return helpers.NewResponse(http.StatusOK, "Success", nil, helpers.PaginatedResponse{
+		Limit: limit,
+		Page:  page,
+		Total: total,
+		List:  list,
+	})
+}
+
+func (u *superadminAppUsecase) GetRoleDetail(ctx context.Context, id string) helpers.Response {
+	ctx, cancel := context.WithTimeout(ctx, u.contextTimeout)
+	defer cancel()
+
+	role, err := u.mongoDbRepo.FetchOneRole(ctx, map[string]interface{}{
+		"id": id,
+	})
+	if err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+	if role == nil {
+		return helpers.NewResponse(http.StatusBadRequest, "Role not found", nil, nil)
+	}
+
+	return helpers.NewResponse(http.StatusOK, "Success", nil, role)
+}
+
+func (u *superadminAppUsecase) CreateRole(ctx context.Context, claim jwt_helpers.SuperadminJWTClaims, payload request.RoleRequest) helpers.Response {
+	ctx, cancel := context.WithTimeout(ctx, u.contextTimeout)
+	defer cancel()
+
+	// validate payload
+	errValidation, permissions, err := u.validateRolePayload(ctx, "", payload)
+	if err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+	if len(errValidation) > 0 {
+		return helpers.NewResponse(http.StatusUnprocessableEntity, "Validation Error", errValidation, nil)
+	}
+
+	// a role can not grant more than the caller has
+	caller, callerPermissions, err := u.fetchCallerPermissions(ctx, claim)
+	if err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+	if caller == nil {
+		return helpers.NewResponse(http.StatusBadRequest, "User not found", nil, nil)
+	}
+	if !mongo_model.HasEveryPermission(callerPermissions, permissions) {
+		return helpers.NewResponse(http.StatusBadRequest, "Can not grant permissions you do not have", nil, nil)
+	}
+
+	// create role
+	now := time.Now()
+	role := mongo_model.Role{
+		ID:          primitive.NewObjectID(),
+		Name:        payload.Name,
+		Description: payload.Description,
+		Permissions: permissions,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+
+	// save
+	if err := u.mongoDbRepo.CreateOneRole(ctx, &role); err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+	u.recordAuditLog(ctx, mongo_model.AuditActionCreate, mongo_model.AuditEntityRole, role.ID.Hex(), nil, role)
+
+	return helpers.NewResponse(http.StatusCreated, "Create role success", nil, role)
+}
+
+func (u *superadminAppUsecase) UpdateRole(ctx context.Context, claim jwt_helpers.SuperadminJWTClaims, id string, payload request.RoleRequest) helpers.Response {
+	ctx, cancel := context.WithTimeout(ctx, u.contextTimeout)
+	defer cancel()
+
+	// get role
+	role, err := u.mongoDbRepo.FetchOneRole(ctx, map[string]interface{}{
+		"id": id,
+	})
+	if err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+	if role == nil {
+		return helpers.NewResponse(http.StatusBadRequest, "Role not found", nil, nil)
+	}
+	before := helpers.AuditSnapshot(role)
+
+	// validate payload
+	errValidation, permissions, err := u.validateRolePayload(ctx, id, payload)
+	if err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+	if len(errValidation) > 0 {
+		return helpers.NewResponse(http.StatusUnprocessableEntity, "Validation Error", errValidation, nil)
+	}
+
+	// a role can not be edited by its own holders, nor grant or keep more than the caller has
+	caller, callerPermissions, err := u.fetchCallerPermissions(ctx, claim)
+	if err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+	if caller == nil {
+		return helpers.NewResponse(http.StatusBadRequest, "User not found", nil, nil)
+	}
+	if caller.Role != nil && caller.Role.ID == role.ID.Hex() {
+		return helpers.NewResponse(http.StatusBadRequest, "Can not change your own role", nil, nil)
+	}
+	if !mongo_model.HasEveryPermission(callerPermissions, role.Permissions) || !mongo_model.HasEveryPermission(callerPermissions, permissions) {
+		return helpers.NewResponse(http.StatusBadRequest, "Can not grant permissions you do not have", nil, nil)
+	}
+
+	// update role
+	now := time.Now()
+	role.Name = payload.Name
+	role.Description = payload.Description
+	role.Permissions = permissions
+	role.UpdatedAt = now
+
+	// save
+	if err := u.mongoDbRepo.UpdatePartialRole(ctx, map[string]interface{}{
+		"id": role.ID,
+	}, map[string]interface{}{
+		"name":        role.Name,
+		"description": role.Description,
+		"permissions": role.Permissions,
+		"updatedAt":   role.UpdatedAt,
+	}); err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+
+	// sync role name on users and end their sessions, they log in again with the new permissions
+	cur, err := u.mongoDbRepo.FetchListSuperadmin(ctx, map[string]interface{}{
+		"roleId": role.ID.Hex(),
+	})
+	if err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+	defer cur.Close(ctx)
+
+	for cur.Next(ctx) {
+		var superadmin mongo_model.Superadmin
+		if err := cur.Decode(&superadmin); err != nil {
+			logrus.Error("Superadmin Decode:", err)
+			return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+		}
+
+		if _, err := u.mongoDbRepo.UpdatePartialSuperadminBumpTokenVersion(ctx, map[string]interface{}{
+			"id": superadmin.ID,
+		}, map[string]interface{}{
+			"role.name": role.Name,
+			"updatedAt": now,
+		}); err != nil {
+			return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+		}
+		if err := shared_usecase.RevokeAuthSessions(ctx, u.mongoDbRepo, mongo_model.ActorRoleSuperadmin, superadmin.ID.Hex(), now); err != nil {
+			return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+		}
+	}
+	u.recordAuditLog(ctx, mongo_model.AuditActionUpdate, mongo_model.AuditEntityRole, role.ID.Hex(), before, role)
+
+	return helpers.NewResponse(http.StatusOK, "Update role success", nil, role)
+}
+
+func (u *superadminAppUsecase) DeleteRole(ctx context.Context, id string) helpers.Response {
+	ctx, cancel := context.WithTimeout(ctx, u.contextTimeout)
+	defer cancel()
+
+	// get role
+	role, err := u.mongoDbRepo.FetchOneRole(ctx, map[string]interface{}{
+		"id": id,
+	})
+	if err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+	if role == nil {
+		return helpers.NewResponse(http.StatusBadRequest, "Role not found", nil, nil)
+	}
+	before := helpers.AuditSnapshot(role)
+
+	// check role used
+	totalUser := u.mongoDbRepo.CountSuperadmin(ctx, map[string]interface{}{
+		"roleId": role.ID.Hex(),
+	})
+	if totalUser > 0 {
+		return helpers.NewResponse(http.StatusBadRequest, "Role is still assigned to users", nil, nil)
+	}
+
+	// delete role
+	now := time.Now()
+	role.DeletedAt = &now
+
+	// save
+	if err := u.mongoDbRepo.UpdatePartialRole(ctx, map[string]interface{}{
+		"id": role.ID,
+	}, map[string]interface{}{
+		"deletedAt": role.DeletedAt,
+	}); err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+	u.recordAuditLog(ctx, mongo_model.AuditActionDelete, mongo_model.AuditEntityRole, role.ID.Hex(), before, role)
+
+	return helpers.NewResponse(http.StatusOK, "Delete role success", nil, nil)
+}
+
+// fetchCallerPermissions returns the calling superadmin with the permissions of its current role,
+// read from the database since the token may still carry the permissions before a role change
+func (u *superadminAppUsecase) fetchCallerPermissions(ctx context.Context, claim jwt_helpers.SuperadminJWTClaims) (*mongo_model.Superadmin, []string, error) {
+	caller, err := u.mongoDbRepo.FetchOneSuperadmin(ctx, map[string]interface{}{
+		"id": claim.UserID,
+	})
+	if err != nil || caller == nil {
+		return nil, nil, err
+	}
+
+	permissions, err := u.superadminPermissions(ctx, caller)
+	if err != nil {
+		return nil, nil, err
+	}
+	return caller, permissions, nil
+}
+
+// validateRolePayload validate create and update role payload, id is empty on create
+func (u *superadminAppUsecase) validateRolePayload(ctx context.Context, id string, payload request.RoleRequest) (map[string]string, []mongo_model.Permission, error) {
+	errValidation := make(map[string]string)
+	if payload.Name == "" {
+		errValidation["name"] = "Name field is required"
+	} else {
+		options := map[string]interface{}{
+			"name": payload.Name,
+		}
+		if id != "" {
+			options["excludeId"] = id
+		}
+		existing, err := u.mongoDbRepo.FetchOneRole(ctx, options)
+		if err != nil {
+			return nil, nil, err
+		}
+		if existing != nil {
+			errValidation["name"] = "Name already used"
+		}
+	}
+
+	permissions := make([]mongo_model.Permission, 0, len(payload.Permissions))
+	seen := make(map[string]bool)
+	if len(payload.Permissions) == 0 {
+		errValidation["permissions"] = "Permissions field is required"
+	}
+	for _, permission := range payload.Permissions {
+		if !mongo_model.IsValidPermission(permission) {
+			errValidation["permissions"] = "Invalid permission " + permission
+			break
+		}
+		if !seen[permission] {
+			seen[permission] = true
+			permissions = append(permissions, mongo_model.Permission(permission))
+		}
+	}
+
+	return errValidation, permissions, nil
+}
@@ -227,6 +227,7 @@ func (u *superadminAppUsecase) CreateSeason(ctx context.Context, payload request
 	if err != nil {
 		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
 	}
+	u.recordAuditLog(ctx, mongo_model.AuditActionCreate, mongo_model.AuditEntitySeason, season.ID.Hex(), nil, season)
 
 	return helpers.NewResponse(http.StatusCreated, "Create season success", nil, season.Format())
 }
@@ -254,6 +255,7 @@ func (u *superadminAppUsecase) UpdateSeason(ctx context.Context, id string, payl
 	if season == nil {
 		return helpers.NewResponse(http.StatusBadRequest, "Season not found", nil, nil)
 	}
+	before := helpers.AuditSnapshot(season)
 
 	now := time.Now()
 	year, month, _ := now.Date()
@@ -366,6 +368,7 @@ func (u *superadminAppUsecase) UpdateSeason(ctx context.Context, id string, payl
 	if err != nil {
 		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
 	}
+	u.recordAuditLog(ctx, mongo_model.AuditActionUpdate, mongo_model.AuditEntitySeason, season.ID.Hex(), before, season)
 
 	return helpers.NewResponse(http.StatusOK, "Update season success", nil, season.Format())
 }
@@ -384,6 +387,7 @@ func (u *superadminAppUsecase) DeleteSeason(ctx context.Context, id string) help
 	if season == nil {
 		return helpers.NewResponse(http.StatusBadRequest, "Season not found", nil, nil)
 	}
+	before := helpers.AuditSnapshot(season)
 
 	// check if season active
 	if season.Status == mongo_model.SeasonStatusActive {
@@ -410,6 +414,7 @@ func (u *superadminAppUsecase) DeleteSeason(ctx context.Context, id string) help
 	if err != nil {
 		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
 	}
+	u.recordAuditLog(ctx, mongo_model.AuditActionDelete, mongo_model.AuditEntitySeason, season.ID.Hex(), before, season)
 
 	return helpers.NewResponse(http.StatusOK, "Delete season success", nil, nil)
 }
@@ -437,6 +442,7 @@ func (u *superadminAppUsecase) UpdateSeasonStatus(ctx context.Context, id string
 	if season == nil {
 		return helpers.NewResponse(http.StatusBadRequest, "Season not found", nil, nil)
 	}
+	before := helpers.AuditSnapshot(season)
 
 	// get active season
 	activeSeason, err := u.mongoDbRepo.FetchOneSeason(ctx, map[string]interface{}{
@@ -464,6 +470,7 @@ func (u *superadminAppUsecase) UpdateSeasonStatus(ctx context.Context, id string
 	if err != nil {
 		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
 	}
+	u.recordAuditLog(ctx, mongo_model.AuditActionUpdateStatus, mongo_model.AuditEntitySeason, season.ID.Hex(), before, season)
 
 	return helpers.NewResponse(http.StatusOK, "Update season status success", nil, season.Format())
 }
@@ -0,0 +1,214 @@
+package superadmin_usecase
+
+import (
+	shared_usecase "app/app/usecase/shared"
+	mongo_model "app/domain/model/mongo"
+	"app/domain/request"
+	"app/helpers"
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+func (u *superadminAppUsecase) UpdateSeasonPass(ctx context.Context, id string, payload request.SeasonPassUpdateRequest) helpers.Response {
+	ctx, cancel := context.WithTimeout(ctx, u.contextTimeout)
+	defer cancel()
+
+	// validate payload
+	errValidation := make(map[string]string)
+	if payload.IsEnabled == nil {
+		errValidation["isEnabled"] = "Is enabled field is required"
+	}
+	if payload.Price < 0 {
+		errValidation["price"] = "Price can not be negative"
+	}
+	if payload.Stock < 0 {
+		errValidation["stock"] = "Stock can not be negative"
+	}
+	if len(errValidation) > 0 {
+		return helpers.NewResponse(http.StatusUnprocessableEntity, "Validation Error", errValidation, nil)
+	}
+
+	// get season
+	season, err := u.mongoDbRepo.FetchOneSeason(ctx, map[string]interface{}{
+		"id": id,
+	})
+	if err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+	if season == nil {
+		return helpers.NewResponse(http.StatusBadRequest, "Season not found", nil, nil)
+	}
+	before := helpers.AuditSnapshot(season)
+
+	// stock can not be lower than sold pass
+	if payload.Stock < season.Pass.Quota.Used {
+		return helpers.NewResponse(http.StatusBadRequest, "Stock can not be lower than sold season pass", nil, nil)
+	}
+
+	// update season pass
+	now := time.Now()
+	season.Pass.IsEnabled = *payload.IsEnabled
+	season.Pass.Price = payload.Price
+	season.Pass.Quota.Stock = payload.Stock
+	season.UpdatedAt = now
+
+	err = u.mongoDbRepo.UpdatePartialSeason(ctx, map[string]interface{}{
+		"id": season.ID,
+	}, map[string]interface{}{
+		"pass.isEnabled":   season.Pass.IsEnabled,
+		"pass.price":       season.Pass.Price,
+		"pass.quota.stock": season.Pass.Quota.Stock,
+		"updatedAt":        season.UpdatedAt,
+	})
+	if err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+	u.recordAuditLog(ctx, mongo_model.AuditActionUpdate, mongo_model.AuditEntitySeason, season.ID.Hex(), before, season)
+
+	return helpers.NewResponse(http.StatusOK, "Update season pass success", nil, season.Format())
+}
+
+// syncSeasonPassTickets reserves quota of every ticket day of an active series for
+// pending and paid season pass purchases, and issues ticket purchases for the paid ones.
+func (u *superadminAppUsecase) syncSeasonPassTickets(ctx context.Context, seriesId string) {
+	ctx, cancel := context.WithTimeout(ctx, u.contextTimeout)
+	defer cancel()
+
+	// check series
+	series, err := u.mongoDbRepo.FetchOneSeries(ctx, map[string]interface{}{
+		"id": seriesId,
+	})
+	if err != nil {
+		logrus.Error("syncSeasonPassTickets FetchOneSeries:", err)
+		return
+	}
+	if series == nil || series.Status != mongo_model.SeriesStatusActive {
+		return
+	}
+
+	// fetch tickets of series
+	ticketCur, err := u.mongoDbRepo.FetchListTicket(ctx, map[string]interface{}{
+		"seriesId":    seriesId,
+		"isCancelled": false,
+	})
+	if err != nil {
+		logrus.Error("syncSeasonPassTickets FetchListTicket:", err)
+		return
+	}
+	defer ticketCur.Close(ctx)
+
+	var tickets []mongo_model.Ticket
+	for ticketCur.Next(ctx) {
+		row := mongo_model.Ticket{}
+		if err := ticketCur.Decode(&row); err != nil {
+			logrus.Error("Ticket Decode:", err)
+			return
+		}
+		tickets = append(tickets, row)
+	}
+	if len(tickets) == 0 {
+		return
+	}
+
+	// fetch season pass purchases of season
+	purchaseCur, err := u.mongoDbRepo.FetchListPurchase(ctx, map[string]interface{}{
+		"seasonId":     series.SeasonID,
+		"isSeasonPass": true,
+		"statuses": []mongo_model.PurchaseStatus{
+			mongo_model.PurchaseStatusPaid,
+			mongo_model.PurchaseStatusPending,
+		},
+	})
+	if err != nil {
+		logrus.Error("syncSeasonPassTickets FetchListPurchase:", err)
+		return
+	}
+	defer purchaseCur.Close(ctx)
+
+	var purchases []mongo_model.Purchase
+	for purchaseCur.Next(ctx) {
+		row := mongo_model.Purchase{}
+		if err := purchaseCur.Decode(&row); err != nil {
+			logrus.Error("Purchase Decode:", err)
+			return
+		}
+		purchases = append(purchases, row)
+	}
+	if len(purchases) == 0 {
+		return
+	}
+
+	// get venue of each ticket day
+	venueIds := helpers.ExtractIds(tickets, func(m mongo_model.Ticket) string {
+		return m.GetVenueID()
+	})
+	venueMap, err := shared_usecase.FetchVenueMap(ctx, u.mongoDbRepo, venueIds)
+	if err != nil {
+		logrus.Error("syncSeasonPassTickets fetchVenueMap:", err)
+		return
+	}
+
+	now := time.Now()
+	for _, purchase := range purchases {
+		// find ticket days not yet covered by this purchase
+		existingTicketIds := make(map[string]struct{})
+		for _, ticket := range purchase.Tickets {
+			existingTicketIds[ticket.ID] = struct{}{}
+		}
+
+		var paidPurchase *mongo_model.Purchase
+		var issueTicketsFK []mongo_model.TicketFK
+		for _, ticket := range tickets {
+			if _, ok := existingTicketIds[ticket.ID.Hex()]; ok {
+				continue
+			}
+			ticketFK := mongo_model.TicketFK{
+				ID:      ticket.ID.Hex(),
+				Name:    ticket.Name,
+				Date:    ticket.Date,
+				VenueID: ticket.GetVenueID(),
+			}
+
+			// reserve quota first, a sold out ticket day is not added to the season pass
+			reserved, err := u.mongoDbRepo.ReserveTicketQuota(ctx, ticketFK.ID, purchase.Amount)
+			if err != nil {
+				continue
+			}
+			if !reserved {
+				logrus.Error("syncSeasonPassTickets ticket " + ticketFK.ID + " sold out for purchase " + purchase.ID.Hex())
+				continue
+			}
+
+			// add ticket day to purchase, only one sync can add it
+			updatedPurchase, err := u.mongoDbRepo.AddPurchaseTicket(ctx, purchase.ID.Hex(), ticketFK, now)
+			if err != nil || updatedPurchase == nil {
+				// already added or no longer pending nor paid, release the reserved quota
+				err := u.mongoDbRepo.IncrementOneTicket(ctx, ticketFK.ID, map[string]int64{
+					"quota.used": purchase.Amount * -1,
+				})
+				if err != nil {
+					logrus.Error("IncrementOneTicket:", err)
+				}
+				continue
+			}
+
+			// pending purchase will get the codes once paid, the webhook reads the ticket days after marking it paid
+			if updatedPurchase.Status == mongo_model.PurchaseStatusPaid {
+				paidPurchase = updatedPurchase
+				issueTicketsFK = append(issueTicketsFK, ticketFK)
+			}
+		}
+		if len(issueTicketsFK) == 0 {
+			continue
+		}
+
+		// issue ticket purchases
+		_, err := shared_usecase.IssueTicketPurchases(ctx, u.mongoDbRepo, paidPurchase, issueTicketsFK, venueMap)
+		if err != nil {
+			logrus.Error("syncSeasonPassTickets IssueTicketPurchases:", err)
+		}
+	}
+}
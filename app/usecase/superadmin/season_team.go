@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"net/http"
 	"net/url"
+	"strings"
 	"time"
 
 	"github.com/sirupsen/logrus"
@@ -254,10 +255,59 @@ func (u *superadminAppUsecase) CreateSeasonTeam(ctx context.Context, payload req
 	if err != nil {
 		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
 	}
+	for _, seasonTeam := range seasonTeams {
+		u.recordAuditLog(ctx, mongo_model.AuditActionCreate, mongo_model.AuditEntitySeasonTeam, seasonTeam.ID.Hex(), nil, seasonTeam)
+	}
+
+	// add the teams to the standings in bg
+	go u.refreshStandings(context.Background(), activeSeason.ID.Hex())
 
 	return helpers.NewResponse(http.StatusCreated, "Success", nil, seasonTeams)
 }
 
+func (u *superadminAppUsecase) UpdateSeasonTeamGroup(ctx context.Context, id string, payload request.SeasonTeamGroupUpdateRequest) helpers.Response {
+	ctx, cancel := context.WithTimeout(ctx, u.contextTimeout)
+	defer cancel()
+
+	// validate payload
+	payload.Group = strings.TrimSpace(payload.Group)
+	if len(payload.Group) > 50 {
+		return helpers.NewResponse(http.StatusUnprocessableEntity, "Validation Error", map[string]string{
+			"group": "Group must be at most 50 characters",
+		}, nil)
+	}
+
+	seasonTeam, err := u.mongoDbRepo.FetchOneSeasonTeam(ctx, map[string]interface{}{
+		"id": id,
+	})
+	if err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+	if seasonTeam == nil {
+		return helpers.NewResponse(http.StatusBadRequest, "SeasonTeam not found", nil, nil)
+	}
+	before := helpers.AuditSnapshot(seasonTeam)
+
+	seasonTeam.Group = payload.Group
+	seasonTeam.UpdatedAt = time.Now()
+
+	err = u.mongoDbRepo.UpdatePartialSeasonTeam(ctx, map[string]interface{}{
+		"id": seasonTeam.ID,
+	}, map[string]interface{}{
+		"group":     seasonTeam.Group,
+		"updatedAt": seasonTeam.UpdatedAt,
+	})
+	if err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+	u.recordAuditLog(ctx, mongo_model.AuditActionUpdate, mongo_model.AuditEntitySeasonTeam, seasonTeam.ID.Hex(), before, seasonTeam)
+
+	// group tables changed
+	go u.refreshStandings(context.Background(), seasonTeam.SeasonID)
+
+	return helpers.NewResponse(http.StatusOK, "Success", nil, seasonTeam)
+}
+
 func (u *superadminAppUsecase) DeleteSeasonTeam(ctx context.Context, id string) helpers.Response {
 	ctx, cancel := context.WithTimeout(ctx, u.contextTimeout)
 	defer cancel()
@@ -271,6 +321,7 @@ func (u *superadminAppUsecase) DeleteSeasonTeam(ctx context.Context, id string)
 	if seasonTeam == nil {
 		return helpers.NewResponse(http.StatusBadRequest, "SeasonTeam not found", nil, nil)
 	}
+	before := helpers.AuditSnapshot(seasonTeam)
 
 	now := time.Now()
 	seasonTeam.DeletedAt = &now
@@ -323,6 +374,10 @@ func (u *superadminAppUsecase) DeleteSeasonTeam(ctx context.Context, id string)
 
 		u.markMediaAsUnusedByIds(ctx, mediaIds)
 	}()
+	u.recordAuditLog(ctx, mongo_model.AuditActionDelete, mongo_model.AuditEntitySeasonTeam, seasonTeam.ID.Hex(), before, seasonTeam)
+
+	// remove the team from the standings in bg
+	go u.refreshStandings(context.Background(), seasonTeam.SeasonID)
 
 	return helpers.NewResponse(http.StatusOK, "Success", nil, nil)
 }
@@ -426,6 +481,9 @@ func (u *superadminAppUsecase) ManageSeasonTeam(ctx context.Context, payload req
 		if err != nil {
 			return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
 		}
+		for _, seasonTeam := range seasonTeams {
+			u.recordAuditLog(ctx, mongo_model.AuditActionCreate, mongo_model.AuditEntitySeasonTeam, seasonTeam.ID.Hex(), nil, seasonTeam)
+		}
 	}
 
 	if len(payload.RemovedTeamIds) != 0 {
@@ -439,6 +497,7 @@ func (u *superadminAppUsecase) ManageSeasonTeam(ctx context.Context, payload req
 		}
 		defer existing.Close(ctx)
 		var listIds []string
+		var removedSeasonTeams []mongo_model.SeasonTeam
 		for existing.Next(ctx) {
 			var seasonTeam mongo_model.SeasonTeam
 			if err := existing.Decode(&seasonTeam); err != nil {
@@ -446,6 +505,7 @@ func (u *superadminAppUsecase) ManageSeasonTeam(ctx context.Context, payload req
 				return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
 			}
 			listIds = append(listIds, seasonTeam.ID.Hex())
+			removedSeasonTeams = append(removedSeasonTeams, seasonTeam)
 		}
 
 		// return error not found
@@ -463,6 +523,10 @@ func (u *superadminAppUsecase) ManageSeasonTeam(ctx context.Context, payload req
 		if err != nil {
 			return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
 		}
+		for _, seasonTeam := range removedSeasonTeams {
+			before := helpers.AuditSnapshot(seasonTeam)
+			u.recordAuditLog(ctx, mongo_model.AuditActionDelete, mongo_model.AuditEntitySeasonTeam, seasonTeam.ID.Hex(), before, helpers.AuditApply(before, map[string]interface{}{"deletedAt": now}))
+		}
 
 		// delete related season team player in bg
 		go func() {
@@ -505,6 +569,9 @@ func (u *superadminAppUsecase) ManageSeasonTeam(ctx context.Context, payload req
 		}()
 	}
 
+	// update the teams of the standings in bg
+	go u.refreshStandings(context.Background(), activeSeason.ID.Hex())
+
 	return helpers.NewResponse(http.StatusOK, "Success", nil, map[string]interface{}{
 		"addedTeamIds":   payload.AddedTeamIds,
 		"removedTeamIds": payload.RemovedTeamIds,
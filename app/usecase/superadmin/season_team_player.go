@@ -294,6 +294,7 @@ func (u *superadminAppUsecase) CreateSeasonTeamPlayer(ctx context.Context, paylo
 	if err != nil {
 		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
 	}
+	u.recordAuditLog(ctx, mongo_model.AuditActionCreate, mongo_model.AuditEntitySeasonTeamPlayer, seasonTeamPlayer.ID.Hex(), nil, seasonTeamPlayer)
 
 	return helpers.NewResponse(http.StatusCreated, "Success", nil, seasonTeamPlayer)
 }
@@ -325,6 +326,7 @@ func (u *superadminAppUsecase) UpdateSeasonTeamPlayer(ctx context.Context, id st
 	if seasonTeamPlayer == nil {
 		return helpers.NewResponse(http.StatusBadRequest, "Season team player not found", nil, nil)
 	}
+	before := helpers.AuditSnapshot(seasonTeamPlayer)
 
 	now := time.Now()
 	year, month, _ := now.Date()
@@ -391,6 +393,7 @@ func (u *superadminAppUsecase) UpdateSeasonTeamPlayer(ctx context.Context, id st
 	if err != nil {
 		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
 	}
+	u.recordAuditLog(ctx, mongo_model.AuditActionUpdate, mongo_model.AuditEntitySeasonTeamPlayer, seasonTeamPlayer.ID.Hex(), before, seasonTeamPlayer)
 
 	return helpers.NewResponse(http.StatusOK, "Success", nil, seasonTeamPlayer)
 }
@@ -409,6 +412,7 @@ func (u *superadminAppUsecase) DeleteSeasonTeamPlayer(ctx context.Context, id st
 	if seasonTeamPlayer == nil {
 		return helpers.NewResponse(http.StatusBadRequest, "Season team player not found", nil, nil)
 	}
+	before := helpers.AuditSnapshot(seasonTeamPlayer)
 
 	// delete season team player
 	now := time.Now()
@@ -421,6 +425,7 @@ func (u *superadminAppUsecase) DeleteSeasonTeamPlayer(ctx context.Context, id st
 	if err != nil {
 		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
 	}
+	u.recordAuditLog(ctx, mongo_model.AuditActionDelete, mongo_model.AuditEntitySeasonTeamPlayer, seasonTeamPlayer.ID.Hex(), before, seasonTeamPlayer)
 
 	return helpers.NewResponse(http.StatusOK, "Success", nil, nil)
 }
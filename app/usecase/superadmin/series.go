@@ -251,6 +251,21 @@ func (u *superadminAppUsecase) CreateSeries(ctx context.Context, payload request
 			errValidation["endDate"] = "End date format is invalid"
 		}
 	}
+	publishAt, err := parseOptionalTime(payload.PublishAt)
+	if err != nil {
+		errValidation["publishAt"] = "Publish at format is invalid"
+	}
+	saleStartAt, err := parseOptionalTime(payload.SaleStartAt)
+	if err != nil {
+		errValidation["saleStartAt"] = "Sale start at format is invalid"
+	}
+	saleEndAt, err := parseOptionalTime(payload.SaleEndAt)
+	if err != nil {
+		errValidation["saleEndAt"] = "Sale end at format is invalid"
+	}
+	if saleStartAt != nil && saleEndAt != nil && !saleStartAt.Before(*saleEndAt) {
+		errValidation["saleEndAt"] = "Sale end at must be after sale start at"
+	}
 	if len(errValidation) > 0 {
 		return helpers.NewResponse(http.StatusUnprocessableEntity, "Validation error", errValidation, nil)
 	}
@@ -293,18 +308,21 @@ func (u *superadminAppUsecase) CreateSeries(ctx context.Context, payload request
 
 	// create series
 	series := mongo_model.Series{
-		ID:        primitive.NewObjectID(),
-		SeasonID:  season.ID.Hex(),
-		Season:    mongo_model.SeasonFK{ID: season.ID.Hex(), Name: season.Name},
-		VenueID:   payload.VenueID,
-		Venue:     mongo_model.VenueFK{ID: payload.VenueID, Name: venue.Name},
-		Name:      payload.Name,
-		Price:     payload.Price,
-		StartDate: startDate,
-		EndDate:   endDate,
-		Status:    mongo_model.SeriesStatusDraft,
-		CreatedAt: now,
-		UpdatedAt: now,
+		ID:          primitive.NewObjectID(),
+		SeasonID:    season.ID.Hex(),
+		Season:      mongo_model.SeasonFK{ID: season.ID.Hex(), Name: season.Name},
+		VenueID:     payload.VenueID,
+		Venue:       mongo_model.VenueFK{ID: payload.VenueID, Name: venue.Name},
+		Name:        payload.Name,
+		Price:       payload.Price,
+		StartDate:   startDate,
+		EndDate:     endDate,
+		PublishAt:   publishAt,
+		SaleStartAt: saleStartAt,
+		SaleEndAt:   saleEndAt,
+		Status:      mongo_model.SeriesStatusDraft,
+		CreatedAt:   now,
+		UpdatedAt:   now,
 	}
 
 	// save
@@ -312,6 +330,7 @@ func (u *superadminAppUsecase) CreateSeries(ctx context.Context, payload request
 	if err != nil {
 		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
 	}
+	u.recordAuditLog(ctx, mongo_model.AuditActionCreate, mongo_model.AuditEntitySeries, series.ID.Hex(), nil, series)
 
 	return helpers.NewResponse(http.StatusCreated, "Success", nil, series.Format())
 }
@@ -320,6 +339,32 @@ func (u *superadminAppUsecase) UpdateSeries(ctx context.Context, id string, payl
 	ctx, cancel := context.WithTimeout(ctx, u.contextTimeout)
 	defer cancel()
 
+	// validate payload
+	errValidation := make(map[string]string)
+	var publishAt, saleStartAt, saleEndAt *time.Time
+	var err error
+	if payload.PublishAt != nil {
+		publishAt, err = parseOptionalTime(*payload.PublishAt)
+		if err != nil {
+			errValidation["publishAt"] = "Publish at format is invalid"
+		}
+	}
+	if payload.SaleStartAt != nil {
+		saleStartAt, err = parseOptionalTime(*payload.SaleStartAt)
+		if err != nil {
+			errValidation["saleStartAt"] = "Sale start at format is invalid"
+		}
+	}
+	if payload.SaleEndAt != nil {
+		saleEndAt, err = parseOptionalTime(*payload.SaleEndAt)
+		if err != nil {
+			errValidation["saleEndAt"] = "Sale end at format is invalid"
+		}
+	}
+	if len(errValidation) > 0 {
+		return helpers.NewResponse(http.StatusUnprocessableEntity, "Validation Error", errValidation, nil)
+	}
+
 	// check series
 	series, err := u.mongoDbRepo.FetchOneSeries(ctx, map[string]interface{}{
 		"id": id,
@@ -330,6 +375,7 @@ func (u *superadminAppUsecase) UpdateSeries(ctx context.Context, id string, payl
 	if series == nil {
 		return helpers.NewResponse(http.StatusBadRequest, "Series not found", nil, nil)
 	}
+	before := helpers.AuditSnapshot(series)
 
 	// update if not empty
 	if payload.Name != "" {
@@ -371,29 +417,54 @@ func (u *superadminAppUsecase) UpdateSeries(ctx context.Context, id string, payl
 	if payload.Status != nil {
 		series.Status = *payload.Status
 	}
+	if payload.PublishAt != nil {
+		series.PublishAt = publishAt
+	}
+	if payload.SaleStartAt != nil {
+		series.SaleStartAt = saleStartAt
+	}
+	if payload.SaleEndAt != nil {
+		series.SaleEndAt = saleEndAt
+	}
 
 	// validate date range
 	if series.StartDate.After(series.EndDate) || series.StartDate.Format("2006-01-02") == series.EndDate.Format("2006-01-02") {
 		return helpers.NewResponse(http.StatusBadRequest, "Start date must be before end date", nil, nil)
 	}
 
+	// validate sale window
+	if series.SaleStartAt != nil && series.SaleEndAt != nil && !series.SaleStartAt.Before(*series.SaleEndAt) {
+		return helpers.NewResponse(http.StatusUnprocessableEntity, "Validation Error", map[string]string{
+			"saleEndAt": "Sale end at must be after sale start at",
+		}, nil)
+	}
+
 	// update series
 	series.UpdatedAt = time.Now()
 	err = u.mongoDbRepo.UpdatePartialSeries(ctx, map[string]interface{}{
 		"id": id,
 	}, map[string]interface{}{
-		"name":      series.Name,
-		"venueId":   series.VenueID,
-		"price":     series.Price,
-		"startDate": series.StartDate,
-		"endDate":   series.EndDate,
-		"status":    series.Status,
-		"updatedAt": series.UpdatedAt,
+		"name":        series.Name,
+		"venueId":     series.VenueID,
+		"price":       series.Price,
+		"startDate":   series.StartDate,
+		"endDate":     series.EndDate,
+		"publishAt":   series.PublishAt,
+		"saleStartAt": series.SaleStartAt,
+		"saleEndAt":   series.SaleEndAt,
+		"status":      series.Status,
+		"updatedAt":   series.UpdatedAt,
 	})
 	if err != nil {
 		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
 	}
 
+	// issue ticket days of published series to season pass holders in bg
+	if series.Status == mongo_model.SeriesStatusActive {
+		go u.syncSeasonPassTickets(context.Background(), series.ID.Hex())
+	}
+	u.recordAuditLog(ctx, mongo_model.AuditActionUpdate, mongo_model.AuditEntitySeries, series.ID.Hex(), before, series)
+
 	return helpers.NewResponse(http.StatusOK, "Success", nil, series.Format())
 }
 
@@ -411,6 +482,7 @@ func (u *superadminAppUsecase) DeleteSeries(ctx context.Context, id string) help
 	if series == nil {
 		return helpers.NewResponse(http.StatusBadRequest, "Series not found", nil, nil)
 	}
+	before := helpers.AuditSnapshot(series)
 
 	// delete series
 	now := time.Now()
@@ -425,6 +497,7 @@ func (u *superadminAppUsecase) DeleteSeries(ctx context.Context, id string) help
 	if err != nil {
 		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
 	}
+	u.recordAuditLog(ctx, mongo_model.AuditActionDelete, mongo_model.AuditEntitySeries, series.ID.Hex(), before, series)
 
 	return helpers.NewResponse(http.StatusOK, "Success", nil, nil)
 }
@@ -471,3 +544,51 @@ func (u *superadminAppUsecase) updateSeriesMatchCount(ctx context.Context, id st
 		return
 	}
 }
+
+// PublishScheduledSeries moves draft series whose publish time has passed to active
+func (u *superadminAppUsecase) PublishScheduledSeries(ctx context.Context) {
+	ctx, cancel := context.WithTimeout(ctx, u.contextTimeout)
+	defer cancel()
+
+	now := time.Now()
+	cur, err := u.mongoDbRepo.FetchListSeries(ctx, map[string]interface{}{
+		"status":          mongo_model.SeriesStatusDraft,
+		"publishAtBefore": now,
+	})
+	if err != nil {
+		logrus.Error("PublishScheduledSeries FetchListSeries:", err)
+		return
+	}
+	defer cur.Close(ctx)
+
+	for cur.Next(ctx) {
+		var series mongo_model.Series
+		if err := cur.Decode(&series); err != nil {
+			logrus.Error("Series Decode:", err)
+			return
+		}
+
+		// every replica runs this, only the one flipping the status syncs the series
+		published, err := u.mongoDbRepo.PublishSeries(ctx, series.ID.Hex(), now)
+		if err != nil || !published {
+			continue
+		}
+
+		// issue ticket days of published series to season pass holders in bg
+		go u.syncSeasonPassTickets(context.Background(), series.ID.Hex())
+	}
+}
+
+// parseOptionalTime parses RFC3339 value, empty value returns nil
+func parseOptionalTime(value string) (*time.Time, error) {
+	if value == "" {
+		return nil, nil
+	}
+
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, err
+	}
+
+	return &t, nil
+}
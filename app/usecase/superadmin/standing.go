@@ -0,0 +1,170 @@
+package superadmin_usecase
+
+import (
+	shared_usecase "app/app/usecase/shared"
+	mongo_model "app/domain/model/mongo"
+	"app/domain/request"
+	"app/helpers"
+	"context"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+func (u *superadminAppUsecase) GetStandingsList(ctx context.Context, queryParam url.Values) helpers.Response {
+	ctx, cancel := context.WithTimeout(ctx, u.contextTimeout)
+	defer cancel()
+
+	// validate query
+	if queryParam.Get("seasonId") == "" {
+		return helpers.NewResponse(http.StatusUnprocessableEntity, "Validation Error", map[string]string{
+			"seasonId": "Season ID is required",
+		}, nil)
+	}
+
+	fetchOptions := map[string]interface{}{
+		"seasonId": queryParam.Get("seasonId"),
+		"sort":     "createdAt",
+		"dir":      "asc",
+	}
+
+	// filtering, the table of the whole season has an empty series id and group
+	if queryParam.Has("seriesId") {
+		fetchOptions["seriesId"] = queryParam.Get("seriesId")
+	}
+	if queryParam.Has("group") {
+		fetchOptions["group"] = queryParam.Get("group")
+	}
+
+	// fetch data
+	cur, err := u.mongoDbRepo.FetchListStanding(ctx, fetchOptions)
+	if err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+	defer cur.Close(ctx)
+
+	list := make([]interface{}, 0)
+	for cur.Next(ctx) {
+		row := mongo_model.Standing{}
+		err := cur.Decode(&row)
+		if err != nil {
+			logrus.Error("Standing Decode:", err)
+			return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+		}
+
+		list = append(list, row)
+	}
+
+	return helpers.NewResponse(http.StatusOK, "Success", nil, list)
+}
+
+func (u *superadminAppUsecase) RecomputeStandings(ctx context.Context, payload request.StandingRecomputeRequest) helpers.Response {
+	ctx, cancel := context.WithTimeout(ctx, u.contextTimeout)
+	defer cancel()
+
+	// validate payload
+	if payload.SeasonID == "" {
+		return helpers.NewResponse(http.StatusUnprocessableEntity, "Validation Error", map[string]string{
+			"seasonId": "Season ID field is required",
+		}, nil)
+	}
+
+	// check season
+	season, err := u.mongoDbRepo.FetchOneSeason(ctx, map[string]interface{}{
+		"id": payload.SeasonID,
+	})
+	if err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+	if season == nil {
+		return helpers.NewResponse(http.StatusBadRequest, "Season not found", nil, nil)
+	}
+
+	// recompute
+	if err := shared_usecase.RefreshStandings(ctx, u.mongoDbRepo, season.ID.Hex()); err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+
+	return helpers.NewResponse(http.StatusOK, "Recompute standings success", nil, nil)
+}
+
+func (u *superadminAppUsecase) UpdateSeasonStandingRule(ctx context.Context, id string, payload request.StandingRuleRequest) helpers.Response {
+	ctx, cancel := context.WithTimeout(ctx, u.contextTimeout)
+	defer cancel()
+
+	// validate payload
+	errValidation := make(map[string]string)
+	if payload.PointsWin < 0 || payload.PointsDraw < 0 || payload.PointsLoss < 0 {
+		errValidation["points"] = "Points can not be negative"
+	} else if payload.PointsWin < payload.PointsDraw || payload.PointsDraw < payload.PointsLoss {
+		errValidation["points"] = "Points of a win must be at least a draw and a draw at least a loss"
+	}
+	tieBreakers := make([]mongo_model.StandingTieBreaker, 0, len(payload.TieBreakers))
+	seen := make(map[string]struct{})
+	for _, tieBreaker := range payload.TieBreakers {
+		if !mongo_model.IsValidStandingTieBreaker(tieBreaker) {
+			errValidation["tieBreakers"] = "Invalid tie breaker " + tieBreaker
+			break
+		}
+		if _, ok := seen[tieBreaker]; ok {
+			errValidation["tieBreakers"] = "Duplicate tie breaker " + tieBreaker
+			break
+		}
+		seen[tieBreaker] = struct{}{}
+		tieBreakers = append(tieBreakers, mongo_model.StandingTieBreaker(tieBreaker))
+	}
+	if len(errValidation) > 0 {
+		return helpers.NewResponse(http.StatusUnprocessableEntity, "Validation Error", errValidation, nil)
+	}
+
+	// get season
+	season, err := u.mongoDbRepo.FetchOneSeason(ctx, map[string]interface{}{
+		"id": id,
+	})
+	if err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+	if season == nil {
+		return helpers.NewResponse(http.StatusBadRequest, "Season not found", nil, nil)
+	}
+	before := helpers.AuditSnapshot(season)
+
+	// update standing rule
+	season.StandingRule = &mongo_model.StandingRule{
+		PointsWin:   payload.PointsWin,
+		PointsDraw:  payload.PointsDraw,
+		PointsLoss:  payload.PointsLoss,
+		TieBreakers: tieBreakers,
+	}
+	season.UpdatedAt = time.Now()
+
+	err = u.mongoDbRepo.UpdatePartialSeason(ctx, map[string]interface{}{
+		"id": season.ID,
+	}, map[string]interface{}{
+		"standingRule": season.StandingRule,
+		"updatedAt":    season.UpdatedAt,
+	})
+	if err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+	u.recordAuditLog(ctx, mongo_model.AuditActionUpdate, mongo_model.AuditEntitySeason, season.ID.Hex(), before, season)
+
+	// rank again with the new rule
+	if err := shared_usecase.RefreshStandings(ctx, u.mongoDbRepo, season.ID.Hex()); err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+
+	return helpers.NewResponse(http.StatusOK, "Update season standing rule success", nil, season.Format())
+}
+
+// refreshStandings recompute the cached standings of a season after a result or its teams changed
+func (u *superadminAppUsecase) refreshStandings(ctx context.Context, seasonId string) {
+	ctx, cancel := context.WithTimeout(ctx, u.contextTimeout)
+	defer cancel()
+
+	if err := shared_usecase.RefreshStandings(ctx, u.mongoDbRepo, seasonId); err != nil {
+		logrus.Error("refreshStandings RefreshStandings:", err)
+	}
+}
@@ -0,0 +1,181 @@
+package superadmin_usecase
+
+import (
+	shared_usecase "app/app/usecase/shared"
+	mongo_model "app/domain/model/mongo"
+	"app/domain/request"
+	"app/helpers"
+	jwt_helpers "app/helpers/jwt"
+	"context"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+func (u *superadminAppUsecase) GetSuperadminsList(ctx context.Context, queryParam url.Values) helpers.Response {
+	ctx, cancel := context.WithTimeout(ctx, u.contextTimeout)
+	defer cancel()
+
+	// get limit offset
+	page, offset, limit := helpers.GetOffsetLimit(queryParam)
+
+	fetchOptions := map[string]interface{}{
+		"limit":  limit,
+		"offset": offset,
+	}
+
+	// filtering
+	if queryParam.Get("search") != "" {
+		fetchOptions["search"] = queryParam.Get("search")
+	}
+	if queryParam.Get("roleId") != "" {
+		fetchOptions["roleId"] = queryParam.Get("roleId")
+	}
+	if queryParam.Get("isOwner") != "" {
+		fetchOptions["isOwner"] = queryParam.Get("isOwner") == "true"
+	}
+
+	// count total
+	total := u.mongoDbRepo.CountSuperadmin(ctx, fetchOptions)
+	if total == 0 {
+		return helpers.NewResponse(http.StatusOK, "Success", nil, helpers.PaginatedResponse{
+			List:  []interface{}{},
+			Limit: limit,
+			Page:  page,
+			Total: total,
+		})
+	}
+
+	// sorting
+	if queryParam.Get("sort") != "" {
+		fetchOptions["sort"] = queryParam.Get("sort")
+	}
+	if queryParam.Get("dir") != "" {
+		fetchOptions["dir"] = queryParam.Get("dir")
+	}
+
+	// fetch data
+	cur, err := u.mongoDbRepo.FetchListSuperadmin(ctx, fetchOptions)
+	if err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+	defer cur.Close(ctx)
+
+	var list []interface{}
+	for cur.Next(ctx) {
+		row := mongo_model.Superadmin{}
+		err := cur.Decode(&row)
+		if err != nil {
+			logrus.Error("GetListSuperadmin Decode:", err)
+			return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+		}
+
+		list = append(list, row)
+	}
+
+	return helpers.NewResponse(http.StatusOK, "Success", nil, helpers.PaginatedResponse{
+		Limit: limit,
+		Page:  page,
+		Total: total,
+		List:  list,
+	})
+}
+
+func (u *superadminAppUsecase) UpdateSuperadminRole(ctx context.Context, claim jwt_helpers.SuperadminJWTClaims, id string, payload request.SuperadminRoleUpdateRequest) helpers.Response {
+	ctx, cancel := context.WithTimeout(ctx, u.contextTimeout)
+	defer cancel()
+
+	if id == claim.UserID {
+		return helpers.NewResponse(http.StatusBadRequest, "Can not change your own role", nil, nil)
+	}
+
+	// get superadmin
+	superadmin, err := u.mongoDbRepo.FetchOneSuperadmin(ctx, map[string]interface{}{
+		"id": id,
+	})
+	if err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+	if superadmin == nil {
+		return helpers.NewResponse(http.StatusBadRequest, "User not found", nil, nil)
+	}
+	before := helpers.AuditSnapshot(superadmin)
+
+	// the caller must hold every permission the user has now and will get
+	caller, callerPermissions, err := u.fetchCallerPermissions(ctx, claim)
+	if err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+	if caller == nil {
+		return helpers.NewResponse(http.StatusBadRequest, "User not found", nil, nil)
+	}
+	currentPermissions, err := u.superadminPermissions(ctx, superadmin)
+	if err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+	required := make([]mongo_model.Permission, 0, len(currentPermissions))
+	for _, permission := range currentPermissions {
+		required = append(required, mongo_model.Permission(permission))
+	}
+	if !mongo_model.HasEveryPermission(callerPermissions, required) {
+		return helpers.NewResponse(http.StatusBadRequest, "Can not change the role of a user with permissions you do not have", nil, nil)
+	}
+
+	// check role, empty means owner and only owners can grant it
+	var roleFK *mongo_model.RoleFK
+	if payload.RoleID == "" && caller.Role != nil {
+		return helpers.NewResponse(http.StatusBadRequest, "Only owners can grant the owner role", nil, nil)
+	}
+	if payload.RoleID != "" {
+		role, err := u.mongoDbRepo.FetchOneRole(ctx, map[string]interface{}{
+			"id": payload.RoleID,
+		})
+		if err != nil {
+			return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+		}
+		if role == nil {
+			return helpers.NewResponse(http.StatusUnprocessableEntity, "Validation Error", map[string]string{
+				"roleId": "Role not found",
+			}, nil)
+		}
+		if !mongo_model.HasEveryPermission(callerPermissions, role.Permissions) {
+			return helpers.NewResponse(http.StatusBadRequest, "Can not grant permissions you do not have", nil, nil)
+		}
+		roleFK = &mongo_model.RoleFK{
+			ID:   role.ID.Hex(),
+			Name: role.Name,
+		}
+	}
+
+	// keep at least one owner
+	if superadmin.Role == nil && roleFK != nil {
+		totalOwner := u.mongoDbRepo.CountSuperadmin(ctx, map[string]interface{}{
+			"isOwner":   true,
+			"excludeId": id,
+		})
+		if totalOwner == 0 {
+			return helpers.NewResponse(http.StatusBadRequest, "At least one owner is required", nil, nil)
+		}
+	}
+
+	// save and end the sessions, the user logs in again with the new permissions
+	now := time.Now()
+	superadmin.Role = roleFK
+	superadmin.UpdatedAt = now
+	if _, err := u.mongoDbRepo.UpdatePartialSuperadminBumpTokenVersion(ctx, map[string]interface{}{
+		"id": superadmin.ID,
+	}, map[string]interface{}{
+		"role":      superadmin.Role,
+		"updatedAt": superadmin.UpdatedAt,
+	}); err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+	if err := shared_usecase.RevokeAuthSessions(ctx, u.mongoDbRepo, mongo_model.ActorRoleSuperadmin, id, now); err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+	u.recordAuditLog(ctx, mongo_model.AuditActionUpdateRole, mongo_model.AuditEntitySuperadmin, superadmin.ID.Hex(), before, superadmin)
+
+	return helpers.NewResponse(http.StatusOK, "Update user role success", nil, superadmin)
+}
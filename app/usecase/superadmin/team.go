@@ -227,6 +227,7 @@ func (u *superadminAppUsecase) CreateTeam(ctx context.Context, payload request.T
 	if err != nil {
 		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
 	}
+	u.recordAuditLog(ctx, mongo_model.AuditActionCreate, mongo_model.AuditEntityTeam, team.ID.Hex(), nil, team)
 
 	return helpers.NewResponse(http.StatusCreated, "Create team success", nil, team)
 }
@@ -254,6 +255,7 @@ func (u *superadminAppUsecase) UpdateTeam(ctx context.Context, id string, payloa
 	if team == nil {
 		return helpers.NewResponse(http.StatusBadRequest, "Team not found", nil, nil)
 	}
+	before := helpers.AuditSnapshot(team)
 
 	now := time.Now()
 	year, month, _ := now.Date()
@@ -323,6 +325,7 @@ func (u *superadminAppUsecase) UpdateTeam(ctx context.Context, id string, payloa
 
 	// bg update active season team
 	go u.updateActiveSeasonTeamBackground(context.Background(), team.ID.Hex(), team)
+	u.recordAuditLog(ctx, mongo_model.AuditActionUpdate, mongo_model.AuditEntityTeam, team.ID.Hex(), before, team)
 
 	return helpers.NewResponse(http.StatusOK, "Update team success", nil, team)
 }
@@ -341,6 +344,7 @@ func (u *superadminAppUsecase) DeleteTeam(ctx context.Context, id string) helper
 	if team == nil {
 		return helpers.NewResponse(http.StatusBadRequest, "Team not found", nil, nil)
 	}
+	before := helpers.AuditSnapshot(team)
 
 	// check if team is in active season team
 	activeSeason, err := u.mongoDbRepo.FetchOneSeason(ctx, map[string]interface{}{
@@ -378,6 +382,7 @@ func (u *superadminAppUsecase) DeleteTeam(ctx context.Context, id string) helper
 	if err != nil {
 		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
 	}
+	u.recordAuditLog(ctx, mongo_model.AuditActionDelete, mongo_model.AuditEntityTeam, team.ID.Hex(), before, team)
 
 	return helpers.NewResponse(http.StatusOK, "Delete team success", nil, nil)
 }
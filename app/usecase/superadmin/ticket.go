@@ -1,6 +1,7 @@
 package superadmin_usecase
 
 import (
+	shared_usecase "app/app/usecase/shared"
 	mongo_model "app/domain/model/mongo"
 	"app/domain/request"
 	"app/helpers"
@@ -69,16 +70,26 @@ func (u *superadminAppUsecase) GetTicketsList(ctx context.Context, queryParam ur
 		tickets = append(tickets, row)
 	}
 
-	// check venue
-	venue, err := u.mongoDbRepo.FetchOneVenue(ctx, map[string]interface{}{
-		"id": tickets[0].Matchs[0].VenueID,
-	})
+	// check venue without duplicate
+	venueIdSet := make(map[string]struct{})
+	for _, ticket := range tickets {
+		venueIdSet[ticket.GetVenueID()] = struct{}{}
+		for _, match := range ticket.Matchs {
+			venueIdSet[match.VenueID] = struct{}{}
+		}
+	}
+
+	// set ids to slice
+	venueIds := make([]string, 0, len(venueIdSet))
+	for id := range venueIdSet {
+		venueIds = append(venueIds, id)
+	}
+
+	// fetch venue
+	venueMap, err := shared_usecase.FetchVenueMap(ctx, u.mongoDbRepo, venueIds)
 	if err != nil {
 		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
 	}
-	if venue == nil {
-		venue = &mongo_model.Venue{}
-	}
 
 	// check season team without duplicate
 	seasonTeamIdSet := make(map[string]struct{})
@@ -120,10 +131,16 @@ func (u *superadminAppUsecase) GetTicketsList(ctx context.Context, queryParam ur
 	// set list
 	var list []interface{}
 	for _, ticket := range tickets {
+		ticket.VenueID = ticket.GetVenueID()
+		ticket.Venue = mongo_model.VenueFK{
+			ID:   ticket.VenueID,
+			Name: venueMap[ticket.VenueID].Name,
+		}
+
 		for i := range ticket.Matchs {
 			ticket.Matchs[i].Venue = mongo_model.VenueFK{
-				ID:   venue.ID.Hex(),
-				Name: venue.Name,
+				ID:   ticket.Matchs[i].VenueID,
+				Name: venueMap[ticket.Matchs[i].VenueID].Name,
 			}
 
 			homeSeasonTeam, ok := seasonTeamMap[ticket.Matchs[i].HomeSeasonTeamID]
@@ -185,16 +202,23 @@ func (u *superadminAppUsecase) GetTicketDetail(ctx context.Context, id string) h
 		return helpers.NewResponse(http.StatusBadRequest, "Ticket not found", nil, nil)
 	}
 
-	// check venue
-	venue, err := u.mongoDbRepo.FetchOneVenue(ctx, map[string]interface{}{
-		"id": ticket.Matchs[0].VenueID,
-	})
+	// check venue without duplicate
+	venueIdSet := map[string]struct{}{ticket.GetVenueID(): {}}
+	for _, match := range ticket.Matchs {
+		venueIdSet[match.VenueID] = struct{}{}
+	}
+
+	// set ids to slice
+	venueIds := make([]string, 0, len(venueIdSet))
+	for id := range venueIdSet {
+		venueIds = append(venueIds, id)
+	}
+
+	// fetch venue
+	venueMap, err := shared_usecase.FetchVenueMap(ctx, u.mongoDbRepo, venueIds)
 	if err != nil {
 		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
 	}
-	if venue == nil {
-		venue = &mongo_model.Venue{}
-	}
 
 	// check season team without duplicate
 	seasonTeamIdSet := make(map[string]struct{})
@@ -232,10 +256,15 @@ func (u *superadminAppUsecase) GetTicketDetail(ctx context.Context, id string) h
 	}
 
 	// set detail ticket
+	ticket.VenueID = ticket.GetVenueID()
+	ticket.Venue = mongo_model.VenueFK{
+		ID:   ticket.VenueID,
+		Name: venueMap[ticket.VenueID].Name,
+	}
 	for i := range ticket.Matchs {
 		ticket.Matchs[i].Venue = mongo_model.VenueFK{
-			ID:   venue.ID.Hex(),
-			Name: venue.Name,
+			ID:   ticket.Matchs[i].VenueID,
+			Name: venueMap[ticket.Matchs[i].VenueID].Name,
 		}
 
 		homeSeasonTeam, ok := seasonTeamMap[ticket.Matchs[i].HomeSeasonTeamID]
@@ -314,6 +343,17 @@ func (u *superadminAppUsecase) CreateOrUpdateTicket(ctx context.Context, payload
 				dateSet[dateStr] = struct{}{}
 			}
 		}
+		saleStartAt, err := parseOptionalTime(ticket.SaleStartAt)
+		if err != nil {
+			errValidation["tickets["+strconv.Itoa(i)+"].saleStartAt"] = "Sale start at is invalid"
+		}
+		saleEndAt, err := parseOptionalTime(ticket.SaleEndAt)
+		if err != nil {
+			errValidation["tickets["+strconv.Itoa(i)+"].saleEndAt"] = "Sale end at is invalid"
+		}
+		if saleStartAt != nil && saleEndAt != nil && !saleStartAt.Before(*saleEndAt) {
+			errValidation["tickets["+strconv.Itoa(i)+"].saleEndAt"] = "Sale end at must be after sale start at"
+		}
 		if len(ticket.Matchs) == 0 {
 			errValidation["tickets["+strconv.Itoa(i)+"].matchs"] = "Matchs is required"
 		}
@@ -360,16 +400,23 @@ func (u *superadminAppUsecase) CreateOrUpdateTicket(ctx context.Context, payload
 		return helpers.NewResponse(http.StatusBadRequest, "Series not found", nil, nil)
 	}
 
-	// check venue
-	venue, err := u.mongoDbRepo.FetchOneVenue(ctx, map[string]interface{}{
-		"id": series.VenueID,
-	})
+	// check venue, ticket day without venue use the series venue
+	venueIdSet := map[string]struct{}{series.VenueID: {}}
+	for _, ticketPayload := range payload.Tickets {
+		if ticketPayload.VenueID != "" {
+			venueIdSet[ticketPayload.VenueID] = struct{}{}
+		}
+	}
+
+	// set ids to slice
+	venueIds := make([]string, 0, len(venueIdSet))
+	for id := range venueIdSet {
+		venueIds = append(venueIds, id)
+	}
+	venueMap, err := shared_usecase.FetchVenueMap(ctx, u.mongoDbRepo, venueIds)
 	if err != nil {
 		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
 	}
-	if venue == nil {
-		return helpers.NewResponse(http.StatusBadRequest, "Venue not found", nil, nil)
-	}
 
 	// check season team without duplicate
 	seasonTeamIdSet := make(map[string]struct{})
@@ -406,6 +453,8 @@ func (u *superadminAppUsecase) CreateOrUpdateTicket(ctx context.Context, payload
 
 	var createdTickets []*mongo_model.Ticket
 	var updatedTickets []mongo_model.Ticket
+	var changedTickets []*mongo_model.Ticket
+	var existingTickets []*mongo_model.Ticket
 	now := time.Now()
 	for _, ticketPayload := range payload.Tickets {
 		// set date to start of day
@@ -417,13 +466,29 @@ func (u *superadminAppUsecase) CreateOrUpdateTicket(ctx context.Context, payload
 				" and "+helpers.FormatDateWIB(series.EndDate, "02 January 2006"), nil, nil)
 		}
 
+		// set venue
+		venueId := ticketPayload.VenueID
+		if venueId == "" {
+			venueId = series.VenueID
+		}
+		if _, ok := venueMap[venueId]; !ok {
+			return helpers.NewResponse(http.StatusBadRequest, "Venue "+venueId+" not found", nil, nil)
+		}
+
+		// set sale window, validated above
+		saleStartAt, _ := parseOptionalTime(ticketPayload.SaleStartAt)
+		saleEndAt, _ := parseOptionalTime(ticketPayload.SaleEndAt)
+
 		// set ticket
 		ticket := &mongo_model.Ticket{
-			Name:     ticketPayload.Name,
-			SeriesID: payload.SeriesID,
-			Date:     date,
-			Price:    ticketPayload.Price,
-			Matchs:   []mongo_model.TicketMatch{},
+			Name:        ticketPayload.Name,
+			SeriesID:    payload.SeriesID,
+			VenueID:     venueId,
+			Date:        date,
+			Price:       ticketPayload.Price,
+			Matchs:      []mongo_model.TicketMatch{},
+			SaleStartAt: saleStartAt,
+			SaleEndAt:   saleEndAt,
 			Quota: mongo_model.TicketQuota{
 				Stock: ticketPayload.Quota,
 			},
@@ -441,10 +506,24 @@ func (u *superadminAppUsecase) CreateOrUpdateTicket(ctx context.Context, payload
 				return helpers.NewResponse(http.StatusBadRequest, "Away Season Team "+matchPayload.AwaySeasonTeamID+" not found", nil, nil)
 			}
 
+			// an existing match can only be kept by its own ticket day or taken when it has none
+			if matchPayload.MatchID != "" {
+				match, err := u.mongoDbRepo.FetchOneMatch(ctx, map[string]interface{}{
+					"id": matchPayload.MatchID,
+				})
+				if err != nil {
+					return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+				}
+				if match == nil || (match.TicketID != "" && match.TicketID != ticketPayload.ID) {
+					return helpers.NewResponse(http.StatusBadRequest, "Match "+matchPayload.MatchID+" not found", nil, nil)
+				}
+			}
+
 			ticket.Matchs = append(ticket.Matchs, mongo_model.TicketMatch{
+				MatchID:          matchPayload.MatchID,
 				HomeSeasonTeamID: matchPayload.HomeSeasonTeamID,
 				AwaySeasonTeamID: matchPayload.AwaySeasonTeamID,
-				VenueID:          venue.ID.Hex(),
+				VenueID:          venueId,
 				Time:             matchPayload.Time,
 			})
 		}
@@ -454,47 +533,77 @@ func (u *superadminAppUsecase) CreateOrUpdateTicket(ctx context.Context, payload
 			ticket.ID = primitive.NewObjectID()
 			ticket.CreatedAt = now
 			createdTickets = append(createdTickets, ticket)
-		} else {
-			// if id is not empty, update ticket
-			existingTicket, err := u.mongoDbRepo.FetchOneTicket(ctx, map[string]interface{}{
-				"id": ticketPayload.ID,
-			})
-			if err != nil {
-				return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
-			}
-			if existingTicket == nil {
-				return helpers.NewResponse(http.StatusBadRequest, "Ticket "+ticketPayload.ID+" not found", nil, nil)
-			}
+			continue
+		}
 
-			// save updated ticket
-			err = u.mongoDbRepo.UpdatePartialTicket(ctx, map[string]interface{}{
-				"id": ticketPayload.ID,
-			}, map[string]interface{}{
-				"name":      ticket.Name,
-				"date":      ticket.Date,
-				"price":     ticket.Price,
-				"quota":     ticket.Quota,
-				"matchs":    ticket.Matchs,
-				"updatedAt": ticket.UpdatedAt,
-			})
-			if err != nil {
-				return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
-			}
-			updatedTickets = append(updatedTickets, *existingTicket)
+		// if id is not empty, update ticket
+		existingTicket, err := u.mongoDbRepo.FetchOneTicket(ctx, map[string]interface{}{
+			"id": ticketPayload.ID,
+		})
+		if err != nil {
+			return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
 		}
+		if existingTicket == nil {
+			return helpers.NewResponse(http.StatusBadRequest, "Ticket "+ticketPayload.ID+" not found", nil, nil)
+		}
+		ticket.ID = existingTicket.ID
+		changedTickets = append(changedTickets, ticket)
+		existingTickets = append(existingTickets, existingTicket)
 	}
 
-	// create many ticket if createdTickets is not empty
+	// every ticket is valid, save them before their matches so a refused payload leaves no match behind
 	if len(createdTickets) > 0 {
 		err = u.mongoDbRepo.CreateManyTicket(ctx, createdTickets)
 		if err != nil {
 			return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
 		}
 	}
+	for i, ticket := range changedTickets {
+		// matchs are saved once synced with the matches
+		err = u.mongoDbRepo.UpdatePartialTicket(ctx, map[string]interface{}{
+			"id": ticket.ID,
+		}, ticketUpdateField(ticket))
+		if err != nil {
+			return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+		}
+		updatedTickets = append(updatedTickets, *existingTickets[i])
+	}
+
+	// sync matches, keeping existing matches and their results
+	for _, ticket := range createdTickets {
+		if err := u.syncTicketMatches(ctx, series.SeasonID, ticket, nil); err != nil {
+			return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+		}
+		if err := u.saveTicketMatchs(ctx, ticket, now); err != nil {
+			return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+		}
+		u.recordAuditLog(ctx, mongo_model.AuditActionCreate, mongo_model.AuditEntityTicket, ticket.ID.Hex(), nil, ticket)
+	}
+	for i, ticket := range changedTickets {
+		existingTicket := existingTickets[i]
+		if err := u.syncTicketMatches(ctx, series.SeasonID, ticket, existingTicket.Matchs); err != nil {
+			return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+		}
+		if err := u.saveTicketMatchs(ctx, ticket, now); err != nil {
+			return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+		}
+		field := ticketUpdateField(ticket)
+		field["matchs"] = ticket.Matchs
+		before := helpers.AuditSnapshot(existingTicket)
+		u.recordAuditLog(ctx, mongo_model.AuditActionUpdate, mongo_model.AuditEntityTicket, existingTicket.ID.Hex(), before, helpers.AuditApply(before, field))
+	}
 
 	// update match count in related series in bg
 	go u.updateSeriesMatchCount(context.Background(), series.ID.Hex())
 
+	// issue new ticket days to season pass holders in bg
+	if len(createdTickets) > 0 {
+		go u.syncSeasonPassTickets(context.Background(), series.ID.Hex())
+	}
+
+	// results of removed matches leave the standings in bg
+	go u.refreshStandings(context.Background(), series.SeasonID)
+
 	// return response
 	return helpers.NewResponse(http.StatusOK, "Success", nil, map[string]interface{}{
 		"created": createdTickets,
@@ -502,6 +611,20 @@ func (u *superadminAppUsecase) CreateOrUpdateTicket(ctx context.Context, payload
 	})
 }
 
+// ticketUpdateField the fields of a ticket day set by the bulk save, matchs are saved by saveTicketMatchs
+func ticketUpdateField(ticket *mongo_model.Ticket) map[string]interface{} {
+	return map[string]interface{}{
+		"name":        ticket.Name,
+		"date":        ticket.Date,
+		"venueId":     ticket.VenueID,
+		"price":       ticket.Price,
+		"quota":       ticket.Quota,
+		"saleStartAt": ticket.SaleStartAt,
+		"saleEndAt":   ticket.SaleEndAt,
+		"updatedAt":   ticket.UpdatedAt,
+	}
+}
+
 func (u *superadminAppUsecase) DeleteTicket(ctx context.Context, id string) helpers.Response {
 	ctx, cancel := context.WithTimeout(ctx, u.contextTimeout)
 	defer cancel()
@@ -518,6 +641,7 @@ func (u *superadminAppUsecase) DeleteTicket(ctx context.Context, id string) help
 	}
 
 	// delete ticket
+	before := helpers.AuditSnapshot(ticket)
 	now := time.Now()
 	ticket.DeletedAt = &now
 	err = u.mongoDbRepo.UpdatePartialTicket(ctx, map[string]interface{}{
@@ -528,9 +652,31 @@ func (u *superadminAppUsecase) DeleteTicket(ctx context.Context, id string) help
 	if err != nil {
 		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
 	}
+	u.recordAuditLog(ctx, mongo_model.AuditActionDelete, mongo_model.AuditEntityTicket, ticket.ID.Hex(), before, ticket)
+
+	// delete matches of the ticket day
+	err = u.mongoDbRepo.UpdateManyMatchPartial(ctx, map[string]interface{}{
+		"ticketId": ticket.ID.Hex(),
+	}, map[string]interface{}{
+		"deletedAt": ticket.DeletedAt,
+	})
+	if err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
 
 	// update match count in related series in bg
 	go u.updateSeriesMatchCount(context.Background(), ticket.SeriesID)
 
+	// results of the ticket day leave the standings in bg
+	series, err := u.mongoDbRepo.FetchOneSeries(ctx, map[string]interface{}{
+		"id": ticket.SeriesID,
+	})
+	if err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+	if series != nil {
+		go u.refreshStandings(context.Background(), series.SeasonID)
+	}
+
 	return helpers.NewResponse(http.StatusOK, "Success", nil, nil)
 }
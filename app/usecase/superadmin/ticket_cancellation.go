@@ -0,0 +1,423 @@
+package superadmin_usecase
+
+import (
+	shared_usecase "app/app/usecase/shared"
+	mongo_model "app/domain/model/mongo"
+	"app/domain/request"
+	"app/helpers"
+	jwt_helpers "app/helpers/jwt"
+	mailing_helpers "app/helpers/mailing"
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// ticketCancellationJobStaleAfter is how long a running job may go without saving progress before it is resumed
+const ticketCancellationJobStaleAfter = 2 * time.Minute
+
+func (u *superadminAppUsecase) CancelTicket(ctx context.Context, claim jwt_helpers.SuperadminJWTClaims, id string, payload request.TicketCancelRequest) helpers.Response {
+	ctx, cancel := context.WithTimeout(ctx, u.contextTimeout)
+	defer cancel()
+
+	// validate payload
+	errValidation := make(map[string]string)
+	if payload.Reason == "" {
+		errValidation["reason"] = "Reason field is required"
+	}
+	if len(errValidation) > 0 {
+		return helpers.NewResponse(http.StatusUnprocessableEntity, "Validation Error", errValidation, nil)
+	}
+
+	// check superadmin
+	superadmin, err := u.mongoDbRepo.FetchOneSuperadmin(ctx, map[string]interface{}{
+		"id": claim.UserID,
+	})
+	if err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+	if superadmin == nil {
+		return helpers.NewResponse(http.StatusBadRequest, "User not found", nil, nil)
+	}
+
+	// check ticket
+	ticket, err := u.mongoDbRepo.FetchOneTicket(ctx, map[string]interface{}{
+		"id": id,
+	})
+	if err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+	if ticket == nil {
+		return helpers.NewResponse(http.StatusBadRequest, "Ticket not found", nil, nil)
+	}
+	if ticket.IsCancelled {
+		return helpers.NewResponse(http.StatusBadRequest, "Ticket day is already cancelled", nil, nil)
+	}
+
+	// check series
+	series, err := u.mongoDbRepo.FetchOneSeries(ctx, map[string]interface{}{
+		"id": ticket.SeriesID,
+	})
+	if err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+	if series == nil {
+		return helpers.NewResponse(http.StatusBadRequest, "Series not found", nil, nil)
+	}
+
+	// cancel ticket, stop new purchase for this day
+	now := time.Now()
+	field := map[string]interface{}{
+		"isCancelled":  true,
+		"cancelledAt":  now,
+		"cancelReason": payload.Reason,
+		"updatedAt":    now,
+	}
+	cancelled, err := u.mongoDbRepo.CancelOneTicket(ctx, ticket.ID.Hex(), payload.Reason, now)
+	if err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+	if !cancelled {
+		// cancelled by another request since it was read
+		return helpers.NewResponse(http.StatusBadRequest, "Ticket day is already cancelled", nil, nil)
+	}
+	before := helpers.AuditSnapshot(ticket)
+	u.recordAuditLog(ctx, mongo_model.AuditActionCancel, mongo_model.AuditEntityTicket, ticket.ID.Hex(), before, helpers.AuditApply(before, field))
+
+	// matches not played yet are postponed
+	err = u.mongoDbRepo.UpdateManyMatchPartial(ctx, map[string]interface{}{
+		"ticketId": ticket.ID.Hex(),
+		"status":   mongo_model.MatchStatusScheduled,
+	}, map[string]interface{}{
+		"status":    mongo_model.MatchStatusPostponed,
+		"updatedAt": now,
+	})
+	if err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+
+	// create job
+	job := mongo_model.TicketCancellationJob{
+		ID: primitive.NewObjectID(),
+		Ticket: mongo_model.TicketFK{
+			ID:      ticket.ID.Hex(),
+			Name:    ticket.Name,
+			Date:    ticket.Date,
+			VenueID: ticket.GetVenueID(),
+		},
+		Series: mongo_model.SeriesFK{
+			ID:   series.ID.Hex(),
+			Name: series.Name,
+		},
+		Reason: payload.Reason,
+		Status: mongo_model.JobStatusRunning,
+		Errors: []string{},
+		CreatedBy: mongo_model.ActorFK{
+			ID:   superadmin.ID.Hex(),
+			Name: superadmin.Name,
+			Role: mongo_model.ActorRoleSuperadmin,
+		},
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	err = u.mongoDbRepo.CreateOneTicketCancellationJob(ctx, &job)
+	if err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+
+	// void ticket purchases and queue refunds in bg
+	go u.processTicketCancellation(context.Background(), job)
+
+	return helpers.NewResponse(http.StatusAccepted, "Ticket day cancelled, refunds are being processed", nil, job)
+}
+
+// processTicketCancellation voids every ticket purchase of the cancelled day
+// and queues a refund for every paid purchase containing it, progress is saved to the job
+// after each purchase so the job can be resumed
+func (u *superadminAppUsecase) processTicketCancellation(ctx context.Context, job mongo_model.TicketCancellationJob) {
+	fetchOptions := map[string]interface{}{
+		"ticketId": job.Ticket.ID,
+		"status":   mongo_model.PurchaseStatusPaid,
+	}
+
+	if job.LastPurchaseID == "" {
+		job.TotalPurchases = u.mongoDbRepo.CountPurchase(ctx, fetchOptions)
+		u.saveTicketCancellationJob(ctx, &job)
+	}
+
+	// refund of a season pass is the price of the day
+	ticket, err := u.fetchTicketCancellationTicket(ctx, job.Ticket.ID)
+	if err != nil {
+		job.Status = mongo_model.JobStatusFailed
+		job.Errors = append(job.Errors, err.Error())
+		u.saveTicketCancellationJob(ctx, &job)
+		return
+	}
+
+	fetchOptions["sort"] = "_id"
+	fetchOptions["dir"] = "asc"
+	if job.LastPurchaseID != "" {
+		fetchOptions["idAfter"] = job.LastPurchaseID
+	}
+	cur, err := u.mongoDbRepo.FetchListPurchase(ctx, fetchOptions)
+	if err != nil {
+		job.Status = mongo_model.JobStatusFailed
+		job.Errors = append(job.Errors, err.Error())
+		u.saveTicketCancellationJob(ctx, &job)
+		return
+	}
+	defer cur.Close(ctx)
+
+	for cur.Next(ctx) {
+		var purchase mongo_model.Purchase
+		if err := cur.Decode(&purchase); err != nil {
+			logrus.Error("Purchase Decode:", err)
+			job.Errors = append(job.Errors, err.Error())
+			continue
+		}
+
+		voided, refund, err := u.voidAndRefundPurchase(ctx, &purchase, ticket, job)
+		if err != nil {
+			job.Errors = append(job.Errors, "purchase "+purchase.ID.Hex()+": "+err.Error())
+		} else {
+			job.VoidedTickets += voided
+			if refund != nil {
+				job.RefundCount++
+				job.RefundTotal += refund.Amount
+
+				// notify holder
+				go mailing_helpers.SendTicketDayCancelled(refund, job.Reason)
+			}
+		}
+
+		job.ProcessedPurchases++
+		job.LastPurchaseID = purchase.ID.Hex()
+		u.saveTicketCancellationJob(ctx, &job)
+	}
+
+	// finish job
+	now := time.Now()
+	job.Status = mongo_model.JobStatusCompleted
+	job.FinishedAt = &now
+	u.saveTicketCancellationJob(ctx, &job)
+}
+
+func (u *superadminAppUsecase) fetchTicketCancellationTicket(ctx context.Context, id string) (*mongo_model.Ticket, error) {
+	ctx, cancel := context.WithTimeout(ctx, u.contextTimeout)
+	defer cancel()
+
+	ticket, err := u.mongoDbRepo.FetchOneTicket(ctx, map[string]interface{}{
+		"id": id,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if ticket == nil {
+		return nil, errors.New("ticket not found")
+	}
+
+	return ticket, nil
+}
+
+// ResumeTicketCancellationJobs continues running jobs which stopped saving progress, e.g. after a restart
+func (u *superadminAppUsecase) ResumeTicketCancellationJobs(ctx context.Context) {
+	ctx, cancel := context.WithTimeout(ctx, u.contextTimeout)
+	defer cancel()
+
+	cur, err := u.mongoDbRepo.FetchListTicketCancellationJob(ctx, map[string]interface{}{
+		"status": mongo_model.JobStatusRunning,
+	})
+	if err != nil {
+		logrus.Error("ResumeTicketCancellationJobs FetchListTicketCancellationJob:", err)
+		return
+	}
+	defer cur.Close(ctx)
+
+	now := time.Now()
+	for cur.Next(ctx) {
+		var job mongo_model.TicketCancellationJob
+		if err := cur.Decode(&job); err != nil {
+			logrus.Error("TicketCancellationJob Decode:", err)
+			return
+		}
+
+		// a job still saving progress is running in another replica
+		claimed, err := u.mongoDbRepo.ClaimTicketCancellationJob(ctx, job.ID.Hex(), now.Add(-ticketCancellationJobStaleAfter), now)
+		if err != nil || !claimed {
+			continue
+		}
+
+		go u.processTicketCancellation(context.Background(), job)
+	}
+}
+
+// voidAndRefundPurchase voids the ticket purchases of the cancelled day in a purchase
+// and queues its refund, refund already queued for the same purchase and day is not duplicated
+func (u *superadminAppUsecase) voidAndRefundPurchase(ctx context.Context, purchase *mongo_model.Purchase, ticket *mongo_model.Ticket, job mongo_model.TicketCancellationJob) (int64, *mongo_model.Refund, error) {
+	ctx, cancel := context.WithTimeout(ctx, u.contextTimeout)
+	defer cancel()
+
+	now := time.Now()
+
+	// void ticket purchases
+	voidOptions := map[string]interface{}{
+		"purchaseId": purchase.ID.Hex(),
+		"ticketId":   job.Ticket.ID,
+		"isVoided":   false,
+	}
+	voided := u.mongoDbRepo.CountTicketPurchase(ctx, voidOptions)
+	if voided > 0 {
+		err := u.mongoDbRepo.UpdateManyTicketPurchasePartial(ctx, voidOptions, map[string]interface{}{
+			"isVoided":   true,
+			"voidedAt":   now,
+			"voidReason": job.Reason,
+			"updatedAt":  now,
+		})
+		if err != nil {
+			return 0, nil, err
+		}
+	}
+
+	// queue refund
+	refunded, err := shared_usecase.FetchRefundedTotal(ctx, u.mongoDbRepo, purchase.ID.Hex())
+	if err != nil {
+		return voided, nil, err
+	}
+	refund := mongo_model.Refund{
+		ID:         primitive.NewObjectID(),
+		PurchaseID: purchase.ID.Hex(),
+		Invoice:    purchase.Invoice,
+		Channel:    purchase.Format().Channel,
+		Member:     purchase.Member,
+		Ticket:     job.Ticket,
+		JobID:      job.ID.Hex(),
+		Reason:     mongo_model.RefundReasonTicketDayCancelled,
+		Amount:     purchase.RefundShare(job.Ticket.ID, ticket.Price, refunded),
+		Status:     mongo_model.RefundStatusPending,
+		CreatedAt:  now,
+		UpdatedAt:  now,
+	}
+	created, err := u.mongoDbRepo.CreateOneRefundIfNotExists(ctx, &refund)
+	if err != nil {
+		return voided, nil, err
+	}
+	if !created {
+		return voided, nil, nil
+	}
+
+	return voided, &refund, nil
+}
+
+func (u *superadminAppUsecase) saveTicketCancellationJob(ctx context.Context, job *mongo_model.TicketCancellationJob) {
+	ctx, cancel := context.WithTimeout(ctx, u.contextTimeout)
+	defer cancel()
+
+	job.UpdatedAt = time.Now()
+	err := u.mongoDbRepo.UpdatePartialTicketCancellationJob(ctx, map[string]interface{}{
+		"id": job.ID,
+	}, map[string]interface{}{
+		"status":             job.Status,
+		"totalPurchases":     job.TotalPurchases,
+		"processedPurchases": job.ProcessedPurchases,
+		"voidedTickets":      job.VoidedTickets,
+		"refundCount":        job.RefundCount,
+		"refundTotal":        job.RefundTotal,
+		"errors":             job.Errors,
+		"lastPurchaseId":     job.LastPurchaseID,
+		"finishedAt":         job.FinishedAt,
+		"updatedAt":          job.UpdatedAt,
+	})
+	if err != nil {
+		logrus.Error("saveTicketCancellationJob UpdatePartialTicketCancellationJob:", err)
+	}
+}
+
+func (u *superadminAppUsecase) GetTicketCancellationJobsList(ctx context.Context, queryParam url.Values) helpers.Response {
+	ctx, cancel := context.WithTimeout(ctx, u.contextTimeout)
+	defer cancel()
+
+	// get limit offset
+	page, offset, limit := helpers.GetOffsetLimit(queryParam)
+
+	fetchOptions := map[string]interface{}{
+		"limit":  limit,
+		"offset": offset,
+	}
+
+	// filtering
+	if queryParam.Get("ticketId") != "" {
+		fetchOptions["ticketId"] = queryParam.Get("ticketId")
+	}
+	if queryParam.Get("seriesId") != "" {
+		fetchOptions["seriesId"] = queryParam.Get("seriesId")
+	}
+	if queryParam.Get("status") != "" {
+		fetchOptions["status"] = mongo_model.JobStatus(queryParam.Get("status"))
+	}
+
+	// count total
+	total := u.mongoDbRepo.CountTicketCancellationJob(ctx, fetchOptions)
+	if total == 0 {
+		return helpers.NewResponse(http.StatusOK, "Success", nil, helpers.PaginatedResponse{
+			List:  []interface{}{},
+			Limit: limit,
+			Page:  page,
+			Total: total,
+		})
+	}
+
+	// sorting
+	if queryParam.Get("sort") != "" {
+		fetchOptions["sort"] = queryParam.Get("sort")
+	}
+	if queryParam.Get("dir") != "" {
+		fetchOptions["dir"] = queryParam.Get("dir")
+	}
+
+	// fetch list
+	cur, err := u.mongoDbRepo.FetchListTicketCancellationJob(ctx, fetchOptions)
+	if err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+	defer cur.Close(ctx)
+
+	var list []interface{}
+	for cur.Next(ctx) {
+		row := mongo_model.TicketCancellationJob{}
+		err = cur.Decode(&row)
+		if err != nil {
+			logrus.Error("TicketCancellationJob Decode:", err)
+			return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+		}
+
+		list = append(list, row)
+	}
+
+	return helpers.NewResponse(http.StatusOK, "Success", nil, helpers.PaginatedResponse{
+		Limit: limit,
+		Page:  page,
+		Total: total,
+		List:  list,
+	})
+}
+
+func (u *superadminAppUsecase) GetTicketCancellationJobDetail(ctx context.Context, id string) helpers.Response {
+	ctx, cancel := context.WithTimeout(ctx, u.contextTimeout)
+	defer cancel()
+
+	job, err := u.mongoDbRepo.FetchOneTicketCancellationJob(ctx, map[string]interface{}{
+		"id": id,
+	})
+	if err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+	if job == nil {
+		return helpers.NewResponse(http.StatusBadRequest, "Job not found", nil, nil)
+	}
+
+	return helpers.NewResponse(http.StatusOK, "Success", nil, job)
+}
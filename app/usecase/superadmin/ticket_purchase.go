@@ -0,0 +1,131 @@
+package superadmin_usecase
+
+import (
+	shared_usecase "app/app/usecase/shared"
+	mongo_model "app/domain/model/mongo"
+	"app/domain/request"
+	"app/helpers"
+	jwt_helpers "app/helpers/jwt"
+	"context"
+	"net/http"
+	"net/url"
+
+	"github.com/sirupsen/logrus"
+)
+
+func (u *superadminAppUsecase) ReissueTicketPurchase(ctx context.Context, claim jwt_helpers.SuperadminJWTClaims, id string, payload request.TicketPurchaseReissueRequest) helpers.Response {
+	ctx, cancel := context.WithTimeout(ctx, u.contextTimeout)
+	defer cancel()
+
+	// validate payload
+	errValidation := make(map[string]string)
+	if payload.Reason == "" {
+		errValidation["reason"] = "Reason field is required"
+	}
+	if len(errValidation) > 0 {
+		return helpers.NewResponse(http.StatusUnprocessableEntity, "Validation Error", errValidation, nil)
+	}
+
+	// check superadmin
+	superadmin, err := u.mongoDbRepo.FetchOneSuperadmin(ctx, map[string]interface{}{
+		"id": claim.UserID,
+	})
+	if err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+	if superadmin == nil {
+		return helpers.NewResponse(http.StatusBadRequest, "User not found", nil, nil)
+	}
+
+	// check ticket purchase
+	ticketPurchase, err := u.mongoDbRepo.FetchOneTicketPurchase(ctx, map[string]interface{}{
+		"id": id,
+	})
+	if err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+	if ticketPurchase == nil {
+		return helpers.NewResponse(http.StatusBadRequest, "Ticket not found", nil, nil)
+	}
+	before := helpers.AuditSnapshot(ticketPurchase)
+
+	response := shared_usecase.ReissueTicketPurchase(ctx, u.mongoDbRepo, ticketPurchase, payload.Reason, mongo_model.ActorFK{
+		ID:   superadmin.ID.Hex(),
+		Name: superadmin.Name,
+		Role: mongo_model.ActorRoleSuperadmin,
+	})
+	if response.Status == http.StatusOK {
+		u.recordAuditLog(ctx, mongo_model.AuditActionReissue, mongo_model.AuditEntityTicketPurchase, ticketPurchase.ID.Hex(), before, ticketPurchase)
+	}
+
+	return response
+}
+
+func (u *superadminAppUsecase) GetTicketPurchaseReissueLogsList(ctx context.Context, queryParam url.Values) helpers.Response {
+	ctx, cancel := context.WithTimeout(ctx, u.contextTimeout)
+	defer cancel()
+
+	// get limit offset
+	page, offset, limit := helpers.GetOffsetLimit(queryParam)
+
+	fetchOptions := map[string]interface{}{
+		"limit":  limit,
+		"offset": offset,
+	}
+
+	// filtering
+	if queryParam.Get("ticketPurchaseId") != "" {
+		fetchOptions["ticketPurchaseId"] = queryParam.Get("ticketPurchaseId")
+	}
+	if queryParam.Get("purchaseId") != "" {
+		fetchOptions["purchaseId"] = queryParam.Get("purchaseId")
+	}
+	if queryParam.Get("memberId") != "" {
+		fetchOptions["memberId"] = queryParam.Get("memberId")
+	}
+
+	// count total
+	total := u.mongoDbRepo.CountTicketPurchaseReissueLog(ctx, fetchOptions)
+	if total == 0 {
+		return helpers.NewResponse(http.StatusOK, "Success", nil, helpers.PaginatedResponse{
+			List:  []interface{}{},
+			Limit: limit,
+			Page:  page,
+			Total: total,
+		})
+	}
+
+	// sorting
+	if queryParam.Get("sort") != "" {
+		fetchOptions["sort"] = queryParam.Get("sort")
+	}
+	if queryParam.Get("dir") != "" {
+		fetchOptions["dir"] = queryParam.Get("dir")
+	}
+
+	// fetch list
+	cur, err := u.mongoDbRepo.FetchListTicketPurchaseReissueLog(ctx, fetchOptions)
+	if err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+	defer cur.Close(ctx)
+
+	var list []interface{}
+	for cur.Next(ctx) {
+		row := mongo_model.TicketPurchaseReissueLog{}
+		err = cur.Decode(&row)
+		if err != nil {
+			logrus.Error("TicketPurchaseReissueLog Decode:", err)
+			return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+		}
+
+		list = append(list, row.Format())
+	}
+
+	return helpers.NewResponse(http.StatusOK, "Success", nil, helpers.PaginatedResponse{
+		Limit: limit,
+		Page:  page,
+		Total: total,
+		List:  list,
+	})
+}
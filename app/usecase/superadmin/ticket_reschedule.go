@@ -0,0 +1,232 @@
+package superadmin_usecase
+
+import (
+	mongo_model "app/domain/model/mongo"
+	"app/domain/request"
+	"app/helpers"
+	jwt_helpers "app/helpers/jwt"
+	mailing_helpers "app/helpers/mailing"
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+func (u *superadminAppUsecase) RescheduleTicket(ctx context.Context, claim jwt_helpers.SuperadminJWTClaims, id string, payload request.TicketRescheduleRequest) helpers.Response {
+	ctx, cancel := context.WithTimeout(ctx, u.contextTimeout)
+	defer cancel()
+
+	// validate payload
+	errValidation := make(map[string]string)
+	if payload.Date == "" {
+		errValidation["date"] = "Date field is required"
+	} else if _, err := time.Parse(time.RFC3339, payload.Date); err != nil {
+		errValidation["date"] = "Date format is invalid"
+	}
+	if payload.Reason == "" {
+		errValidation["reason"] = "Reason field is required"
+	}
+	for i, matchTime := range payload.MatchTimes {
+		if _, err := time.Parse("15:04", matchTime); err != nil {
+			errValidation["matchTimes["+strconv.Itoa(i)+"]"] = "Time is invalid"
+		}
+	}
+	if payload.RefundOptInDays < 0 {
+		errValidation["refundOptInDays"] = "Refund opt in days must not be negative"
+	}
+	if len(errValidation) > 0 {
+		return helpers.NewResponse(http.StatusUnprocessableEntity, "Validation Error", errValidation, nil)
+	}
+
+	// check superadmin
+	superadmin, err := u.mongoDbRepo.FetchOneSuperadmin(ctx, map[string]interface{}{
+		"id": claim.UserID,
+	})
+	if err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+	if superadmin == nil {
+		return helpers.NewResponse(http.StatusBadRequest, "User not found", nil, nil)
+	}
+
+	// check ticket
+	ticket, err := u.mongoDbRepo.FetchOneTicket(ctx, map[string]interface{}{
+		"id": id,
+	})
+	if err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+	if ticket == nil {
+		return helpers.NewResponse(http.StatusBadRequest, "Ticket not found", nil, nil)
+	}
+	before := helpers.AuditSnapshot(ticket)
+	if ticket.IsCancelled {
+		return helpers.NewResponse(http.StatusBadRequest, "Ticket day has been cancelled", nil, nil)
+	}
+	if len(payload.MatchTimes) > 0 && len(payload.MatchTimes) != len(ticket.Matchs) {
+		return helpers.NewResponse(http.StatusBadRequest, "Match times must be set for all "+strconv.Itoa(len(ticket.Matchs))+" matchs", nil, nil)
+	}
+
+	// check series
+	series, err := u.mongoDbRepo.FetchOneSeries(ctx, map[string]interface{}{
+		"id": ticket.SeriesID,
+	})
+	if err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+	if series == nil {
+		return helpers.NewResponse(http.StatusBadRequest, "Series not found", nil, nil)
+	}
+
+	// set date to start of day
+	date, _ := time.Parse(time.RFC3339, payload.Date)
+	date = helpers.SetToStartOfDayWIB(date)
+	if date.Before(series.StartDate) || date.After(series.EndDate) {
+		return helpers.NewResponse(http.StatusBadRequest, "Date must be between "+helpers.FormatDateWIB(series.StartDate, "02 January 2006")+
+			" and "+helpers.FormatDateWIB(series.EndDate, "02 January 2006"), nil, nil)
+	}
+	if date.Before(helpers.SetToStartOfDayWIB(time.Now())) {
+		return helpers.NewResponse(http.StatusUnprocessableEntity, "Validation Error", map[string]string{
+			"date": "Date must not be in the past",
+		}, nil)
+	}
+
+	// another ticket day of the series can not be on the same date
+	sameDateCur, err := u.mongoDbRepo.FetchListTicket(ctx, map[string]interface{}{
+		"seriesId":    ticket.SeriesID,
+		"dateFrom":    date,
+		"dateTo":      helpers.SetToEndOfDayWIB(date),
+		"isCancelled": false,
+	})
+	if err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+	defer sameDateCur.Close(ctx)
+
+	for sameDateCur.Next(ctx) {
+		var sameDateTicket mongo_model.Ticket
+		if err := sameDateCur.Decode(&sameDateTicket); err != nil {
+			logrus.Error("Ticket Decode:", err)
+			return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+		}
+		if sameDateTicket.ID != ticket.ID {
+			return helpers.NewResponse(http.StatusUnprocessableEntity, "Validation Error", map[string]string{
+				"date": "Date is already used by " + sameDateTicket.Name,
+			}, nil)
+		}
+	}
+
+	// set match times
+	for i, matchTime := range payload.MatchTimes {
+		ticket.Matchs[i].Time = matchTime
+	}
+
+	// set reschedule
+	now := time.Now()
+	refundOptInDays := payload.RefundOptInDays
+	if refundOptInDays == 0 {
+		refundOptInDays = 7
+	}
+	ticket.Reschedule = &mongo_model.TicketReschedule{
+		PreviousDate:     ticket.Date,
+		Reason:           payload.Reason,
+		RefundOptInUntil: now.AddDate(0, 0, refundOptInDays),
+		RescheduledAt:    now,
+		RescheduledBy: mongo_model.ActorFK{
+			ID:   superadmin.ID.Hex(),
+			Name: superadmin.Name,
+			Role: mongo_model.ActorRoleSuperadmin,
+		},
+	}
+	ticket.Date = date
+	ticket.UpdatedAt = now
+
+	// save ticket
+	err = u.mongoDbRepo.UpdatePartialTicket(ctx, map[string]interface{}{
+		"id": ticket.ID,
+	}, map[string]interface{}{
+		"date":       ticket.Date,
+		"matchs":     ticket.Matchs,
+		"reschedule": ticket.Reschedule,
+		"updatedAt":  ticket.UpdatedAt,
+	})
+	if err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+
+	// move kickoff of the matches to the new date
+	for _, ticketMatch := range ticket.Matchs {
+		if ticketMatch.MatchID == "" {
+			continue
+		}
+		kickoffAt, err := helpers.SetTimeOfDayWIB(ticket.Date, ticketMatch.Time)
+		if err != nil {
+			return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+		}
+		err = u.mongoDbRepo.UpdatePartialMatch(ctx, map[string]interface{}{
+			"id": ticketMatch.MatchID,
+		}, map[string]interface{}{
+			"kickoffAt": kickoffAt,
+			"updatedAt": now,
+		})
+		if err != nil {
+			return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+		}
+	}
+
+	// update denormalized date in purchases
+	err = u.mongoDbRepo.UpdateManyPurchasePartial(ctx, map[string]interface{}{
+		"ticketId": ticket.ID.Hex(),
+	}, map[string]interface{}{
+		"tickets.$.date": ticket.Date,
+		"updatedAt":      now,
+	})
+	if err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+
+	// update denormalized date in ticket purchases, so it can be scanned at the new date
+	err = u.mongoDbRepo.UpdateManyTicketPurchasePartial(ctx, map[string]interface{}{
+		"ticketId": ticket.ID.Hex(),
+	}, map[string]interface{}{
+		"ticket.date": ticket.Date,
+		"updatedAt":   now,
+	})
+	if err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+
+	// notify holders in bg
+	go u.notifyTicketRescheduled(context.Background(), *ticket)
+	u.recordAuditLog(ctx, mongo_model.AuditActionReschedule, mongo_model.AuditEntityTicket, ticket.ID.Hex(), before, ticket)
+
+	return helpers.NewResponse(http.StatusOK, "Success", nil, ticket.Format())
+}
+
+// notifyTicketRescheduled emails every paid and pending purchase holder of the rescheduled ticket day
+func (u *superadminAppUsecase) notifyTicketRescheduled(ctx context.Context, ticket mongo_model.Ticket) {
+	cur, err := u.mongoDbRepo.FetchListPurchase(ctx, map[string]interface{}{
+		"ticketId": ticket.ID.Hex(),
+		"statuses": []mongo_model.PurchaseStatus{
+			mongo_model.PurchaseStatusPaid,
+			mongo_model.PurchaseStatusPending,
+		},
+	})
+	if err != nil {
+		logrus.Error("notifyTicketRescheduled FetchListPurchase:", err)
+		return
+	}
+	defer cur.Close(ctx)
+
+	for cur.Next(ctx) {
+		var purchase mongo_model.Purchase
+		if err := cur.Decode(&purchase); err != nil {
+			logrus.Error("Purchase Decode:", err)
+			continue
+		}
+
+		mailing_helpers.SendTicketDayRescheduled(&purchase, &ticket)
+	}
+}
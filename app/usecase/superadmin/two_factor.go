@@ -0,0 +1,472 @@
+package superadmin_usecase
+
+import (
+	mongo_model "app/domain/model/mongo"
+	"app/domain/request"
+	"app/helpers"
+	jwt_helpers "app/helpers/jwt"
+	"context"
+	"encoding/base64"
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"golang.org/x/crypto/bcrypt"
+)
+
+const (
+	twoFactorChallengeIssuer = "superadmin-2fa"
+	twoFactorChallengeTTL    = 5 * time.Minute
+	recoveryCodeCount        = 10
+)
+
+var errInvalidTwoFactorCode = errors.New("Invalid two factor code")
+
+// issueTwoFactorChallenge returns the short lived challenge token used by the second login step
+func issueTwoFactorChallenge(superadmin *mongo_model.Superadmin) (map[string]any, error) {
+	now := time.Now()
+	expiredAt := now.Add(twoFactorChallengeTTL)
+	challengeToken, err := jwt_helpers.GenerateJWTTokenSuperadmin(jwt_helpers.SuperadminChallengeJWTClaims{
+		UserID: superadmin.ID.Hex(),
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        uuid.NewString(),
+			Issuer:    twoFactorChallengeIssuer,
+			IssuedAt:  jwt.NewNumericDate(now),
+			NotBefore: jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(expiredAt),
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]any{
+		"twoFactorRequired":      true,
+		"twoFactorSetupRequired": !superadmin.TwoFactor.IsEnabled,
+		"challengeToken":         challengeToken,
+		"challengeExpiredAt":     expiredAt,
+	}, nil
+}
+
+func (u *superadminAppUsecase) fetchTwoFactorChallenge(ctx context.Context, challengeToken string) (*mongo_model.Superadmin, error) {
+	claims := jwt_helpers.SuperadminChallengeJWTClaims{}
+	if err := jwt_helpers.ParseJWTTokenSuperadmin(challengeToken, &claims); err != nil {
+		return nil, nil
+	}
+	if claims.Issuer != twoFactorChallengeIssuer {
+		return nil, nil
+	}
+
+	return u.mongoDbRepo.FetchOneSuperadmin(ctx, map[string]interface{}{
+		"id": claims.UserID,
+	})
+}
+
+// startTwoFactorSetup store a pending secret, it only becomes active once a code from it is confirmed
+func (u *superadminAppUsecase) startTwoFactorSetup(ctx context.Context, superadmin *mongo_model.Superadmin) (map[string]any, error) {
+	secret, err := helpers.GenerateTOTPSecret()
+	if err != nil {
+		return nil, err
+	}
+
+	account := superadmin.Name
+	if superadmin.Email != nil {
+		account = *superadmin.Email
+	}
+	otpauthUri := helpers.TOTPProvisioningURI(helpers.GetTwoFactorIssuer(), account, secret)
+	qrCodePng, err := helpers.GenerateQRCodePNG(otpauthUri)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := u.mongoDbRepo.UpdatePartialSuperadmin(ctx, map[string]interface{}{
+		"id": superadmin.ID,
+	}, map[string]interface{}{
+		"twoFactor.pendingSecret": secret,
+		"updatedAt":               time.Now(),
+	}); err != nil {
+		return nil, err
+	}
+
+	return map[string]any{
+		"secret":     secret,
+		"otpauthUri": otpauthUri,
+		"qrCode":     "data:image/png;base64," + base64.StdEncoding.EncodeToString(qrCodePng),
+	}, nil
+}
+
+// useTwoFactorCode check an authenticator code or a recovery code and mark it used in one conditional update,
+// so neither can be used twice even by concurrent requests
+func (u *superadminAppUsecase) useTwoFactorCode(ctx context.Context, superadmin *mongo_model.Superadmin, code, recoveryCode string) (bool, error) {
+	now := time.Now()
+	if code != "" {
+		step, ok := helpers.ValidateTOTP(superadmin.TwoFactor.Secret, code, now)
+		if !ok || step <= superadmin.TwoFactor.LastUsedStep {
+			return false, nil
+		}
+		return u.mongoDbRepo.UseSuperadminTwoFactorStep(ctx, superadmin.ID.Hex(), step, now)
+	}
+
+	if recoveryCode != "" {
+		hashed := helpers.HashToken(strings.ToLower(strings.TrimSpace(recoveryCode)))
+		return u.mongoDbRepo.UseSuperadminRecoveryCode(ctx, superadmin.ID.Hex(), hashed, now)
+	}
+
+	return false, nil
+}
+
+// generateRecoveryCodes returns the plain codes to show once and their hashes to store
+func generateRecoveryCodes() ([]string, []string, error) {
+	codes := make([]string, 0, recoveryCodeCount)
+	hashes := make([]string, 0, recoveryCodeCount)
+	for i := 0; i < recoveryCodeCount; i++ {
+		random, err := helpers.GenerateSecureRandomChar(10)
+		if err != nil {
+			return nil, nil, err
+		}
+		code := strings.ToLower(random[:5] + "-" + random[5:])
+		codes = append(codes, code)
+		hashes = append(hashes, helpers.HashToken(code))
+	}
+	return codes, hashes, nil
+}
+
+// enableTwoFactor confirm the pending secret with a code and activate it
+func (u *superadminAppUsecase) enableTwoFactor(ctx context.Context, superadmin *mongo_model.Superadmin, code string) ([]string, error) {
+	step, ok := helpers.ValidateTOTP(superadmin.TwoFactor.PendingSecret, code, time.Now())
+	if !ok {
+		return nil, errInvalidTwoFactorCode
+	}
+
+	codes, hashes, err := generateRecoveryCodes()
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	superadmin.TwoFactor = mongo_model.TwoFactor{
+		IsEnabled:     true,
+		EnabledAt:     &now,
+		Secret:        superadmin.TwoFactor.PendingSecret,
+		RecoveryCodes: hashes,
+		LastUsedStep:  step,
+	}
+	if err := u.mongoDbRepo.UpdatePartialSuperadmin(ctx, map[string]interface{}{
+		"id": superadmin.ID,
+	}, map[string]interface{}{
+		"twoFactor": superadmin.TwoFactor,
+		"updatedAt": now,
+	}); err != nil {
+		return nil, err
+	}
+
+	return codes, nil
+}
+
+func (u *superadminAppUsecase) SetupTwoFactorChallenge(ctx context.Context, payload request.TwoFactorChallengeRequest) helpers.Response {
+	ctx, cancel := context.WithTimeout(ctx, u.contextTimeout)
+	defer cancel()
+
+	// validate payload
+	errValidation := make(map[string]string)
+	if payload.ChallengeToken == "" {
+		errValidation["challengeToken"] = "Challenge token field is required"
+	}
+	if len(errValidation) > 0 {
+		return helpers.NewResponse(http.StatusUnprocessableEntity, "Validation Error", errValidation, nil)
+	}
+
+	// check challenge
+	superadmin, err := u.fetchTwoFactorChallenge(ctx, payload.ChallengeToken)
+	if err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+	if superadmin == nil {
+		return helpers.NewResponse(http.StatusUnauthorized, "Challenge token is invalid or expired", nil, nil)
+	}
+	if superadmin.TwoFactor.IsEnabled {
+		return helpers.NewResponse(http.StatusBadRequest, "Two factor authentication already enabled", nil, nil)
+	}
+
+	// start setup
+	result, err := u.startTwoFactorSetup(ctx, superadmin)
+	if err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+
+	return helpers.NewResponse(http.StatusOK, "Scan the qr code then verify a code to finish login", nil, result)
+}
+
+func (u *superadminAppUsecase) VerifyTwoFactor(ctx context.Context, payload request.TwoFactorVerifyRequest, clientIP string) helpers.Response {
+	ctx, cancel := context.WithTimeout(ctx, u.contextTimeout)
+	defer cancel()
+
+	// validate payload
+	errValidation := make(map[string]string)
+	if payload.ChallengeToken == "" {
+		errValidation["challengeToken"] = "Challenge token field is required"
+	}
+	if payload.Code == "" && payload.RecoveryCode == "" {
+		errValidation["code"] = "Code or recovery code field is required"
+	}
+	if len(errValidation) > 0 {
+		return helpers.NewResponse(http.StatusUnprocessableEntity, "Validation Error", errValidation, nil)
+	}
+
+	// check challenge
+	superadmin, err := u.fetchTwoFactorChallenge(ctx, payload.ChallengeToken)
+	if err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+	if superadmin == nil {
+		return helpers.NewResponse(http.StatusUnauthorized, "Challenge token is invalid or expired", nil, nil)
+	}
+	account := ""
+	if superadmin.Email != nil {
+		account = *superadmin.Email
+	}
+
+	// check failed login backoff and lockout, a wrong code counts as a failed login
+	message, err := u.checkLoginAttempt(ctx, account, clientIP)
+	if err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+	if message != "" {
+		return helpers.NewResponse(http.StatusTooManyRequests, message, nil, nil)
+	}
+
+	var recoveryCodes []string
+	if superadmin.TwoFactor.IsEnabled {
+		// check code
+		ok, err := u.useTwoFactorCode(ctx, superadmin, payload.Code, payload.RecoveryCode)
+		if err != nil {
+			return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+		}
+		if !ok {
+			u.recordLoginFailure(ctx, account, clientIP, superadmin)
+			return helpers.NewResponse(http.StatusBadRequest, errInvalidTwoFactorCode.Error(), nil, nil)
+		}
+	} else {
+		// mandatory setup during login
+		if superadmin.TwoFactor.PendingSecret == "" {
+			return helpers.NewResponse(http.StatusBadRequest, "Two factor authentication setup is required", nil, nil)
+		}
+		recoveryCodes, err = u.enableTwoFactor(ctx, superadmin, payload.Code)
+		if err != nil {
+			if errors.Is(err, errInvalidTwoFactorCode) {
+				u.recordLoginFailure(ctx, account, clientIP, superadmin)
+				return helpers.NewResponse(http.StatusBadRequest, err.Error(), nil, nil)
+			}
+			return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+		}
+	}
+	u.clearLoginAttempt(ctx, account)
+
+	// create session and generate token
+	result, err := u.createAuthSession(ctx, superadmin)
+	if err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+	result["user"] = superadmin
+	if recoveryCodes != nil {
+		result["recoveryCodes"] = recoveryCodes
+	}
+
+	return helpers.NewResponse(http.StatusOK, "Login successful", nil, result)
+}
+
+func (u *superadminAppUsecase) SetupTwoFactor(ctx context.Context, claim jwt_helpers.SuperadminJWTClaims) helpers.Response {
+	ctx, cancel := context.WithTimeout(ctx, u.contextTimeout)
+	defer cancel()
+
+	// check superadmin
+	superadmin, err := u.mongoDbRepo.FetchOneSuperadmin(ctx, map[string]interface{}{
+		"id": claim.UserID,
+	})
+	if err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+	if superadmin == nil {
+		return helpers.NewResponse(http.StatusBadRequest, "User not found", nil, nil)
+	}
+	if superadmin.TwoFactor.IsEnabled {
+		return helpers.NewResponse(http.StatusBadRequest, "Two factor authentication already enabled", nil, nil)
+	}
+
+	// start setup
+	result, err := u.startTwoFactorSetup(ctx, superadmin)
+	if err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+
+	return helpers.NewResponse(http.StatusOK, "Scan the qr code then enable with a code", nil, result)
+}
+
+func (u *superadminAppUsecase) EnableTwoFactor(ctx context.Context, claim jwt_helpers.SuperadminJWTClaims, payload request.TwoFactorCodeRequest) helpers.Response {
+	ctx, cancel := context.WithTimeout(ctx, u.contextTimeout)
+	defer cancel()
+
+	// validate payload
+	errValidation := make(map[string]string)
+	if payload.Code == "" {
+		errValidation["code"] = "Code field is required"
+	}
+	if len(errValidation) > 0 {
+		return helpers.NewResponse(http.StatusUnprocessableEntity, "Validation Error", errValidation, nil)
+	}
+
+	// check superadmin
+	superadmin, err := u.mongoDbRepo.FetchOneSuperadmin(ctx, map[string]interface{}{
+		"id": claim.UserID,
+	})
+	if err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+	if superadmin == nil {
+		return helpers.NewResponse(http.StatusBadRequest, "User not found", nil, nil)
+	}
+	before := helpers.AuditSnapshot(superadmin)
+	if superadmin.TwoFactor.IsEnabled {
+		return helpers.NewResponse(http.StatusBadRequest, "Two factor authentication already enabled", nil, nil)
+	}
+	if superadmin.TwoFactor.PendingSecret == "" {
+		return helpers.NewResponse(http.StatusBadRequest, "Two factor authentication setup has not been started", nil, nil)
+	}
+
+	// enable
+	recoveryCodes, err := u.enableTwoFactor(ctx, superadmin, payload.Code)
+	if err != nil {
+		if errors.Is(err, errInvalidTwoFactorCode) {
+			return helpers.NewResponse(http.StatusBadRequest, err.Error(), nil, nil)
+		}
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+	u.recordAuditLog(ctx, mongo_model.AuditActionEnable2FA, mongo_model.AuditEntitySuperadmin, superadmin.ID.Hex(), before, superadmin)
+
+	return helpers.NewResponse(http.StatusOK, "Two factor authentication enabled, store the recovery codes safely", nil, map[string]any{
+		"recoveryCodes": recoveryCodes,
+	})
+}
+
+func (u *superadminAppUsecase) DisableTwoFactor(ctx context.Context, claim jwt_helpers.SuperadminJWTClaims, payload request.TwoFactorDisableRequest) helpers.Response {
+	ctx, cancel := context.WithTimeout(ctx, u.contextTimeout)
+	defer cancel()
+
+	// validate payload
+	errValidation := make(map[string]string)
+	if payload.Password == "" {
+		errValidation["password"] = "Password field is required"
+	}
+	if payload.Code == "" {
+		errValidation["code"] = "Code field is required"
+	}
+	if len(errValidation) > 0 {
+		return helpers.NewResponse(http.StatusUnprocessableEntity, "Validation Error", errValidation, nil)
+	}
+	if helpers.GetSuperadminTwoFactorRequired() {
+		return helpers.NewResponse(http.StatusBadRequest, "Two factor authentication is mandatory on this deployment", nil, nil)
+	}
+
+	// check superadmin
+	superadmin, err := u.mongoDbRepo.FetchOneSuperadmin(ctx, map[string]interface{}{
+		"id": claim.UserID,
+	})
+	if err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+	if superadmin == nil {
+		return helpers.NewResponse(http.StatusBadRequest, "User not found", nil, nil)
+	}
+	before := helpers.AuditSnapshot(superadmin)
+	if !superadmin.TwoFactor.IsEnabled {
+		return helpers.NewResponse(http.StatusBadRequest, "Two factor authentication is not enabled", nil, nil)
+	}
+
+	// check password and code
+	if err := bcrypt.CompareHashAndPassword([]byte(superadmin.Password), []byte(payload.Password)); err != nil {
+		return helpers.NewResponse(http.StatusBadRequest, "Wrong password", nil, nil)
+	}
+	ok, err := u.useTwoFactorCode(ctx, superadmin, payload.Code, "")
+	if err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+	if !ok {
+		return helpers.NewResponse(http.StatusBadRequest, errInvalidTwoFactorCode.Error(), nil, nil)
+	}
+
+	// disable
+	superadmin.TwoFactor = mongo_model.TwoFactor{}
+	if err := u.mongoDbRepo.UpdatePartialSuperadmin(ctx, map[string]interface{}{
+		"id": superadmin.ID,
+	}, map[string]interface{}{
+		"twoFactor": superadmin.TwoFactor,
+		"updatedAt": time.Now(),
+	}); err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+	u.recordAuditLog(ctx, mongo_model.AuditActionDisable2FA, mongo_model.AuditEntitySuperadmin, superadmin.ID.Hex(), before, superadmin)
+
+	return helpers.NewResponse(http.StatusOK, "Two factor authentication disabled", nil, nil)
+}
+
+func (u *superadminAppUsecase) RegenerateRecoveryCodes(ctx context.Context, claim jwt_helpers.SuperadminJWTClaims, payload request.TwoFactorCodeRequest) helpers.Response {
+	ctx, cancel := context.WithTimeout(ctx, u.contextTimeout)
+	defer cancel()
+
+	// validate payload
+	errValidation := make(map[string]string)
+	if payload.Code == "" {
+		errValidation["code"] = "Code field is required"
+	}
+	if len(errValidation) > 0 {
+		return helpers.NewResponse(http.StatusUnprocessableEntity, "Validation Error", errValidation, nil)
+	}
+
+	// check superadmin
+	superadmin, err := u.mongoDbRepo.FetchOneSuperadmin(ctx, map[string]interface{}{
+		"id": claim.UserID,
+	})
+	if err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+	if superadmin == nil {
+		return helpers.NewResponse(http.StatusBadRequest, "User not found", nil, nil)
+	}
+	before := helpers.AuditSnapshot(superadmin)
+	if !superadmin.TwoFactor.IsEnabled {
+		return helpers.NewResponse(http.StatusBadRequest, "Two factor authentication is not enabled", nil, nil)
+	}
+
+	// check code
+	ok, err := u.useTwoFactorCode(ctx, superadmin, payload.Code, "")
+	if err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+	if !ok {
+		return helpers.NewResponse(http.StatusBadRequest, errInvalidTwoFactorCode.Error(), nil, nil)
+	}
+
+	// replace every recovery code
+	recoveryCodes, hashes, err := generateRecoveryCodes()
+	if err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+	superadmin.TwoFactor.RecoveryCodes = hashes
+	superadmin.UpdatedAt = time.Now()
+	if err := u.mongoDbRepo.UpdatePartialSuperadmin(ctx, map[string]interface{}{
+		"id": superadmin.ID,
+	}, map[string]interface{}{
+		"twoFactor.recoveryCodes": superadmin.TwoFactor.RecoveryCodes,
+		"updatedAt":               superadmin.UpdatedAt,
+	}); err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+	u.recordAuditLog(ctx, mongo_model.AuditActionRegenerate, mongo_model.AuditEntitySuperadmin, superadmin.ID.Hex(), before, superadmin)
+
+	return helpers.NewResponse(http.StatusOK, "Recovery codes regenerated, store them safely", nil, map[string]any{
+		"recoveryCodes": recoveryCodes,
+	})
+}
@@ -120,6 +120,7 @@ func (u *superadminAppUsecase) CreateVenue(ctx context.Context, payload request.
 	if err != nil {
 		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
 	}
+	u.recordAuditLog(ctx, mongo_model.AuditActionCreate, mongo_model.AuditEntityVenue, venue.ID.Hex(), nil, venue)
 
 	return helpers.NewResponse(http.StatusCreated, "Create venue success", nil, venue)
 }
@@ -149,6 +150,7 @@ func (u *superadminAppUsecase) UpdateVenue(ctx context.Context, id string, paylo
 	}
 
 	// update venue
+	before := helpers.AuditSnapshot(venue)
 	venue.Name = payload.Name
 	venue.UpdatedAt = time.Now()
 
@@ -162,6 +164,7 @@ func (u *superadminAppUsecase) UpdateVenue(ctx context.Context, id string, paylo
 	if err != nil {
 		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
 	}
+	u.recordAuditLog(ctx, mongo_model.AuditActionUpdate, mongo_model.AuditEntityVenue, venue.ID.Hex(), before, venue)
 
 	return helpers.NewResponse(http.StatusOK, "Update venue success", nil, venue)
 }
@@ -182,6 +185,7 @@ func (u *superadminAppUsecase) DeleteVenue(ctx context.Context, id string) helpe
 	}
 
 	// delete venue
+	before := helpers.AuditSnapshot(venue)
 	now := time.Now()
 	venue.DeletedAt = &now
 
@@ -194,6 +198,7 @@ func (u *superadminAppUsecase) DeleteVenue(ctx context.Context, id string) helpe
 	if err != nil {
 		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
 	}
+	u.recordAuditLog(ctx, mongo_model.AuditActionDelete, mongo_model.AuditEntityVenue, venue.ID.Hex(), before, venue)
 
 	return helpers.NewResponse(http.StatusOK, "Delete venue success", nil, nil)
 }
@@ -290,6 +290,7 @@ func (u *superadminAppUsecase) CreateVoting(ctx context.Context, payload request
 	if err := u.mongoDbRepo.CreateOneVoting(ctx, voting); err != nil {
 		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
 	}
+	u.recordAuditLog(ctx, mongo_model.AuditActionCreate, mongo_model.AuditEntityVoting, voting.ID.Hex(), nil, voting)
 
 	return helpers.NewResponse(http.StatusCreated, "Voting created", nil, voting.Format())
 }
@@ -308,6 +309,7 @@ func (u *superadminAppUsecase) UpdateVoting(ctx context.Context, id string, payl
 	if voting == nil {
 		return helpers.NewResponse(http.StatusBadRequest, "Voting not found", nil, nil)
 	}
+	before := helpers.AuditSnapshot(voting)
 
 	// update if not empty
 	if payload.SeriesID != "" {
@@ -421,6 +423,7 @@ func (u *superadminAppUsecase) UpdateVoting(ctx context.Context, id string, payl
 	if err != nil {
 		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
 	}
+	u.recordAuditLog(ctx, mongo_model.AuditActionUpdate, mongo_model.AuditEntityVoting, voting.ID.Hex(), before, voting)
 
 	return helpers.NewResponse(http.StatusOK, "Success", nil, voting.Format())
 }
@@ -439,6 +442,7 @@ func (u *superadminAppUsecase) DeleteVoting(ctx context.Context, id string) help
 	if voting == nil {
 		return helpers.NewResponse(http.StatusBadRequest, "Voting not found", nil, nil)
 	}
+	before := helpers.AuditSnapshot(voting)
 
 	// timestamp
 	now := time.Now()
@@ -462,6 +466,7 @@ func (u *superadminAppUsecase) DeleteVoting(ctx context.Context, id string) help
 
 	// mark media unused in bg
 	go u.markMediaAsUnusedByIds(context.Background(), []string{voting.Banner.ID})
+	u.recordAuditLog(ctx, mongo_model.AuditActionDelete, mongo_model.AuditEntityVoting, voting.ID.Hex(), before, voting)
 
 	return helpers.NewResponse(http.StatusOK, "Delete voting success", nil, nil)
 }
@@ -1,6 +1,7 @@
 package webhook_usecase
 
 import (
+	shared_usecase "app/app/usecase/shared"
 	mongo_model "app/domain/model/mongo"
 	"app/domain/request"
 	"app/helpers"
@@ -9,7 +10,6 @@ import (
 	"net/http"
 	"time"
 
-	"github.com/google/uuid"
 	"github.com/sirupsen/logrus"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 )
@@ -71,49 +71,54 @@ func (u *webhookAppUsecase) paidPurchase(ctx context.Context, payload request.Sn
 		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
 	}
 
-	// get venue
-	venue, err := u.mongoDbRepo.FetchOneVenue(ctx, map[string]interface{}{
-		"id": purchase.Tickets[0].VenueID,
+	// read the ticket days again, a season pass sync may have added days since the purchase was read
+	purchase, err = u.mongoDbRepo.FetchOnePurchase(ctx, map[string]interface{}{
+		"id": purchase.ID,
 	})
 	if err != nil {
 		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
 	}
-	if venue == nil {
-		return helpers.NewResponse(http.StatusBadRequest, "Venue not found", nil, nil)
+	if purchase == nil {
+		return helpers.NewResponse(http.StatusBadRequest, "Purchase not found", nil, nil)
 	}
 
-	// create array of ticket purchases
-	var ticketPurchases []*mongo_model.TicketPurchase
-	for _, ticket := range purchase.Tickets {
-		for a := 0; a < int(purchase.Amount); a++ {
-			ticketPurchase := &mongo_model.TicketPurchase{
-				ID:     primitive.NewObjectID(),
-				Member: purchase.Member,
-				Ticket: ticket,
-				Venue: mongo_model.VenueFK{
-					ID:   venue.ID.Hex(),
-					Name: venue.Name,
-				},
-				PurchaseID: purchase.ID.Hex(),
-				Code:       uuid.NewString(),
-				IsUsed:     false,
-				UsedAt:     nil,
-				CreatedAt:  now,
-				UpdatedAt:  now,
-			}
+	// season pass bought before any ticket day exists, codes are issued when ticket days are created
+	if len(purchase.Tickets) == 0 {
+		return helpers.NewResponse(http.StatusOK, "Purchase paid successfully", nil, purchase)
+	}
 
-			ticketPurchases = append(ticketPurchases, ticketPurchase)
-		}
+	// ticket day cancelled while waiting for payment gets refund instead of ticket
+	cancelledTicketIds, err := u.refundCancelledTickets(ctx, purchase)
+	if err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
 	}
 
-	// create ticket purchases
-	err = u.mongoDbRepo.CreateManyTicketPurchase(ctx, ticketPurchases)
+	// get venue of each ticket day
+	venueIds := helpers.ExtractIds(purchase.Tickets, func(m mongo_model.TicketFK) string {
+		return m.VenueID
+	})
+	venueMap, err := shared_usecase.FetchVenueMap(ctx, u.mongoDbRepo, venueIds)
 	if err != nil {
 		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
 	}
 
-	// send email to member
-	go mailing_helpers.SendTicketPurchase(ticketPurchases)
+	// issue ticket purchases of ticket days which are not cancelled
+	var ticketsFK []mongo_model.TicketFK
+	for _, ticket := range purchase.Tickets {
+		if _, ok := cancelledTicketIds[ticket.ID]; ok {
+			continue
+		}
+		ticketsFK = append(ticketsFK, ticket)
+	}
+
+	if len(ticketsFK) == 0 {
+		return helpers.NewResponse(http.StatusOK, "Purchase paid successfully", nil, purchase)
+	}
+
+	_, err = shared_usecase.IssueTicketPurchases(ctx, u.mongoDbRepo, purchase, ticketsFK, venueMap)
+	if err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
 
 	return helpers.NewResponse(http.StatusOK, "Ticket purchase generated successfully", nil, purchase)
 }
@@ -134,11 +139,31 @@ func (u *webhookAppUsecase) restoreQuota(ctx context.Context, purchase *mongo_mo
 		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
 	}
 
+	// read the ticket days again, a season pass sync may have added days since the purchase was read
+	failedPurchase, err := u.mongoDbRepo.FetchOnePurchase(ctx, map[string]interface{}{
+		"id": purchase.ID,
+	})
+	if err != nil {
+		return helpers.NewResponse(http.StatusInternalServerError, err.Error(), nil, nil)
+	}
+	if failedPurchase != nil {
+		purchase = failedPurchase
+	}
+
 	// restore quota for each ticket in bg
 	go func() {
 		ctx, cancel := context.WithTimeout(context.Background(), u.contextTimeout)
 		defer cancel()
 
+		if purchase.IsSeasonPass {
+			err := u.mongoDbRepo.IncrementOneSeason(ctx, purchase.Season.ID, map[string]int64{
+				"pass.quota.used": purchase.Amount * -1,
+			})
+			if err != nil {
+				logrus.Error("IncrementOneSeason:", err)
+			}
+		}
+
 		for _, p := range purchase.Tickets {
 			err := u.mongoDbRepo.IncrementOneTicket(ctx, p.ID, map[string]int64{
 				"quota.used": purchase.Amount * -1,
@@ -151,3 +176,68 @@ func (u *webhookAppUsecase) restoreQuota(ctx context.Context, purchase *mongo_mo
 
 	return helpers.NewResponse(http.StatusOK, "Quota restored successfully", nil, nil)
 }
+
+// refundCancelledTickets queues refund for ticket days of the purchase which were cancelled
+// before the payment was received, returns the cancelled ticket ids
+func (u *webhookAppUsecase) refundCancelledTickets(ctx context.Context, purchase *mongo_model.Purchase) (map[string]struct{}, error) {
+	cancelledTicketIds := make(map[string]struct{})
+
+	ticketIds := helpers.ExtractIds(purchase.Tickets, func(m mongo_model.TicketFK) string {
+		return m.ID
+	})
+	cur, err := u.mongoDbRepo.FetchListTicket(ctx, map[string]interface{}{
+		"ids":         ticketIds,
+		"isCancelled": true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer cur.Close(ctx)
+
+	refunded, err := shared_usecase.FetchRefundedTotal(ctx, u.mongoDbRepo, purchase.ID.Hex())
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	for cur.Next(ctx) {
+		var ticket mongo_model.Ticket
+		if err := cur.Decode(&ticket); err != nil {
+			logrus.Error("Ticket Decode:", err)
+			return nil, err
+		}
+		cancelledTicketIds[ticket.ID.Hex()] = struct{}{}
+
+		refund := mongo_model.Refund{
+			ID:         primitive.NewObjectID(),
+			PurchaseID: purchase.ID.Hex(),
+			Invoice:    purchase.Invoice,
+			Channel:    purchase.Format().Channel,
+			Member:     purchase.Member,
+			Ticket: mongo_model.TicketFK{
+				ID:      ticket.ID.Hex(),
+				Name:    ticket.Name,
+				Date:    ticket.Date,
+				VenueID: ticket.GetVenueID(),
+			},
+			Reason:    mongo_model.RefundReasonTicketDayCancelled,
+			Amount:    purchase.RefundShare(ticket.ID.Hex(), ticket.Price, refunded),
+			Status:    mongo_model.RefundStatusPending,
+			CreatedAt: now,
+			UpdatedAt: now,
+		}
+		created, err := u.mongoDbRepo.CreateOneRefundIfNotExists(ctx, &refund)
+		if err != nil {
+			return nil, err
+		}
+		if !created {
+			continue
+		}
+		refunded += refund.Amount
+
+		// notify member
+		go mailing_helpers.SendTicketDayCancelled(&refund, ticket.CancelReason)
+	}
+
+	return cancelledTicketIds, nil
+}
@@ -7,13 +7,26 @@ import (
 )
 
 type Admin struct {
-	ID            primitive.ObjectID `bson:"_id" json:"id"`
-	Name          string             `bson:"name" json:"name"`
-	Email         string             `bson:"email" json:"email"`
-	Username      string             `bson:"username" json:"username"`
-	Password      string             `bson:"password" json:"-"`
-	PasswordToken string             `bson:"passwordToken" json:"-"`
-	CreatedAt     time.Time          `bson:"createdAt" json:"createdAt"`
-	UpdatedAt     time.Time          `bson:"updatedAt" json:"updatedAt"`
-	DeletedAt     *time.Time         `bson:"deletedAt" json:"-"`
+	ID                     primitive.ObjectID `bson:"_id" json:"id"`
+	Name                   string             `bson:"name" json:"name"`
+	Email                  string             `bson:"email" json:"email"`
+	Username               string             `bson:"username" json:"username"`
+	Password               string             `bson:"password" json:"-"`
+	PasswordToken          string             `bson:"passwordToken" json:"-"`
+	PasswordTokenExpiredAt *time.Time         `bson:"passwordTokenExpiredAt" json:"-"`
+	PasswordChangedAt      *time.Time         `bson:"passwordChangedAt" json:"-"`
+	TokenVersion           int                `bson:"tokenVersion" json:"-"`
+	Venue                  VenueFK            `bson:"venue" json:"venue"`
+	IsDisabled             bool               `bson:"isDisabled" json:"isDisabled"`
+	DisabledAt             *time.Time         `bson:"disabledAt" json:"disabledAt"`
+	InvitedAt              *time.Time         `bson:"invitedAt" json:"invitedAt"`
+	LastLoginAt            *time.Time         `bson:"lastLoginAt" json:"lastLoginAt"`
+	CreatedAt              time.Time          `bson:"createdAt" json:"createdAt"`
+	UpdatedAt              time.Time          `bson:"updatedAt" json:"updatedAt"`
+	DeletedAt              *time.Time         `bson:"deletedAt" json:"-"`
+}
+
+type AdminFK struct {
+	ID   string `bson:"id" json:"id"`
+	Name string `bson:"name" json:"name"`
 }
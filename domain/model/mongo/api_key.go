@@ -0,0 +1,90 @@
+package mongo_model
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// APIKey authenticates a partner integration on the partner routes, only the hash of the key is stored,
+// the plain key is shown once when it is created
+type APIKey struct {
+	ID              primitive.ObjectID `bson:"_id" json:"id"`
+	Name            string             `bson:"name" json:"name"`
+	Description     string             `bson:"description" json:"description"`
+	Prefix          string             `bson:"prefix" json:"prefix"`
+	KeyHash         string             `bson:"keyHash" json:"-"`
+	Scopes          []APIKeyScope      `bson:"scopes" json:"scopes"`
+	RateLimit       int                `bson:"rateLimit" json:"rateLimit"`
+	ExpiredAt       *time.Time         `bson:"expiredAt" json:"expiredAt"`
+	RevokedAt       *time.Time         `bson:"revokedAt" json:"revokedAt"`
+	LastUsedAt      *time.Time         `bson:"lastUsedAt" json:"lastUsedAt"`
+	RateWindowStart *time.Time         `bson:"rateWindowStart" json:"-"`
+	RateCount       int                `bson:"rateCount" json:"-"`
+	Status          APIKeyStatus       `bson:"-" json:"status"`
+	CreatedBy       ActorFK            `bson:"createdBy" json:"createdBy"`
+	CreatedAt       time.Time          `bson:"createdAt" json:"createdAt"`
+	UpdatedAt       time.Time          `bson:"updatedAt" json:"updatedAt"`
+	DeletedAt       *time.Time         `bson:"deletedAt" json:"-"`
+}
+
+type APIKeyStatus string
+
+const (
+	APIKeyStatusActive  APIKeyStatus = "active"
+	APIKeyStatusExpired APIKeyStatus = "expired"
+	APIKeyStatusRevoked APIKeyStatus = "revoked"
+)
+
+type APIKeyScope string
+
+const (
+	APIKeyScopeFixturesRead   APIKeyScope = "fixtures.read"
+	APIKeyScopeAttendanceRead APIKeyScope = "attendance.read"
+	APIKeyScopeStandingsRead  APIKeyScope = "standings.read"
+)
+
+type APIKeyScopeInfo struct {
+	Key         APIKeyScope `json:"key"`
+	Description string      `json:"description"`
+}
+
+// APIKeyScopeList every scope a partner key can be given
+var APIKeyScopeList = []APIKeyScopeInfo{
+	{Key: APIKeyScopeFixturesRead, Description: "Read fixtures of ticket days and their matches"},
+	{Key: APIKeyScopeAttendanceRead, Description: "Read live attendance of ticket days"},
+	{Key: APIKeyScopeStandingsRead, Description: "Read league standings of seasons"},
+}
+
+func IsValidAPIKeyScope(scope string) bool {
+	for _, s := range APIKeyScopeList {
+		if string(s.Key) == scope {
+			return true
+		}
+	}
+	return false
+}
+
+func (k *APIKey) GetStatus(now time.Time) APIKeyStatus {
+	if k.RevokedAt != nil {
+		return APIKeyStatusRevoked
+	}
+	if k.ExpiredAt != nil && !now.Before(*k.ExpiredAt) {
+		return APIKeyStatusExpired
+	}
+	return APIKeyStatusActive
+}
+
+func (k *APIKey) HasScope(scope APIKeyScope) bool {
+	for _, s := range k.Scopes {
+		if s == scope {
+			return true
+		}
+	}
+	return false
+}
+
+func (k *APIKey) Format(now time.Time) *APIKey {
+	k.Status = k.GetStatus(now)
+	return k
+}
@@ -0,0 +1,71 @@
+package mongo_model
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// AuditLog records one back office mutation, before and after only hold the fields that changed
+type AuditLog struct {
+	ID         primitive.ObjectID `bson:"_id" json:"id"`
+	Actor      ActorFK            `bson:"actor" json:"actor"`
+	Action     AuditAction        `bson:"action" json:"action"`
+	EntityType AuditEntity        `bson:"entityType" json:"entityType"`
+	EntityID   string             `bson:"entityId" json:"entityId"`
+	Before     bson.M             `bson:"before" json:"before"`
+	After      bson.M             `bson:"after" json:"after"`
+	IP         string             `bson:"ip" json:"ip"`
+	UserAgent  string             `bson:"userAgent" json:"userAgent"`
+	CreatedAt  time.Time          `bson:"createdAt" json:"createdAt"`
+	UpdatedAt  time.Time          `bson:"updatedAt" json:"updatedAt"`
+	DeletedAt  *time.Time         `bson:"deletedAt" json:"-"`
+}
+
+type AuditAction string
+
+const (
+	AuditActionCreate       AuditAction = "create"
+	AuditActionUpdate       AuditAction = "update"
+	AuditActionDelete       AuditAction = "delete"
+	AuditActionUpdateStatus AuditAction = "update_status"
+	AuditActionUpdateRole   AuditAction = "update_role"
+	AuditActionResendInvite AuditAction = "resend_invite"
+	AuditActionUnlock       AuditAction = "unlock"
+	AuditActionCancel       AuditAction = "cancel"
+	AuditActionReschedule   AuditAction = "reschedule"
+	AuditActionReissue      AuditAction = "reissue"
+	AuditActionScan         AuditAction = "scan"
+	AuditActionEnable2FA    AuditAction = "enable_2fa"
+	AuditActionDisable2FA   AuditAction = "disable_2fa"
+	AuditActionRegenerate   AuditAction = "regenerate_recovery_codes"
+	AuditActionRevoke       AuditAction = "revoke"
+	AuditActionImpersonate  AuditAction = "impersonate"
+)
+
+type AuditEntity string
+
+const (
+	AuditEntityAdmin            AuditEntity = "admin"
+	AuditEntitySuperadmin       AuditEntity = "superadmin"
+	AuditEntityRole             AuditEntity = "role"
+	AuditEntityLoginAttempt     AuditEntity = "login_attempt"
+	AuditEntitySeason           AuditEntity = "season"
+	AuditEntitySeasonTeam       AuditEntity = "season_team"
+	AuditEntitySeasonTeamPlayer AuditEntity = "season_team_player"
+	AuditEntityVenue            AuditEntity = "venue"
+	AuditEntityTeam             AuditEntity = "team"
+	AuditEntityPlayer           AuditEntity = "player"
+	AuditEntitySeries           AuditEntity = "series"
+	AuditEntityTicket           AuditEntity = "ticket"
+	AuditEntityTicketPurchase   AuditEntity = "ticket_purchase"
+	AuditEntityPurchase         AuditEntity = "purchase"
+	AuditEntityRefund           AuditEntity = "refund"
+	AuditEntityVoting           AuditEntity = "voting"
+	AuditEntityCandidate        AuditEntity = "candidate"
+	AuditEntityAPIKey           AuditEntity = "api_key"
+	AuditEntityMember           AuditEntity = "member"
+	AuditEntityMatch            AuditEntity = "match"
+	AuditEntityMatchEvent       AuditEntity = "match_event"
+)
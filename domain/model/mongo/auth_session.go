@@ -0,0 +1,28 @@
+package mongo_model
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// AuthSession is one logged in device, its refresh token is rotated on every refresh
+type AuthSession struct {
+	ID                       primitive.ObjectID `bson:"_id" json:"id"`
+	Role                     ActorRole          `bson:"role" json:"role"`
+	UserID                   string             `bson:"userId" json:"userId"`
+	RefreshTokenHash         string             `bson:"refreshTokenHash" json:"-"`
+	PreviousRefreshTokenHash string             `bson:"previousRefreshTokenHash" json:"-"`
+	TokenVersion             int                `bson:"tokenVersion" json:"-"`
+	ExpiredAt                time.Time          `bson:"expiredAt" json:"expiredAt"`
+	LastRefreshedAt          *time.Time         `bson:"lastRefreshedAt" json:"lastRefreshedAt"`
+	RevokedAt                *time.Time         `bson:"revokedAt" json:"revokedAt"`
+	ImpersonatedBy           *ActorFK           `bson:"impersonatedBy" json:"impersonatedBy"`
+	CreatedAt                time.Time          `bson:"createdAt" json:"createdAt"`
+	UpdatedAt                time.Time          `bson:"updatedAt" json:"updatedAt"`
+	DeletedAt                *time.Time         `bson:"deletedAt" json:"-"`
+}
+
+func (s *AuthSession) IsActive(now time.Time) bool {
+	return s.RevokedAt == nil && now.Before(s.ExpiredAt)
+}
@@ -24,6 +24,11 @@ var PlayerPositionList = []string{
 	"Anchor",
 }
 
+var GenderList = []string{
+	"male",
+	"female",
+}
+
 type SeriesStatus int
 
 const (
@@ -43,6 +48,30 @@ var SeriesStatusMap = map[SeriesStatus]SeriesStatusStruct{
 	SeriesStatusDraft:     {ID: SeriesStatusDraft, Name: "Draft"},
 }
 
+type SaleStatus string
+
+const (
+	SaleStatusUpcoming SaleStatus = "upcoming"
+	SaleStatusOpen     SaleStatus = "open"
+	SaleStatusClosed   SaleStatus = "closed"
+)
+
+type MatchStatus string
+
+const (
+	MatchStatusScheduled MatchStatus = "scheduled"
+	MatchStatusLive      MatchStatus = "live"
+	MatchStatusFinished  MatchStatus = "finished"
+	MatchStatusPostponed MatchStatus = "postponed"
+)
+
+var MatchStatusList = []MatchStatus{
+	MatchStatusScheduled,
+	MatchStatusLive,
+	MatchStatusFinished,
+	MatchStatusPostponed,
+}
+
 type VotingStatus int
 
 const (
@@ -80,3 +109,71 @@ var PurchaseStatusMap = map[PurchaseStatus]PurchaseStatusStruct{
 	PurchaseStatusPending: {ID: PurchaseStatusPending, Name: "Pending"},
 	PurchaseStatusFailed:  {ID: PurchaseStatusFailed, Name: "Failed"},
 }
+
+type PurchaseChannel string
+
+const (
+	PurchaseChannelOnline    PurchaseChannel = "online"
+	PurchaseChannelBoxOffice PurchaseChannel = "box_office"
+)
+
+type BoxOfficePaymentMethod string
+
+const (
+	BoxOfficePaymentMethodCash BoxOfficePaymentMethod = "cash"
+	BoxOfficePaymentMethodEdc  BoxOfficePaymentMethod = "edc"
+)
+
+var BoxOfficePaymentMethodList = []BoxOfficePaymentMethod{
+	BoxOfficePaymentMethodCash,
+	BoxOfficePaymentMethodEdc,
+}
+
+type ActorRole string
+
+const (
+	ActorRoleSuperadmin ActorRole = "superadmin"
+	ActorRoleAdmin      ActorRole = "admin"
+	ActorRoleMember     ActorRole = "member"
+)
+
+type RefundStatus int
+
+const (
+	RefundStatusPending   RefundStatus = 1
+	RefundStatusProcessed RefundStatus = 2
+	RefundStatusRejected  RefundStatus = 3
+)
+
+type RefundStatusStruct struct {
+	ID   RefundStatus `json:"id"`
+	Name string       `json:"name"`
+}
+
+var RefundStatusMap = map[RefundStatus]RefundStatusStruct{
+	RefundStatusPending:   {ID: RefundStatusPending, Name: "Pending"},
+	RefundStatusProcessed: {ID: RefundStatusProcessed, Name: "Processed"},
+	RefundStatusRejected:  {ID: RefundStatusRejected, Name: "Rejected"},
+}
+
+type RefundReason string
+
+const (
+	RefundReasonTicketDayCancelled   RefundReason = "ticket_day_cancelled"
+	RefundReasonTicketDayRescheduled RefundReason = "ticket_day_rescheduled"
+)
+
+type JobStatus string
+
+const (
+	JobStatusRunning   JobStatus = "running"
+	JobStatusCompleted JobStatus = "completed"
+	JobStatusFailed    JobStatus = "failed"
+)
+
+type LoginAttemptType string
+
+const (
+	LoginAttemptTypeAccount LoginAttemptType = "account"
+	LoginAttemptTypeIP      LoginAttemptType = "ip"
+)
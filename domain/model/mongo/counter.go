@@ -0,0 +1,11 @@
+package mongo_model
+
+import "time"
+
+// Counter is a named sequence, the key is the document id so every key only has one counter
+type Counter struct {
+	ID        string    `bson:"_id" json:"id"`
+	Value     int64     `bson:"value" json:"value"`
+	CreatedAt time.Time `bson:"createdAt" json:"createdAt"`
+	UpdatedAt time.Time `bson:"updatedAt" json:"updatedAt"`
+}
@@ -0,0 +1,75 @@
+package mongo_model
+
+import (
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// LoginAttempt tracks failed logins of one account or one ip address for a role
+type LoginAttempt struct {
+	ID           primitive.ObjectID `bson:"_id" json:"id"`
+	Role         ActorRole          `bson:"role" json:"role"`
+	Type         LoginAttemptType   `bson:"type" json:"type"`
+	Identifier   string             `bson:"identifier" json:"identifier"`
+	Failures     int                `bson:"failures" json:"failures"`
+	LockCount    int                `bson:"lockCount" json:"lockCount"`
+	LastFailedAt *time.Time         `bson:"lastFailedAt" json:"lastFailedAt"`
+	RetryAt      *time.Time         `bson:"retryAt" json:"retryAt"`
+	LockedUntil  *time.Time         `bson:"lockedUntil" json:"lockedUntil"`
+	IsLocked     bool               `bson:"-" json:"isLocked"`
+	UnlockedBy   *ActorFK           `bson:"unlockedBy" json:"unlockedBy"`
+	UnlockedAt   *time.Time         `bson:"unlockedAt" json:"unlockedAt"`
+	CreatedAt    time.Time          `bson:"createdAt" json:"createdAt"`
+	UpdatedAt    time.Time          `bson:"updatedAt" json:"updatedAt"`
+	DeletedAt    *time.Time         `bson:"deletedAt" json:"-"`
+}
+
+func (a *LoginAttempt) Format(now time.Time) *LoginAttempt {
+	a.IsLocked = a.LockedUntil != nil && now.Before(*a.LockedUntil)
+	return a
+}
+
+// BlockedUntil returns until when a new login is refused, nil when login is allowed
+func (a *LoginAttempt) BlockedUntil(now time.Time) *time.Time {
+	var blockedUntil *time.Time
+	for _, until := range []*time.Time{a.LockedUntil, a.RetryAt} {
+		if until != nil && now.Before(*until) && (blockedUntil == nil || until.After(*blockedUntil)) {
+			blockedUntil = until
+		}
+	}
+	return blockedUntil
+}
+
+// LoginAttemptPolicy is how failures turn into a backoff or a lockout.
+// Every next lockout lasts twice as long capped to one day, the backoff doubles on every failure capped to five minutes
+// and only applies to the account counter, the ip counter only locks
+type LoginAttemptPolicy struct {
+	MaxAttempts     int
+	BackoffBase     time.Duration
+	LockoutDuration time.Duration
+}
+
+const (
+	LoginAttemptMaxLockout = 24 * time.Hour
+	LoginAttemptMaxBackoff = 5 * time.Minute
+)
+
+func LoginAttemptIdentifiers(account, ip string) map[LoginAttemptType]string {
+	identifiers := map[LoginAttemptType]string{
+		LoginAttemptTypeAccount: account,
+	}
+	if ip != "" {
+		identifiers[LoginAttemptTypeIP] = ip
+	}
+	return identifiers
+}
+
+func LoginBlockedMessage(blockedUntil, now time.Time) string {
+	wait := blockedUntil.Sub(now).Round(time.Second)
+	if wait < time.Second {
+		wait = time.Second
+	}
+	return fmt.Sprintf("Too many failed login attempts, please try again in %s", wait)
+}
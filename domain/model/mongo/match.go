@@ -0,0 +1,69 @@
+package mongo_model
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// MatchDefaultDuration is the minutes of a finished match when its duration was not recorded
+const MatchDefaultDuration = 90
+
+// Match is one fixture between two season teams, a ticket day links its matches by id
+// so the result is kept when the ticket day is edited. Duration is the minutes played including
+// stoppage time, set once the match is finished
+type Match struct {
+	ID               primitive.ObjectID `bson:"_id" json:"id"`
+	SeasonID         string             `bson:"seasonId" json:"seasonId"`
+	SeriesID         string             `bson:"seriesId" json:"seriesId"`
+	TicketID         string             `bson:"ticketId" json:"ticketId"`
+	HomeSeasonTeamID string             `bson:"homeSeasonTeamId" json:"homeSeasonTeamId"`
+	HomeSeasonTeam   SeasonTeamFK       `bson:"-" json:"homeSeasonTeam"`
+	AwaySeasonTeamID string             `bson:"awaySeasonTeamId" json:"awaySeasonTeamId"`
+	AwaySeasonTeam   SeasonTeamFK       `bson:"-" json:"awaySeasonTeam"`
+	VenueID          string             `bson:"venueId" json:"venueId"`
+	Venue            VenueFK            `bson:"-" json:"venue"`
+	KickoffAt        time.Time          `bson:"kickoffAt" json:"kickoffAt"`
+	Status           MatchStatus        `bson:"status" json:"status"`
+	Score            *MatchScore        `bson:"score" json:"score"`
+	ScoreVersion     int64              `bson:"scoreVersion" json:"-"`
+	Duration         int                `bson:"duration" json:"duration"`
+	CreatedAt        time.Time          `bson:"createdAt" json:"createdAt"`
+	UpdatedAt        time.Time          `bson:"updatedAt" json:"updatedAt"`
+	DeletedAt        *time.Time         `bson:"deletedAt" json:"-"`
+}
+
+// MatchScore is the final score, set once the match is finished
+type MatchScore struct {
+	Home int `bson:"home" json:"home"`
+	Away int `bson:"away" json:"away"`
+}
+
+func IsValidMatchStatus(status string) bool {
+	for _, s := range MatchStatusList {
+		if string(s) == status {
+			return true
+		}
+	}
+	return false
+}
+
+// ScoreFromEvents count the goals of the events, an own goal counts for the other team
+func (m *Match) ScoreFromEvents(events []MatchEvent) MatchScore {
+	score := MatchScore{}
+	for _, event := range events {
+		if event.Type != MatchEventTypeGoal {
+			continue
+		}
+		isHome := event.SeasonTeamPlayer.SeasonTeam.ID == m.HomeSeasonTeamID
+		if event.IsOwnGoal {
+			isHome = !isHome
+		}
+		if isHome {
+			score.Home++
+		} else {
+			score.Away++
+		}
+	}
+	return score
+}
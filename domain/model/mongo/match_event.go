@@ -0,0 +1,110 @@
+package mongo_model
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// MatchEvent is one moment of a match recorded by a match official, the match score is derived from its goals.
+// A start is recorded at minute 0 for every player of the starting lineup.
+// SubstitutedSeasonTeamPlayer is the player going off and only set on substitution,
+// an own goal counts for the opponent of the player's team
+type MatchEvent struct {
+	ID                          primitive.ObjectID  `bson:"_id" json:"id"`
+	MatchID                     string              `bson:"matchId" json:"matchId"`
+	Type                        MatchEventType      `bson:"type" json:"type"`
+	Minute                      int                 `bson:"minute" json:"minute"`
+	SeasonTeamPlayer            SeasonTeamPlayerFK  `bson:"seasonTeamPlayer" json:"seasonTeamPlayer"`
+	SubstitutedSeasonTeamPlayer *SeasonTeamPlayerFK `bson:"substitutedSeasonTeamPlayer" json:"substitutedSeasonTeamPlayer"`
+	IsOwnGoal                   bool                `bson:"isOwnGoal" json:"isOwnGoal"`
+	Note                        string              `bson:"note" json:"note"`
+	RecordedBy                  ActorFK             `bson:"recordedBy" json:"recordedBy"`
+	CreatedAt                   time.Time           `bson:"createdAt" json:"createdAt"`
+	UpdatedAt                   time.Time           `bson:"updatedAt" json:"updatedAt"`
+	DeletedAt                   *time.Time          `bson:"deletedAt" json:"-"`
+}
+
+type MatchEventType string
+
+const (
+	MatchEventTypeStart        MatchEventType = "start"
+	MatchEventTypeGoal         MatchEventType = "goal"
+	MatchEventTypeAssist       MatchEventType = "assist"
+	MatchEventTypeSave         MatchEventType = "save"
+	MatchEventTypeYellowCard   MatchEventType = "yellow_card"
+	MatchEventTypeRedCard      MatchEventType = "red_card"
+	MatchEventTypeFoul         MatchEventType = "foul"
+	MatchEventTypeSubstitution MatchEventType = "substitution"
+)
+
+var MatchEventTypeList = []MatchEventType{
+	MatchEventTypeStart,
+	MatchEventTypeGoal,
+	MatchEventTypeAssist,
+	MatchEventTypeSave,
+	MatchEventTypeYellowCard,
+	MatchEventTypeRedCard,
+	MatchEventTypeFoul,
+	MatchEventTypeSubstitution,
+}
+
+func IsValidMatchEventType(eventType string) bool {
+	for _, t := range MatchEventTypeList {
+		if string(t) == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+// PlayerStats is the count of recorded events of one season team player, an own goal is not counted as a goal.
+// Match is the number of matches the player started or has a recorded event in, coming on or going off included.
+// Minute is the minutes played, from kickoff for a starter or the minute coming on until the minute going off,
+// sent off or the end of the match. A player with events of a match without lineup is counted as a starter
+type PlayerStats struct {
+	Rank             int64              `bson:"-" json:"rank"`
+	SeasonTeamPlayer SeasonTeamPlayerFK `bson:"seasonTeamPlayer" json:"seasonTeamPlayer"`
+	Goal             int64              `bson:"goal" json:"goal"`
+	OwnGoal          int64              `bson:"ownGoal" json:"ownGoal"`
+	Assist           int64              `bson:"assist" json:"assist"`
+	Save             int64              `bson:"save" json:"save"`
+	YellowCard       int64              `bson:"yellowCard" json:"yellowCard"`
+	RedCard          int64              `bson:"redCard" json:"redCard"`
+	Foul             int64              `bson:"foul" json:"foul"`
+	Minute           int64              `bson:"minute" json:"minute"`
+	Match            int64              `bson:"match" json:"match"`
+}
+
+// PlayerStatsSort is the stat a leaderboard is ranked by, a card counts yellow and red cards.
+// A tie goes to the player with fewer minutes
+type PlayerStatsSort string
+
+const (
+	PlayerStatsSortGoal       PlayerStatsSort = "goal"
+	PlayerStatsSortAssist     PlayerStatsSort = "assist"
+	PlayerStatsSortSave       PlayerStatsSort = "save"
+	PlayerStatsSortYellowCard PlayerStatsSort = "yellowCard"
+	PlayerStatsSortRedCard    PlayerStatsSort = "redCard"
+	PlayerStatsSortCard       PlayerStatsSort = "card"
+	PlayerStatsSortMinute     PlayerStatsSort = "minute"
+)
+
+var PlayerStatsSortList = []PlayerStatsSort{
+	PlayerStatsSortGoal,
+	PlayerStatsSortAssist,
+	PlayerStatsSortSave,
+	PlayerStatsSortYellowCard,
+	PlayerStatsSortRedCard,
+	PlayerStatsSortCard,
+	PlayerStatsSortMinute,
+}
+
+func IsValidPlayerStatsSort(sort string) bool {
+	for _, s := range PlayerStatsSortList {
+		if string(s) == sort {
+			return true
+		}
+	}
+	return false
+}
@@ -7,20 +7,34 @@ import (
 )
 
 type Member struct {
-	ID            primitive.ObjectID `bson:"_id" json:"id"`
-	Name          string             `bson:"name" json:"name"`
-	Email         string             `bson:"email" json:"email"`
-	Password      string             `bson:"password" json:"-"`
-	Phone         *string            `bson:"phone" json:"phone"`
-	Age           *int               `bson:"age" json:"age"`
-	Gender        *string            `bson:"gender" json:"gender"`
-	EmailToken    string             `bson:"emailToken" json:"-"`
-	PasswordToken string             `bson:"passwordToken" json:"-"`
-	IsVerified    bool               `bson:"isVerified" json:"isVerified"`
-	VerifiedAt    *time.Time         `bson:"verifiedAt" json:"-"`
-	CreatedAt     time.Time          `bson:"createdAt" json:"createdAt"`
-	UpdatedAt     time.Time          `bson:"updatedAt" json:"updatedAt"`
-	DeletedAt     *time.Time         `bson:"deletedAt" json:"-"`
+	ID                     primitive.ObjectID `bson:"_id" json:"id"`
+	Name                   string             `bson:"name" json:"name"`
+	Email                  string             `bson:"email" json:"email"`
+	PendingEmail           *string            `bson:"pendingEmail" json:"pendingEmail"`
+	Password               string             `bson:"password" json:"-"`
+	Phone                  *string            `bson:"phone" json:"phone"`
+	Age                    *int               `bson:"age" json:"age"`
+	Gender                 *string            `bson:"gender" json:"gender"`
+	EmailToken             string             `bson:"emailToken" json:"-"`
+	EmailTokenExpiredAt    *time.Time         `bson:"emailTokenExpiredAt" json:"-"`
+	EmailTokenSentAt       *time.Time         `bson:"emailTokenSentAt" json:"-"`
+	PasswordToken          string             `bson:"passwordToken" json:"-"`
+	PasswordTokenExpiredAt *time.Time         `bson:"passwordTokenExpiredAt" json:"-"`
+	PasswordChangedAt      *time.Time         `bson:"passwordChangedAt" json:"-"`
+	TokenVersion           int                `bson:"tokenVersion" json:"-"`
+	IsVerified             bool               `bson:"isVerified" json:"isVerified"`
+	VerifiedAt             *time.Time         `bson:"verifiedAt" json:"-"`
+	OIDC                   *MemberOIDC        `bson:"oidc" json:"-"`
+	CreatedAt              time.Time          `bson:"createdAt" json:"createdAt"`
+	UpdatedAt              time.Time          `bson:"updatedAt" json:"updatedAt"`
+	DeletedAt              *time.Time         `bson:"deletedAt" json:"-"`
+}
+
+// MemberOIDC is the identity provider account linked to the member
+type MemberOIDC struct {
+	Issuer   string    `bson:"issuer" json:"issuer"`
+	Subject  string    `bson:"subject" json:"subject"`
+	LinkedAt time.Time `bson:"linkedAt" json:"linkedAt"`
 }
 
 type MemberFK struct {
@@ -0,0 +1,20 @@
+package mongo_model
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// OIDCState keeps the pkce verifier and nonce of one authorization request until the callback
+type OIDCState struct {
+	ID           primitive.ObjectID `bson:"_id" json:"id"`
+	State        string             `bson:"state" json:"-"`
+	CodeVerifier string             `bson:"codeVerifier" json:"-"`
+	Nonce        string             `bson:"nonce" json:"-"`
+	ExpiredAt    time.Time          `bson:"expiredAt" json:"expiredAt"`
+	UsedAt       *time.Time         `bson:"usedAt" json:"usedAt"`
+	CreatedAt    time.Time          `bson:"createdAt" json:"createdAt"`
+	UpdatedAt    time.Time          `bson:"updatedAt" json:"updatedAt"`
+	DeletedAt    *time.Time         `bson:"deletedAt" json:"-"`
+}
@@ -1,6 +1,7 @@
 package mongo_model
 
 import (
+	"math"
 	"time"
 
 	"go.mongodb.org/mongo-driver/bson/primitive"
@@ -17,6 +18,9 @@ type Purchase struct {
 	Price             float64            `bson:"price" json:"price"`
 	GrandTotal        float64            `bson:"grandTotal" json:"grandTotal"`
 	IsCheckoutPackage bool               `bson:"isCheckoutPackage" json:"isCheckoutPackage"`
+	IsSeasonPass      bool               `bson:"isSeasonPass" json:"isSeasonPass"`
+	Channel           PurchaseChannel    `bson:"channel" json:"channel"`
+	BoxOffice         *BoxOffice         `bson:"boxOffice,omitempty" json:"boxOffice,omitempty"`
 	Status            PurchaseStatus     `bson:"status" json:"-"`
 	ExpiredAt         time.Time          `bson:"expiredAt" json:"expiredAt"`
 	PaidAt            *time.Time         `bson:"paidAt" json:"paidAt"`
@@ -33,6 +37,15 @@ type MemberPurchaseFK struct {
 	Phone string `bson:"phone" json:"phone"`
 }
 
+// AnonymizedMemberPurchaseFK replace the member copy on financial records once the account is deleted
+func AnonymizedMemberPurchaseFK(memberID string) MemberPurchaseFK {
+	return MemberPurchaseFK{
+		ID:    memberID,
+		Name:  "Deleted Member",
+		Email: "deleted-" + memberID + "@deleted.invalid",
+	}
+}
+
 type Invoice struct {
 	InvoiceID          string `bson:"invoiceId" json:"invoiceId"`
 	InvoiceExternalID  string `bson:"invoiceExternalId" json:"invoiceExternalId"`
@@ -44,7 +57,38 @@ type Invoice struct {
 	PaymentDestination string `bson:"paymentDestination" json:"paymentDestination"`
 }
 
+type BoxOffice struct {
+	Admin         AdminFK                `bson:"admin" json:"admin"`
+	Venue         VenueFK                `bson:"venue" json:"venue"`
+	PaymentMethod BoxOfficePaymentMethod `bson:"paymentMethod" json:"paymentMethod"`
+	EdcReference  string                 `bson:"edcReference" json:"edcReference"`
+}
+
+// RefundShare returns the part of grand total paid for a single ticket day, refunded is the amount already
+// refunded for the purchase so the refunds never add up to more than the grand total. Season pass gains ticket days
+// after it is bought so it refunds the price of the day, package purchases are pro-rated over their ticket days
+// and the last ticket day takes the rounding remainder
+func (p *Purchase) RefundShare(ticketId string, ticketPrice, refunded float64) float64 {
+	remaining := math.Max(p.GrandTotal-refunded, 0)
+	if p.IsSeasonPass {
+		return math.Min(ticketPrice*float64(p.Amount), remaining)
+	}
+	if len(p.Tickets) <= 1 {
+		return remaining
+	}
+
+	share := math.Floor(p.GrandTotal / float64(len(p.Tickets)))
+	if p.Tickets[len(p.Tickets)-1].ID == ticketId {
+		share = p.GrandTotal - share*float64(len(p.Tickets)-1)
+	}
+
+	return math.Min(share, remaining)
+}
+
 func (p *Purchase) Format() *Purchase {
 	p.StatusString = PurchaseStatusMap[p.Status].Name
+	if p.Channel == "" {
+		p.Channel = PurchaseChannelOnline
+	}
 	return p
 }
@@ -0,0 +1,33 @@
+package mongo_model
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type Refund struct {
+	ID           primitive.ObjectID `bson:"_id" json:"id"`
+	PurchaseID   string             `bson:"purchaseId" json:"purchaseId"`
+	Invoice      Invoice            `bson:"invoice" json:"invoice"`
+	Channel      PurchaseChannel    `bson:"channel" json:"channel"`
+	Member       MemberPurchaseFK   `bson:"member" json:"member"`
+	Ticket       TicketFK           `bson:"ticket" json:"ticket"`
+	JobID        string             `bson:"jobId" json:"jobId"`
+	Reason       RefundReason       `bson:"reason" json:"reason"`
+	Amount       float64            `bson:"amount" json:"amount"`
+	Status       RefundStatus       `bson:"status" json:"-"`
+	StatusString string             `bson:"-" json:"status"`
+	Note         string             `bson:"note" json:"note"`
+	ProcessedBy  *ActorFK           `bson:"processedBy" json:"processedBy"`
+	ProcessedAt  *time.Time         `bson:"processedAt" json:"processedAt"`
+	CreatedAt    time.Time          `bson:"createdAt" json:"createdAt"`
+	UpdatedAt    time.Time          `bson:"updatedAt" json:"updatedAt"`
+	DeletedAt    *time.Time         `bson:"deletedAt" json:"-"`
+}
+
+func (r *Refund) Format() *Refund {
+	r.StatusString = RefundStatusMap[r.Status].Name
+
+	return r
+}
@@ -0,0 +1,135 @@
+package mongo_model
+
+import (
+	"strings"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// Role is a named set of back office permissions assigned to superadmin users
+type Role struct {
+	ID          primitive.ObjectID `bson:"_id" json:"id"`
+	Name        string             `bson:"name" json:"name"`
+	Description string             `bson:"description" json:"description"`
+	Permissions []Permission       `bson:"permissions" json:"permissions"`
+	CreatedAt   time.Time          `bson:"createdAt" json:"createdAt"`
+	UpdatedAt   time.Time          `bson:"updatedAt" json:"updatedAt"`
+	DeletedAt   *time.Time         `bson:"deletedAt" json:"-"`
+}
+
+type RoleFK struct {
+	ID   string `bson:"id" json:"id"`
+	Name string `bson:"name" json:"name"`
+}
+
+type Permission string
+
+const (
+	// PermissionAll is carried by owners, superadmins without a role
+	PermissionAll Permission = "*"
+
+	PermissionRolesManage         Permission = "roles.manage"
+	PermissionUsersManage         Permission = "users.manage"
+	PermissionAdminsView          Permission = "admins.view"
+	PermissionAdminsManage        Permission = "admins.manage"
+	PermissionLoginAttemptsView   Permission = "login-attempts.view"
+	PermissionLoginAttemptsManage Permission = "login-attempts.manage"
+	PermissionAuditLogsView       Permission = "audit-logs.view"
+	PermissionAPIKeysManage       Permission = "api-keys.manage"
+	PermissionMembersImpersonate  Permission = "members.impersonate"
+	PermissionDashboardView       Permission = "dashboard.view"
+	PermissionSeasonsView         Permission = "seasons.view"
+	PermissionSeasonsManage       Permission = "seasons.manage"
+	PermissionVenuesView          Permission = "venues.view"
+	PermissionVenuesManage        Permission = "venues.manage"
+	PermissionTeamsView           Permission = "teams.view"
+	PermissionTeamsManage         Permission = "teams.manage"
+	PermissionPlayersView         Permission = "players.view"
+	PermissionPlayersManage       Permission = "players.manage"
+	PermissionSeriesView          Permission = "series.view"
+	PermissionSeriesManage        Permission = "series.manage"
+	PermissionTicketsView         Permission = "tickets.view"
+	PermissionTicketsManage       Permission = "tickets.manage"
+	PermissionMatchesView         Permission = "matches.view"
+	PermissionMatchesManage       Permission = "matches.manage"
+	PermissionVotingsView         Permission = "votings.view"
+	PermissionVotingsManage       Permission = "votings.manage"
+	PermissionPurchasesView       Permission = "purchases.view"
+	PermissionPurchasesManage     Permission = "purchases.manage"
+	PermissionRefundsView         Permission = "refunds.view"
+	PermissionRefundsManage       Permission = "refunds.manage"
+)
+
+type PermissionInfo struct {
+	Key         Permission `json:"key"`
+	Group       string     `json:"group"`
+	Description string     `json:"description"`
+}
+
+// PermissionList every permission a role can be given, a manage permission includes its view permission
+var PermissionList = []PermissionInfo{
+	{Key: PermissionRolesManage, Group: "Access", Description: "Manage roles"},
+	{Key: PermissionUsersManage, Group: "Access", Description: "Assign roles to back office users"},
+	{Key: PermissionAdminsView, Group: "Access", Description: "View admins"},
+	{Key: PermissionAdminsManage, Group: "Access", Description: "Manage admins"},
+	{Key: PermissionLoginAttemptsView, Group: "Access", Description: "View locked logins"},
+	{Key: PermissionLoginAttemptsManage, Group: "Access", Description: "Unlock locked logins"},
+	{Key: PermissionAuditLogsView, Group: "Access", Description: "View audit logs"},
+	{Key: PermissionAPIKeysManage, Group: "Access", Description: "Manage partner api keys"},
+	{Key: PermissionMembersImpersonate, Group: "Access", Description: "View the member app as a member, read only"},
+	{Key: PermissionDashboardView, Group: "Dashboard", Description: "View dashboard"},
+	{Key: PermissionSeasonsView, Group: "Content", Description: "View seasons"},
+	{Key: PermissionSeasonsManage, Group: "Content", Description: "Manage seasons"},
+	{Key: PermissionVenuesView, Group: "Content", Description: "View venues"},
+	{Key: PermissionVenuesManage, Group: "Content", Description: "Manage venues"},
+	{Key: PermissionTeamsView, Group: "Content", Description: "View teams and season teams"},
+	{Key: PermissionTeamsManage, Group: "Content", Description: "Manage teams and season teams"},
+	{Key: PermissionPlayersView, Group: "Content", Description: "View players and season team players"},
+	{Key: PermissionPlayersManage, Group: "Content", Description: "Manage players and season team players"},
+	{Key: PermissionVotingsView, Group: "Content", Description: "View votings and candidates"},
+	{Key: PermissionVotingsManage, Group: "Content", Description: "Manage votings and candidates"},
+	{Key: PermissionSeriesView, Group: "Operations", Description: "View series"},
+	{Key: PermissionSeriesManage, Group: "Operations", Description: "Manage series"},
+	{Key: PermissionTicketsView, Group: "Operations", Description: "View tickets and cancellation jobs"},
+	{Key: PermissionTicketsManage, Group: "Operations", Description: "Manage, cancel and reschedule tickets"},
+	{Key: PermissionMatchesView, Group: "Operations", Description: "View matches"},
+	{Key: PermissionMatchesManage, Group: "Operations", Description: "Manage matches and their results"},
+	{Key: PermissionPurchasesView, Group: "Finance", Description: "View purchases and reissue logs"},
+	{Key: PermissionPurchasesManage, Group: "Finance", Description: "Reissue ticket purchases"},
+	{Key: PermissionRefundsView, Group: "Finance", Description: "View refunds"},
+	{Key: PermissionRefundsManage, Group: "Finance", Description: "Process refunds"},
+}
+
+func IsValidPermission(permission string) bool {
+	for _, p := range PermissionList {
+		if string(p.Key) == permission {
+			return true
+		}
+	}
+	return false
+}
+
+// HasPermission check the granted permissions against the required one
+func HasPermission(granted []string, required Permission) bool {
+	for _, g := range granted {
+		if g == string(PermissionAll) || g == string(required) {
+			return true
+		}
+		// manage includes view of the same resource
+		if resource, ok := strings.CutSuffix(string(required), ".view"); ok && g == resource+".manage" {
+			return true
+		}
+	}
+	return false
+}
+
+// HasEveryPermission check the granted permissions cover every required one
+func HasEveryPermission(granted []string, required []Permission) bool {
+	for _, permission := range required {
+		if !HasPermission(granted, permission) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,23 @@
+package mongo_model
+
+import "time"
+
+// SaleWindow is the computed sale state of a series or ticket day,
+// OpensIn is the remaining seconds until the sale starts
+type SaleWindow struct {
+	Status  SaleStatus `json:"status"`
+	OpensIn int64      `json:"opensIn"`
+}
+
+func NewSaleWindow(now time.Time, startAt, endAt *time.Time) SaleWindow {
+	if startAt != nil && now.Before(*startAt) {
+		return SaleWindow{
+			Status:  SaleStatusUpcoming,
+			OpensIn: int64(startAt.Sub(now).Seconds()),
+		}
+	}
+	if endAt != nil && !now.Before(*endAt) {
+		return SaleWindow{Status: SaleStatusClosed}
+	}
+	return SaleWindow{Status: SaleStatusOpen}
+}
@@ -13,9 +13,14 @@ type Season struct {
 	StatusString string             `bson:"-" json:"status"`
 	Logo         MediaFK            `bson:"logo" json:"logo"`
 	Banner       MediaFK            `bson:"banner" json:"banner"`
-	CreatedAt    time.Time          `bson:"createdAt" json:"createdAt"`
-	UpdatedAt    time.Time          `bson:"updatedAt" json:"updatedAt"`
-	DeletedAt    *time.Time         `bson:"deletedAt" json:"-"`
+	Pass         SeasonPass         `bson:"pass" json:"pass"`
+	StandingRule *StandingRule      `bson:"standingRule" json:"standingRule"`
+	// StandingVersion counts the requests to compute the standings, StandingLockedUntil is set while one run computes them
+	StandingVersion     int64      `bson:"standingVersion" json:"-"`
+	StandingLockedUntil *time.Time `bson:"standingLockedUntil" json:"-"`
+	CreatedAt           time.Time  `bson:"createdAt" json:"createdAt"`
+	UpdatedAt           time.Time  `bson:"updatedAt" json:"updatedAt"`
+	DeletedAt           *time.Time `bson:"deletedAt" json:"-"`
 }
 
 type SeasonFK struct {
@@ -23,8 +28,15 @@ type SeasonFK struct {
 	Name string `bson:"name" json:"name"`
 }
 
+type SeasonPass struct {
+	IsEnabled bool        `bson:"isEnabled" json:"isEnabled"`
+	Price     float64     `bson:"price" json:"price"`
+	Quota     TicketQuota `bson:"quota" json:"quota"`
+}
+
 func (s *Season) Format() *Season {
 	s.StatusString = SeasonStatusMap[s.Status].Name
+	s.Pass.Quota.Remaining = s.Pass.Quota.Stock - s.Pass.Quota.Used
 
 	return s
 }
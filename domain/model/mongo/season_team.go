@@ -11,6 +11,7 @@ type SeasonTeam struct {
 	SeasonID  string             `bson:"seasonId" json:"seasonId"`
 	Season    SeasonFK           `bson:"-" json:"season"`
 	Team      TeamFK             `bson:"team" json:"team"`
+	Group     string             `bson:"group" json:"group"`
 	CreatedAt time.Time          `bson:"createdAt" json:"createdAt"`
 	UpdatedAt time.Time          `bson:"updatedAt" json:"updatedAt"`
 	DeletedAt *time.Time         `bson:"deletedAt" json:"-"`
@@ -17,6 +17,10 @@ type Series struct {
 	MatchCount   int64              `bson:"matchCount" json:"matchCount"`
 	StartDate    time.Time          `bson:"startDate" json:"startDate"`
 	EndDate      time.Time          `bson:"endDate" json:"endDate"`
+	PublishAt    *time.Time         `bson:"publishAt" json:"publishAt"`
+	SaleStartAt  *time.Time         `bson:"saleStartAt" json:"saleStartAt"`
+	SaleEndAt    *time.Time         `bson:"saleEndAt" json:"saleEndAt"`
+	Sale         SaleWindow         `bson:"-" json:"sale"`
 	Status       SeriesStatus       `bson:"status" json:"-"`
 	StatusString string             `bson:"-" json:"status"`
 	Tickets      []Ticket           `bson:"tickets" json:"tickets"`
@@ -32,6 +36,7 @@ type SeriesFK struct {
 
 func (s *Series) Format() *Series {
 	s.StatusString = SeriesStatusMap[s.Status].Name
+	s.Sale = NewSaleWindow(time.Now(), s.SaleStartAt, s.SaleEndAt)
 
 	return s
 }
@@ -0,0 +1,306 @@
+package mongo_model
+
+import (
+	"sort"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// Standing is the cached league table of a season computed from its finished matches.
+// An empty SeriesID is the table of the whole season, an empty Group is the table of every group
+type Standing struct {
+	ID         primitive.ObjectID `bson:"_id" json:"id"`
+	SeasonID   string             `bson:"seasonId" json:"seasonId"`
+	SeriesID   string             `bson:"seriesId" json:"seriesId"`
+	Group      string             `bson:"group" json:"group"`
+	Rule       StandingRule       `bson:"rule" json:"rule"`
+	Rows       []StandingRow      `bson:"rows" json:"rows"`
+	ComputedAt time.Time          `bson:"computedAt" json:"computedAt"`
+	CreatedAt  time.Time          `bson:"createdAt" json:"createdAt"`
+	UpdatedAt  time.Time          `bson:"updatedAt" json:"updatedAt"`
+	DeletedAt  *time.Time         `bson:"deletedAt" json:"-"`
+}
+
+// HasGroups reports whether the table lists teams of different groups, its positions do not rank them against each other
+func (s *Standing) HasGroups() bool {
+	for _, row := range s.Rows {
+		if row.Group != "" {
+			return true
+		}
+	}
+	return false
+}
+
+type StandingRow struct {
+	Position       int          `bson:"position" json:"position"`
+	SeasonTeam     SeasonTeamFK `bson:"seasonTeam" json:"seasonTeam"`
+	Group          string       `bson:"group" json:"group"`
+	Played         int          `bson:"played" json:"played"`
+	Won            int          `bson:"won" json:"won"`
+	Drawn          int          `bson:"drawn" json:"drawn"`
+	Lost           int          `bson:"lost" json:"lost"`
+	GoalsFor       int          `bson:"goalsFor" json:"goalsFor"`
+	GoalsAgainst   int          `bson:"goalsAgainst" json:"goalsAgainst"`
+	GoalDifference int          `bson:"goalDifference" json:"goalDifference"`
+	Points         int          `bson:"points" json:"points"`
+}
+
+// StandingRule is how a season ranks its teams, points always come first then the tie breakers in order
+type StandingRule struct {
+	PointsWin   int                  `bson:"pointsWin" json:"pointsWin"`
+	PointsDraw  int                  `bson:"pointsDraw" json:"pointsDraw"`
+	PointsLoss  int                  `bson:"pointsLoss" json:"pointsLoss"`
+	TieBreakers []StandingTieBreaker `bson:"tieBreakers" json:"tieBreakers"`
+}
+
+type StandingTieBreaker string
+
+const (
+	StandingTieBreakerGoalDifference StandingTieBreaker = "goal_difference"
+	StandingTieBreakerGoalsFor       StandingTieBreaker = "goals_for"
+	StandingTieBreakerGoalsAgainst   StandingTieBreaker = "goals_against"
+	StandingTieBreakerWon            StandingTieBreaker = "won"
+	StandingTieBreakerHeadToHead     StandingTieBreaker = "head_to_head"
+)
+
+var StandingTieBreakerList = []StandingTieBreaker{
+	StandingTieBreakerGoalDifference,
+	StandingTieBreakerGoalsFor,
+	StandingTieBreakerGoalsAgainst,
+	StandingTieBreakerWon,
+	StandingTieBreakerHeadToHead,
+}
+
+func IsValidStandingTieBreaker(tieBreaker string) bool {
+	for _, t := range StandingTieBreakerList {
+		if string(t) == tieBreaker {
+			return true
+		}
+	}
+	return false
+}
+
+// DefaultStandingRule is used by seasons without a standing rule
+var DefaultStandingRule = StandingRule{
+	PointsWin:  3,
+	PointsDraw: 1,
+	PointsLoss: 0,
+	TieBreakers: []StandingTieBreaker{
+		StandingTieBreakerGoalDifference,
+		StandingTieBreakerGoalsFor,
+		StandingTieBreakerHeadToHead,
+	},
+}
+
+func (s *Season) GetStandingRule() StandingRule {
+	if s.StandingRule == nil {
+		return DefaultStandingRule
+	}
+	return *s.StandingRule
+}
+
+// BuildStandings computes the table of the whole season, of each group and of each series
+func BuildStandings(season *Season, seasonTeams []SeasonTeam, matches []Match, now time.Time) []Standing {
+	rule := season.GetStandingRule()
+	newStanding := func(seriesId, group string, teams []SeasonTeam, matches []Match) Standing {
+		return Standing{
+			SeasonID:   season.ID.Hex(),
+			SeriesID:   seriesId,
+			Group:      group,
+			Rule:       rule,
+			Rows:       ComputeStandingRows(teams, matches, rule),
+			ComputedAt: now,
+		}
+	}
+
+	standings := []Standing{newStanding("", "", seasonTeams, matches)}
+
+	// group tables only count matches inside the group
+	groups := make([]string, 0)
+	groupTeams := make(map[string][]SeasonTeam)
+	for _, seasonTeam := range seasonTeams {
+		if seasonTeam.Group == "" {
+			continue
+		}
+		if _, ok := groupTeams[seasonTeam.Group]; !ok {
+			groups = append(groups, seasonTeam.Group)
+		}
+		groupTeams[seasonTeam.Group] = append(groupTeams[seasonTeam.Group], seasonTeam)
+	}
+	sort.Strings(groups)
+	for _, group := range groups {
+		standings = append(standings, newStanding("", group, groupTeams[group], matches))
+	}
+
+	// series tables only list the teams playing in the series
+	seriesIds := make([]string, 0)
+	seriesMatches := make(map[string][]Match)
+	for _, match := range matches {
+		if match.SeriesID == "" {
+			continue
+		}
+		if _, ok := seriesMatches[match.SeriesID]; !ok {
+			seriesIds = append(seriesIds, match.SeriesID)
+		}
+		seriesMatches[match.SeriesID] = append(seriesMatches[match.SeriesID], match)
+	}
+	for _, seriesId := range seriesIds {
+		playing := make(map[string]struct{})
+		for _, match := range seriesMatches[seriesId] {
+			playing[match.HomeSeasonTeamID] = struct{}{}
+			playing[match.AwaySeasonTeamID] = struct{}{}
+		}
+		teams := make([]SeasonTeam, 0)
+		for _, seasonTeam := range seasonTeams {
+			if _, ok := playing[seasonTeam.ID.Hex()]; ok {
+				teams = append(teams, seasonTeam)
+			}
+		}
+		standings = append(standings, newStanding(seriesId, "", teams, seriesMatches[seriesId]))
+	}
+
+	return standings
+}
+
+// standingRankPoints ranks by points, it always comes before the tie breakers of the rule
+const standingRankPoints StandingTieBreaker = "points"
+
+// ComputeStandingRows ranks the teams by the finished matches played between them.
+// Teams level on points are split by the tie breakers in order, head to head ranks the tied teams
+// by a table of only the matches played between them
+func ComputeStandingRows(seasonTeams []SeasonTeam, matches []Match, rule StandingRule) []StandingRow {
+	rowMap := make(map[string]*StandingRow)
+	rows := make([]*StandingRow, 0, len(seasonTeams))
+	for _, seasonTeam := range seasonTeams {
+		row := &StandingRow{
+			SeasonTeam: SeasonTeamFK{
+				ID:       seasonTeam.ID.Hex(),
+				SeasonID: seasonTeam.SeasonID,
+				TeamID:   seasonTeam.Team.ID,
+				Team:     seasonTeam.Team,
+			},
+			Group: seasonTeam.Group,
+		}
+		rowMap[row.SeasonTeam.ID] = row
+		rows = append(rows, row)
+	}
+
+	record := func(row *StandingRow, goalsFor, goalsAgainst int) {
+		row.Played++
+		row.GoalsFor += goalsFor
+		row.GoalsAgainst += goalsAgainst
+		row.GoalDifference = row.GoalsFor - row.GoalsAgainst
+		row.Points += rule.points(goalsFor, goalsAgainst)
+		switch {
+		case goalsFor > goalsAgainst:
+			row.Won++
+		case goalsFor == goalsAgainst:
+			row.Drawn++
+		default:
+			row.Lost++
+		}
+	}
+
+	played := make([]Match, 0, len(matches))
+	for _, match := range matches {
+		if match.Status != MatchStatusFinished || match.Score == nil {
+			continue
+		}
+		home, okHome := rowMap[match.HomeSeasonTeamID]
+		away, okAway := rowMap[match.AwaySeasonTeamID]
+		if !okHome || !okAway {
+			continue
+		}
+		record(home, match.Score.Home, match.Score.Away)
+		record(away, match.Score.Away, match.Score.Home)
+		played = append(played, match)
+	}
+
+	criteria := append([]StandingTieBreaker{standingRankPoints}, rule.TieBreakers...)
+	rankStandingRows(rows, criteria, played, rule)
+
+	result := make([]StandingRow, len(rows))
+	for i, row := range rows {
+		row.Position = i + 1
+		result[i] = *row
+	}
+
+	return result
+}
+
+// rankStandingRows orders the rows by the first criterion, then ranks each run of rows still level
+// with the remaining criteria. Rows level on every criterion are ordered by team name
+func rankStandingRows(rows []*StandingRow, criteria []StandingTieBreaker, matches []Match, rule StandingRule) {
+	if len(rows) < 2 {
+		return
+	}
+	if len(criteria) == 0 {
+		sort.SliceStable(rows, func(i, j int) bool {
+			return rows[i].SeasonTeam.Team.Name < rows[j].SeasonTeam.Team.Name
+		})
+		return
+	}
+
+	value := standingRankValues(rows, criteria[0], matches, rule)
+	sort.SliceStable(rows, func(i, j int) bool {
+		return value[rows[i].SeasonTeam.ID] > value[rows[j].SeasonTeam.ID]
+	})
+
+	for start := 0; start < len(rows); {
+		end := start + 1
+		for end < len(rows) && value[rows[end].SeasonTeam.ID] == value[rows[start].SeasonTeam.ID] {
+			end++
+		}
+		rankStandingRows(rows[start:end], criteria[1:], matches, rule)
+		start = end
+	}
+}
+
+// standingRankValues returns the value of each row for the criterion, a higher value ranks first
+func standingRankValues(rows []*StandingRow, criterion StandingTieBreaker, matches []Match, rule StandingRule) map[string]int {
+	value := make(map[string]int, len(rows))
+	for _, row := range rows {
+		switch criterion {
+		case standingRankPoints:
+			value[row.SeasonTeam.ID] = row.Points
+		case StandingTieBreakerGoalDifference:
+			value[row.SeasonTeam.ID] = row.GoalDifference
+		case StandingTieBreakerGoalsFor:
+			value[row.SeasonTeam.ID] = row.GoalsFor
+		case StandingTieBreakerGoalsAgainst:
+			value[row.SeasonTeam.ID] = -row.GoalsAgainst
+		case StandingTieBreakerWon:
+			value[row.SeasonTeam.ID] = row.Won
+		case StandingTieBreakerHeadToHead:
+			value[row.SeasonTeam.ID] = 0
+		}
+	}
+
+	// the points of the tied teams in the matches played between them
+	if criterion == StandingTieBreakerHeadToHead {
+		for _, match := range matches {
+			_, okHome := value[match.HomeSeasonTeamID]
+			_, okAway := value[match.AwaySeasonTeamID]
+			if !okHome || !okAway {
+				continue
+			}
+			value[match.HomeSeasonTeamID] += rule.points(match.Score.Home, match.Score.Away)
+			value[match.AwaySeasonTeamID] += rule.points(match.Score.Away, match.Score.Home)
+		}
+	}
+
+	return value
+}
+
+// points of a team scoring goalsFor and conceding goalsAgainst in a match
+func (r StandingRule) points(goalsFor, goalsAgainst int) int {
+	switch {
+	case goalsFor > goalsAgainst:
+		return r.PointsWin
+	case goalsFor == goalsAgainst:
+		return r.PointsDraw
+	default:
+		return r.PointsLoss
+	}
+}
@@ -0,0 +1,204 @@
+package mongo_model
+
+import (
+	"testing"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func newStandingTestTeams(names ...string) []SeasonTeam {
+	seasonTeams := make([]SeasonTeam, 0, len(names))
+	for _, name := range names {
+		seasonTeams = append(seasonTeams, SeasonTeam{
+			ID:       primitive.NewObjectID(),
+			SeasonID: "season",
+			Team:     TeamFK{ID: name, Name: name},
+		})
+	}
+	return seasonTeams
+}
+
+func newStandingTestMatch(home, away SeasonTeam, homeGoals, awayGoals int) Match {
+	return Match{
+		ID:               primitive.NewObjectID(),
+		SeasonID:         "season",
+		HomeSeasonTeamID: home.ID.Hex(),
+		AwaySeasonTeamID: away.ID.Hex(),
+		Status:           MatchStatusFinished,
+		Score:            &MatchScore{Home: homeGoals, Away: awayGoals},
+	}
+}
+
+func standingTestOrder(rows []StandingRow) []string {
+	order := make([]string, len(rows))
+	for i, row := range rows {
+		order[i] = row.SeasonTeam.Team.Name
+	}
+	return order
+}
+
+func assertStandingOrder(t *testing.T, rows []StandingRow, want ...string) {
+	t.Helper()
+	got := standingTestOrder(rows)
+	if len(got) != len(want) {
+		t.Fatalf("order = %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("order = %v, want %v", got, want)
+		}
+		if rows[i].Position != i+1 {
+			t.Fatalf("%s position = %d, want %d", rows[i].SeasonTeam.Team.Name, rows[i].Position, i+1)
+		}
+	}
+}
+
+func TestComputeStandingRowsRecord(t *testing.T) {
+	teams := newStandingTestTeams("A", "B")
+	matches := []Match{
+		newStandingTestMatch(teams[0], teams[1], 2, 0),
+		newStandingTestMatch(teams[1], teams[0], 1, 1),
+	}
+
+	rows := ComputeStandingRows(teams, matches, DefaultStandingRule)
+	assertStandingOrder(t, rows, "A", "B")
+
+	a := rows[0]
+	if a.Played != 2 || a.Won != 1 || a.Drawn != 1 || a.Lost != 0 {
+		t.Errorf("A played/won/drawn/lost = %d/%d/%d/%d, want 2/1/1/0", a.Played, a.Won, a.Drawn, a.Lost)
+	}
+	if a.GoalsFor != 3 || a.GoalsAgainst != 1 || a.GoalDifference != 2 || a.Points != 4 {
+		t.Errorf("A for/against/difference/points = %d/%d/%d/%d, want 3/1/2/4", a.GoalsFor, a.GoalsAgainst, a.GoalDifference, a.Points)
+	}
+	b := rows[1]
+	if b.Played != 2 || b.Lost != 1 || b.Points != 1 {
+		t.Errorf("B played/lost/points = %d/%d/%d, want 2/1/1", b.Played, b.Lost, b.Points)
+	}
+}
+
+func TestComputeStandingRowsSkipsUnfinishedMatches(t *testing.T) {
+	teams := newStandingTestTeams("A", "B")
+	live := newStandingTestMatch(teams[1], teams[0], 3, 0)
+	live.Status = MatchStatusLive
+	scheduled := newStandingTestMatch(teams[1], teams[0], 0, 0)
+	scheduled.Status = MatchStatusScheduled
+	scheduled.Score = nil
+
+	rows := ComputeStandingRows(teams, []Match{live, scheduled}, DefaultStandingRule)
+	for _, row := range rows {
+		if row.Played != 0 || row.Points != 0 {
+			t.Errorf("%s played %d with %d points, want no result counted", row.SeasonTeam.Team.Name, row.Played, row.Points)
+		}
+	}
+	assertStandingOrder(t, rows, "A", "B")
+}
+
+func TestComputeStandingRowsTieBreakers(t *testing.T) {
+	teams := newStandingTestTeams("A", "B", "C", "D")
+	a, b, c, d := teams[0], teams[1], teams[2], teams[3]
+	// B and C win twice each, B with the better goal difference, C with more goals scored
+	matches := []Match{
+		newStandingTestMatch(b, a, 3, 0),
+		newStandingTestMatch(b, d, 1, 0),
+		newStandingTestMatch(c, a, 4, 3),
+		newStandingTestMatch(c, d, 2, 1),
+	}
+
+	rule := StandingRule{PointsWin: 3, PointsDraw: 1, TieBreakers: []StandingTieBreaker{StandingTieBreakerGoalDifference}}
+	assertStandingOrder(t, ComputeStandingRows(teams, matches, rule), "B", "C", "D", "A")
+
+	rule.TieBreakers = []StandingTieBreaker{StandingTieBreakerGoalsFor}
+	assertStandingOrder(t, ComputeStandingRows(teams, matches, rule), "C", "B", "A", "D")
+
+	rule.TieBreakers = []StandingTieBreaker{StandingTieBreakerGoalsAgainst}
+	assertStandingOrder(t, ComputeStandingRows(teams, matches, rule), "B", "C", "D", "A")
+}
+
+func TestComputeStandingRowsHeadToHeadMiniTable(t *testing.T) {
+	teams := newStandingTestTeams("A", "B", "C", "D")
+	a, b, c, d := teams[0], teams[1], teams[2], teams[3]
+	// A, B and C end on 6 points, between them C took 4 points, A 3 and B 1
+	matches := []Match{
+		newStandingTestMatch(a, b, 1, 0),
+		newStandingTestMatch(c, a, 2, 0),
+		newStandingTestMatch(b, c, 1, 1),
+		newStandingTestMatch(a, d, 1, 0),
+		newStandingTestMatch(b, d, 1, 0),
+		newStandingTestMatch(d, b, 0, 0),
+		newStandingTestMatch(b, d, 2, 2),
+		newStandingTestMatch(d, c, 1, 1),
+		newStandingTestMatch(c, d, 0, 0),
+	}
+
+	rule := StandingRule{PointsWin: 3, PointsDraw: 1, TieBreakers: []StandingTieBreaker{StandingTieBreakerHeadToHead}}
+	rows := ComputeStandingRows(teams, matches, rule)
+	for _, row := range rows[:3] {
+		if row.Points != rows[0].Points {
+			t.Fatalf("%s has %d points, want the top three level", row.SeasonTeam.Team.Name, row.Points)
+		}
+	}
+	assertStandingOrder(t, rows, "C", "A", "B", "D")
+}
+
+func TestComputeStandingRowsHeadToHeadCycle(t *testing.T) {
+	teams := newStandingTestTeams("A", "B", "C")
+	a, b, c := teams[0], teams[1], teams[2]
+	// each team beat one and lost to one, head to head can not split them and goal difference decides
+	matches := []Match{
+		newStandingTestMatch(a, b, 1, 0),
+		newStandingTestMatch(b, c, 3, 0),
+		newStandingTestMatch(c, a, 3, 0),
+	}
+	rule := StandingRule{
+		PointsWin:   3,
+		PointsDraw:  1,
+		TieBreakers: []StandingTieBreaker{StandingTieBreakerHeadToHead, StandingTieBreakerGoalDifference},
+	}
+
+	// the table is the same whatever order the teams and matches come in
+	permutations := [][]int{{0, 1, 2}, {0, 2, 1}, {1, 0, 2}, {1, 2, 0}, {2, 0, 1}, {2, 1, 0}}
+	for _, permutation := range permutations {
+		orderedTeams := make([]SeasonTeam, len(permutation))
+		orderedMatches := make([]Match, len(permutation))
+		for i, index := range permutation {
+			orderedTeams[i] = teams[index]
+			orderedMatches[i] = matches[index]
+		}
+		assertStandingOrder(t, ComputeStandingRows(orderedTeams, orderedMatches, rule), "B", "C", "A")
+	}
+}
+
+func TestComputeStandingRowsTeamNameLast(t *testing.T) {
+	teams := newStandingTestTeams("C", "A", "B")
+
+	rows := ComputeStandingRows(teams, nil, DefaultStandingRule)
+	assertStandingOrder(t, rows, "A", "B", "C")
+}
+
+func TestBuildStandingsGroups(t *testing.T) {
+	teams := newStandingTestTeams("A", "B", "C", "D")
+	teams[0].Group, teams[1].Group = "X", "X"
+	teams[2].Group, teams[3].Group = "Y", "Y"
+	// a match across groups counts in the season table only
+	matches := []Match{
+		newStandingTestMatch(teams[1], teams[0], 1, 0),
+		newStandingTestMatch(teams[0], teams[2], 5, 0),
+	}
+	season := &Season{ID: primitive.NewObjectID()}
+
+	standings := BuildStandings(season, teams, matches, time.Now())
+	if len(standings) != 3 {
+		t.Fatalf("built %d standings, want the season table and two group tables", len(standings))
+	}
+	if standings[0].Group != "" || len(standings[0].Rows) != 4 {
+		t.Errorf("season table group %q with %d rows, want every team", standings[0].Group, len(standings[0].Rows))
+	}
+	assertStandingOrder(t, standings[1].Rows, "B", "A")
+	if standings[1].Rows[1].Played != 1 {
+		t.Errorf("A played %d group matches, want 1", standings[1].Rows[1].Played)
+	}
+	if standings[2].Group != "Y" || standings[2].Rows[0].Played != 0 {
+		t.Errorf("group Y table counted a match played outside the group")
+	}
+}
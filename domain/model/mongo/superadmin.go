@@ -6,12 +6,27 @@ import (
 	"go.mongodb.org/mongo-driver/bson/primitive"
 )
 
+// Superadmin is a back office user, one without a role is an owner with every permission
 type Superadmin struct {
-	ID        primitive.ObjectID `bson:"_id" json:"id"`
-	Name      string             `bson:"name" json:"name"`
-	Email     *string            `bson:"email" json:"email"`
-	Password  string             `bson:"password" json:"-"`
-	CreatedAt time.Time          `bson:"createdAt" json:"createdAt"`
-	UpdatedAt time.Time          `bson:"updatedAt" json:"updatedAt"`
-	DeletedAt *time.Time         `bson:"deletedAt" json:"-"`
+	ID           primitive.ObjectID `bson:"_id" json:"id"`
+	Name         string             `bson:"name" json:"name"`
+	Email        *string            `bson:"email" json:"email"`
+	Password     string             `bson:"password" json:"-"`
+	Role         *RoleFK            `bson:"role" json:"role"`
+	TokenVersion int                `bson:"tokenVersion" json:"-"`
+	TwoFactor    TwoFactor          `bson:"twoFactor" json:"twoFactor"`
+	CreatedAt    time.Time          `bson:"createdAt" json:"createdAt"`
+	UpdatedAt    time.Time          `bson:"updatedAt" json:"updatedAt"`
+	DeletedAt    *time.Time         `bson:"deletedAt" json:"-"`
+}
+
+type TwoFactor struct {
+	IsEnabled     bool       `bson:"isEnabled" json:"isEnabled"`
+	EnabledAt     *time.Time `bson:"enabledAt" json:"enabledAt"`
+	Secret        string     `bson:"secret" json:"-"`
+	PendingSecret string     `bson:"pendingSecret" json:"-"`
+	// sha256 hash of each unused recovery code
+	RecoveryCodes []string `bson:"recoveryCodes" json:"-"`
+	// last accepted time step, a code can only be used once
+	LastUsedStep int64 `bson:"lastUsedStep" json:"-"`
 }
@@ -7,16 +7,25 @@ import (
 )
 
 type Ticket struct {
-	ID        primitive.ObjectID `bson:"_id" json:"id"`
-	SeriesID  string             `bson:"seriesId" json:"seriesId"`
-	Name      string             `bson:"name" json:"name"`
-	Date      time.Time          `bson:"date" json:"date"`
-	Price     float64            `bson:"price" json:"price"`
-	Quota     TicketQuota        `bson:"quota" json:"quota"`
-	Matchs    []TicketMatch      `bson:"matchs" json:"matchs"`
-	CreatedAt time.Time          `bson:"createdAt" json:"createdAt"`
-	UpdatedAt time.Time          `bson:"updatedAt" json:"updatedAt"`
-	DeletedAt *time.Time         `bson:"deletedAt" json:"-"`
+	ID           primitive.ObjectID `bson:"_id" json:"id"`
+	SeriesID     string             `bson:"seriesId" json:"seriesId"`
+	VenueID      string             `bson:"venueId" json:"venueId"`
+	Venue        VenueFK            `bson:"-" json:"venue"`
+	Name         string             `bson:"name" json:"name"`
+	Date         time.Time          `bson:"date" json:"date"`
+	Price        float64            `bson:"price" json:"price"`
+	Quota        TicketQuota        `bson:"quota" json:"quota"`
+	Matchs       []TicketMatch      `bson:"matchs" json:"matchs"`
+	SaleStartAt  *time.Time         `bson:"saleStartAt" json:"saleStartAt"`
+	SaleEndAt    *time.Time         `bson:"saleEndAt" json:"saleEndAt"`
+	Sale         SaleWindow         `bson:"-" json:"sale"`
+	IsCancelled  bool               `bson:"isCancelled" json:"isCancelled"`
+	CancelledAt  *time.Time         `bson:"cancelledAt" json:"cancelledAt"`
+	CancelReason string             `bson:"cancelReason" json:"cancelReason"`
+	Reschedule   *TicketReschedule  `bson:"reschedule" json:"reschedule"`
+	CreatedAt    time.Time          `bson:"createdAt" json:"createdAt"`
+	UpdatedAt    time.Time          `bson:"updatedAt" json:"updatedAt"`
+	DeletedAt    *time.Time         `bson:"deletedAt" json:"-"`
 }
 
 type TicketFK struct {
@@ -26,6 +35,16 @@ type TicketFK struct {
 	VenueID string    `bson:"venueId" json:"venueId"`
 }
 
+// TicketReschedule keeps the last reschedule of a ticket day, holders can
+// opt into a refund instead until RefundOptInUntil
+type TicketReschedule struct {
+	PreviousDate     time.Time `bson:"previousDate" json:"previousDate"`
+	Reason           string    `bson:"reason" json:"reason"`
+	RefundOptInUntil time.Time `bson:"refundOptInUntil" json:"refundOptInUntil"`
+	RescheduledAt    time.Time `bson:"rescheduledAt" json:"rescheduledAt"`
+	RescheduledBy    ActorFK   `bson:"rescheduledBy" json:"rescheduledBy"`
+}
+
 type TicketQuota struct {
 	Stock     int64 `bson:"stock" json:"stock"`
 	Used      int64 `bson:"used" json:"used"`
@@ -33,6 +52,7 @@ type TicketQuota struct {
 }
 
 type TicketMatch struct {
+	MatchID          string       `bson:"matchId" json:"matchId"`
 	HomeSeasonTeamID string       `bson:"homeSeasonTeamId" json:"homeSeasonTeamId"`
 	HomeSeasonTeam   SeasonTeamFK `bson:"-" json:"homeSeasonTeam"`
 	AwaySeasonTeamID string       `bson:"awaySeasonTeamId" json:"awaySeasonTeamId"`
@@ -42,8 +62,21 @@ type TicketMatch struct {
 	Time             string       `bson:"time" json:"time"`
 }
 
+// GetVenueID returns the venue of the ticket day, tickets created before
+// per ticket day venue existed fall back to the venue of their first match
+func (t *Ticket) GetVenueID() string {
+	if t.VenueID != "" {
+		return t.VenueID
+	}
+	if len(t.Matchs) > 0 {
+		return t.Matchs[0].VenueID
+	}
+	return ""
+}
+
 func (t *Ticket) Format() *Ticket {
 	t.Quota.Remaining = t.Quota.Stock - t.Quota.Used
+	t.Sale = NewSaleWindow(time.Now(), t.SaleStartAt, t.SaleEndAt)
 
 	return t
 }
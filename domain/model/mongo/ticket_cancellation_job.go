@@ -0,0 +1,30 @@
+package mongo_model
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// TicketCancellationJob keeps the progress of voiding and refunding
+// every purchase of a cancelled ticket day, purchases are processed in id order
+// so a job interrupted by a restart resumes after LastPurchaseID
+type TicketCancellationJob struct {
+	ID                 primitive.ObjectID `bson:"_id" json:"id"`
+	Ticket             TicketFK           `bson:"ticket" json:"ticket"`
+	Series             SeriesFK           `bson:"series" json:"series"`
+	Reason             string             `bson:"reason" json:"reason"`
+	Status             JobStatus          `bson:"status" json:"status"`
+	TotalPurchases     int64              `bson:"totalPurchases" json:"totalPurchases"`
+	ProcessedPurchases int64              `bson:"processedPurchases" json:"processedPurchases"`
+	VoidedTickets      int64              `bson:"voidedTickets" json:"voidedTickets"`
+	RefundCount        int64              `bson:"refundCount" json:"refundCount"`
+	RefundTotal        float64            `bson:"refundTotal" json:"refundTotal"`
+	Errors             []string           `bson:"errors" json:"errors"`
+	LastPurchaseID     string             `bson:"lastPurchaseId" json:"-"`
+	CreatedBy          ActorFK            `bson:"createdBy" json:"createdBy"`
+	FinishedAt         *time.Time         `bson:"finishedAt" json:"finishedAt"`
+	CreatedAt          time.Time          `bson:"createdAt" json:"createdAt"`
+	UpdatedAt          time.Time          `bson:"updatedAt" json:"updatedAt"`
+	DeletedAt          *time.Time         `bson:"deletedAt" json:"-"`
+}
@@ -7,15 +7,20 @@ import (
 )
 
 type TicketPurchase struct {
-	ID         primitive.ObjectID `bson:"_id" json:"id"`
-	Member     MemberPurchaseFK   `bson:"member" json:"member"`
-	Ticket     TicketFK           `bson:"ticket" json:"ticket"`
-	Venue      VenueFK            `bson:"venue" json:"venue"`
-	PurchaseID string             `bson:"purchaseId" json:"purchaseId"`
-	Code       string             `bson:"code" json:"code"`
-	IsUsed     bool               `bson:"isUsed" json:"isUsed"`
-	UsedAt     *time.Time         `bson:"usedAt" json:"usedAt"`
-	CreatedAt  time.Time          `bson:"createdAt" json:"createdAt"`
-	UpdatedAt  time.Time          `bson:"updatedAt" json:"updatedAt"`
-	DeletedAt  *time.Time         `bson:"deletedAt" json:"-"`
+	ID           primitive.ObjectID `bson:"_id" json:"id"`
+	Member       MemberPurchaseFK   `bson:"member" json:"member"`
+	Ticket       TicketFK           `bson:"ticket" json:"ticket"`
+	Venue        VenueFK            `bson:"venue" json:"venue"`
+	PurchaseID   string             `bson:"purchaseId" json:"purchaseId"`
+	Seat         int64              `bson:"seat,omitempty" json:"seat"`
+	Code         string             `bson:"code" json:"code"`
+	RevokedCodes []string           `bson:"revokedCodes" json:"-"`
+	IsUsed       bool               `bson:"isUsed" json:"isUsed"`
+	UsedAt       *time.Time         `bson:"usedAt" json:"usedAt"`
+	IsVoided     bool               `bson:"isVoided" json:"isVoided"`
+	VoidedAt     *time.Time         `bson:"voidedAt" json:"voidedAt"`
+	VoidReason   string             `bson:"voidReason" json:"voidReason"`
+	CreatedAt    time.Time          `bson:"createdAt" json:"createdAt"`
+	UpdatedAt    time.Time          `bson:"updatedAt" json:"updatedAt"`
+	DeletedAt    *time.Time         `bson:"deletedAt" json:"-"`
 }
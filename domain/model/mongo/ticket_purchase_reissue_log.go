@@ -0,0 +1,41 @@
+package mongo_model
+
+import (
+	"strings"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// TicketPurchaseReissueLog records a revoked ticket code and the code issued in its place.
+// NewCode is the live entry credential, lists only show its last characters
+type TicketPurchaseReissueLog struct {
+	ID               primitive.ObjectID `bson:"_id" json:"id"`
+	TicketPurchaseID string             `bson:"ticketPurchaseId" json:"ticketPurchaseId"`
+	PurchaseID       string             `bson:"purchaseId" json:"purchaseId"`
+	Member           MemberPurchaseFK   `bson:"member" json:"member"`
+	OldCode          string             `bson:"oldCode" json:"oldCode"`
+	NewCode          string             `bson:"newCode" json:"newCode"`
+	Reason           string             `bson:"reason" json:"reason"`
+	ReissuedBy       ActorFK            `bson:"reissuedBy" json:"reissuedBy"`
+	CreatedAt        time.Time          `bson:"createdAt" json:"createdAt"`
+	UpdatedAt        time.Time          `bson:"updatedAt" json:"updatedAt"`
+	DeletedAt        *time.Time         `bson:"deletedAt" json:"-"`
+}
+
+// ticketCodeVisibleLength is how many trailing characters of a live ticket code a log shows
+const ticketCodeVisibleLength = 4
+
+func (l *TicketPurchaseReissueLog) Format() *TicketPurchaseReissueLog {
+	if len(l.NewCode) > ticketCodeVisibleLength {
+		l.NewCode = strings.Repeat("*", len(l.NewCode)-ticketCodeVisibleLength) + l.NewCode[len(l.NewCode)-ticketCodeVisibleLength:]
+	}
+
+	return l
+}
+
+type ActorFK struct {
+	ID   string    `bson:"id" json:"id"`
+	Name string    `bson:"name" json:"name"`
+	Role ActorRole `bson:"role" json:"role"`
+}
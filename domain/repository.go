@@ -2,25 +2,42 @@ package domain
 
 import (
 	mongo_model "app/domain/model/mongo"
+	oidc_model "app/domain/model/oidc"
 	s3_model "app/domain/model/s3"
 	"app/helpers"
 	"context"
 	"io"
+	"time"
 
 	"go.mongodb.org/mongo-driver/mongo"
 )
 
 type MongoDbRepo interface {
+	EnsureIndexes(ctx context.Context) (err error)
+
 	// Superadmin
+	FetchListSuperadmin(ctx context.Context, options map[string]interface{}) (cur *mongo.Cursor, err error)
+	CountSuperadmin(ctx context.Context, options map[string]interface{}) (total int64)
 	FetchOneSuperadmin(ctx context.Context, options map[string]interface{}) (row *mongo_model.Superadmin, err error)
+	UpdatePartialSuperadmin(ctx context.Context, options, field map[string]interface{}) (err error)
+	UpdatePartialSuperadminBumpTokenVersion(ctx context.Context, options, field map[string]interface{}) (matched bool, err error)
+	UseSuperadminTwoFactorStep(ctx context.Context, id string, step int64, now time.Time) (matched bool, err error)
+	UseSuperadminRecoveryCode(ctx context.Context, id string, recoveryCodeHash string, now time.Time) (matched bool, err error)
+	UpdateManySuperadminPartial(ctx context.Context, options, field map[string]interface{}) (err error)
 
 	// Admin
+	FetchListAdmin(ctx context.Context, options map[string]interface{}) (cur *mongo.Cursor, err error)
+	CountAdmin(ctx context.Context, options map[string]interface{}) (total int64)
 	FetchOneAdmin(ctx context.Context, options map[string]interface{}) (row *mongo_model.Admin, err error)
+	CreateOneAdmin(ctx context.Context, admin *mongo_model.Admin) (err error)
+	UpdatePartialAdmin(ctx context.Context, options, field map[string]interface{}) (err error)
+	UpdatePartialAdminBumpTokenVersion(ctx context.Context, options, field map[string]interface{}) (matched bool, err error)
 
 	// Member
 	FetchOneMember(ctx context.Context, options map[string]interface{}) (row *mongo_model.Member, err error)
 	CreateOneMember(ctx context.Context, member *mongo_model.Member) (err error)
 	UpdatePartialMember(ctx context.Context, options, field map[string]interface{}) (err error)
+	UpdatePartialMemberBumpTokenVersion(ctx context.Context, options, field map[string]interface{}) (matched bool, err error)
 
 	// Media
 	FetchOneMedia(ctx context.Context, options map[string]interface{}) (row *mongo_model.Media, err error)
@@ -35,6 +52,10 @@ type MongoDbRepo interface {
 	FetchOneSeason(ctx context.Context, options map[string]interface{}) (row *mongo_model.Season, err error)
 	CreateOneSeason(ctx context.Context, season *mongo_model.Season) (err error)
 	UpdatePartialSeason(ctx context.Context, options, field map[string]interface{}) (err error)
+	IncrementOneSeason(ctx context.Context, id string, payload map[string]int64) (err error)
+	ReserveSeasonPassQuota(ctx context.Context, id string, amount int64) (matched bool, err error)
+	ClaimSeasonStandingLock(ctx context.Context, id string, lockedUntil, now time.Time) (matched bool, err error)
+	ReleaseSeasonStandingLock(ctx context.Context, id string, standingVersion int64) (matched bool, err error)
 
 	// Venue
 	FetchListVenue(ctx context.Context, options map[string]interface{}) (cur *mongo.Cursor, err error)
@@ -80,6 +101,7 @@ type MongoDbRepo interface {
 	CreateOneSeries(ctx context.Context, season *mongo_model.Series) (err error)
 	UpdatePartialSeries(ctx context.Context, options, field map[string]interface{}) (err error)
 	SumSeriesMatchCount(ctx context.Context, options map[string]interface{}) (total int64, err error)
+	PublishSeries(ctx context.Context, id string, now time.Time) (matched bool, err error)
 
 	// Ticket
 	FetchListTicket(ctx context.Context, options map[string]interface{}) (cur *mongo.Cursor, err error)
@@ -89,6 +111,8 @@ type MongoDbRepo interface {
 	CreateManyTicket(ctx context.Context, tickets []*mongo_model.Ticket) (err error)
 	UpdatePartialTicket(ctx context.Context, options, field map[string]interface{}) (err error)
 	IncrementOneTicket(ctx context.Context, id string, payload map[string]int64) (err error)
+	ReserveTicketQuota(ctx context.Context, id string, amount int64) (matched bool, err error)
+	CancelOneTicket(ctx context.Context, id string, reason string, now time.Time) (matched bool, err error)
 
 	// Voting
 	FetchListVoting(ctx context.Context, options map[string]interface{}) (cur *mongo.Cursor, err error)
@@ -117,6 +141,8 @@ type MongoDbRepo interface {
 	FetchOnePurchase(ctx context.Context, options map[string]interface{}) (row *mongo_model.Purchase, err error)
 	CreateOnePurchase(ctx context.Context, purchase *mongo_model.Purchase) (err error)
 	UpdatePartialPurchase(ctx context.Context, options, field map[string]interface{}) (err error)
+	AddPurchaseTicket(ctx context.Context, id string, ticket mongo_model.TicketFK, now time.Time) (row *mongo_model.Purchase, err error)
+	UpdateManyPurchasePartial(ctx context.Context, options, field map[string]interface{}) (err error)
 
 	// Ticket Purchase
 	FetchListTicketPurchase(ctx context.Context, options map[string]interface{}) (cur *mongo.Cursor, err error)
@@ -124,8 +150,99 @@ type MongoDbRepo interface {
 	FetchOneTicketPurchase(ctx context.Context, options map[string]interface{}) (row *mongo_model.TicketPurchase, err error)
 	CreateOneTicketPurchase(ctx context.Context, ticketPurchase *mongo_model.TicketPurchase) (err error)
 	CreateManyTicketPurchase(ctx context.Context, ticketPurchases []*mongo_model.TicketPurchase) (err error)
+	CreateManyTicketPurchaseSkipDuplicate(ctx context.Context, ticketPurchases []*mongo_model.TicketPurchase) (inserted []*mongo_model.TicketPurchase, err error)
 	UpdatePartialTicketPurchase(ctx context.Context, options, field map[string]interface{}) (err error)
 	UpdateManyTicketPurchasePartial(ctx context.Context, options, field map[string]interface{}) (err error)
+	ReissueTicketPurchaseCode(ctx context.Context, id string, oldCode, newCode string, now time.Time) (matched bool, err error)
+
+	// Ticket Purchase Reissue Log
+	FetchListTicketPurchaseReissueLog(ctx context.Context, options map[string]interface{}) (cur *mongo.Cursor, err error)
+	CountTicketPurchaseReissueLog(ctx context.Context, options map[string]interface{}) (total int64)
+	CreateOneTicketPurchaseReissueLog(ctx context.Context, reissueLog *mongo_model.TicketPurchaseReissueLog) (err error)
+	UpdateManyTicketPurchaseReissueLogPartial(ctx context.Context, options, field map[string]interface{}) (err error)
+
+	// Refund
+	FetchListRefund(ctx context.Context, options map[string]interface{}) (cur *mongo.Cursor, err error)
+	CountRefund(ctx context.Context, options map[string]interface{}) (total int64)
+	FetchOneRefund(ctx context.Context, options map[string]interface{}) (row *mongo_model.Refund, err error)
+	CreateOneRefund(ctx context.Context, refund *mongo_model.Refund) (err error)
+	CreateOneRefundIfNotExists(ctx context.Context, refund *mongo_model.Refund) (created bool, err error)
+	UpdatePartialRefund(ctx context.Context, options, field map[string]interface{}) (err error)
+	UpdateManyRefundPartial(ctx context.Context, options, field map[string]interface{}) (err error)
+
+	// Ticket Cancellation Job
+	FetchListTicketCancellationJob(ctx context.Context, options map[string]interface{}) (cur *mongo.Cursor, err error)
+	CountTicketCancellationJob(ctx context.Context, options map[string]interface{}) (total int64)
+	FetchOneTicketCancellationJob(ctx context.Context, options map[string]interface{}) (row *mongo_model.TicketCancellationJob, err error)
+	CreateOneTicketCancellationJob(ctx context.Context, job *mongo_model.TicketCancellationJob) (err error)
+	UpdatePartialTicketCancellationJob(ctx context.Context, options, field map[string]interface{}) (err error)
+	ClaimTicketCancellationJob(ctx context.Context, id string, staleBefore, now time.Time) (matched bool, err error)
+
+	// Auth Session
+	FetchOneAuthSession(ctx context.Context, options map[string]interface{}) (row *mongo_model.AuthSession, err error)
+	CreateOneAuthSession(ctx context.Context, authSession *mongo_model.AuthSession) (err error)
+	UpdatePartialAuthSession(ctx context.Context, options, field map[string]interface{}) (err error)
+	UpdateManyAuthSessionPartial(ctx context.Context, options, field map[string]interface{}) (err error)
+	RotateAuthSessionRefreshToken(ctx context.Context, id string, oldRefreshTokenHash, newRefreshTokenHash string, now time.Time) (row *mongo_model.AuthSession, err error)
+
+	// Login Attempt
+	FetchListLoginAttempt(ctx context.Context, options map[string]interface{}) (cur *mongo.Cursor, err error)
+	CountLoginAttempt(ctx context.Context, options map[string]interface{}) (total int64)
+	FetchOneLoginAttempt(ctx context.Context, options map[string]interface{}) (row *mongo_model.LoginAttempt, err error)
+	IncrementLoginAttempt(ctx context.Context, role mongo_model.ActorRole, attemptType mongo_model.LoginAttemptType, identifier string, policy mongo_model.LoginAttemptPolicy, now, windowStart time.Time) (row *mongo_model.LoginAttempt, err error)
+	UpdatePartialLoginAttempt(ctx context.Context, options, field map[string]interface{}) (err error)
+
+	// OIDC State
+	CreateOneOIDCState(ctx context.Context, oidcState *mongo_model.OIDCState) (err error)
+	ConsumeOIDCState(ctx context.Context, state string, now time.Time) (row *mongo_model.OIDCState, err error)
+
+	// Role
+	FetchListRole(ctx context.Context, options map[string]interface{}) (cur *mongo.Cursor, err error)
+	CountRole(ctx context.Context, options map[string]interface{}) (total int64)
+	FetchOneRole(ctx context.Context, options map[string]interface{}) (row *mongo_model.Role, err error)
+	CreateOneRole(ctx context.Context, role *mongo_model.Role) (err error)
+	UpdatePartialRole(ctx context.Context, options, field map[string]interface{}) (err error)
+
+	// Audit Log
+	FetchListAuditLog(ctx context.Context, options map[string]interface{}) (cur *mongo.Cursor, err error)
+	CountAuditLog(ctx context.Context, options map[string]interface{}) (total int64)
+	FetchOneAuditLog(ctx context.Context, options map[string]interface{}) (row *mongo_model.AuditLog, err error)
+	CreateOneAuditLog(ctx context.Context, auditLog *mongo_model.AuditLog) (err error)
+
+	// API Key
+	FetchListAPIKey(ctx context.Context, options map[string]interface{}) (cur *mongo.Cursor, err error)
+	CountAPIKey(ctx context.Context, options map[string]interface{}) (total int64)
+	FetchOneAPIKey(ctx context.Context, options map[string]interface{}) (row *mongo_model.APIKey, err error)
+	CreateOneAPIKey(ctx context.Context, apiKey *mongo_model.APIKey) (err error)
+	UpdatePartialAPIKey(ctx context.Context, options, field map[string]interface{}) (err error)
+	IncrementAPIKeyUsage(ctx context.Context, id string, now, windowStart time.Time) (row *mongo_model.APIKey, err error)
+
+	// Match
+	FetchListMatch(ctx context.Context, options map[string]interface{}) (cur *mongo.Cursor, err error)
+	CountMatch(ctx context.Context, options map[string]interface{}) (total int64)
+	FetchOneMatch(ctx context.Context, options map[string]interface{}) (row *mongo_model.Match, err error)
+	CreateOneMatch(ctx context.Context, match *mongo_model.Match) (err error)
+	UpdatePartialMatch(ctx context.Context, options, field map[string]interface{}) (err error)
+	UpdateManyMatchPartial(ctx context.Context, options, field map[string]interface{}) (err error)
+	StartMatch(ctx context.Context, id string, now time.Time) (matched bool, err error)
+	UpdateMatchScore(ctx context.Context, id string, scoreVersion int64, score mongo_model.MatchScore, now time.Time) (matched bool, err error)
+
+	// Match Event
+	FetchListMatchEvent(ctx context.Context, options map[string]interface{}) (cur *mongo.Cursor, err error)
+	CountMatchEvent(ctx context.Context, options map[string]interface{}) (total int64)
+	FetchOneMatchEvent(ctx context.Context, options map[string]interface{}) (row *mongo_model.MatchEvent, err error)
+	CreateOneMatchEvent(ctx context.Context, matchEvent *mongo_model.MatchEvent) (err error)
+	UpdatePartialMatchEvent(ctx context.Context, options, field map[string]interface{}) (err error)
+	AggregatePlayerStats(ctx context.Context, options map[string]interface{}) (list []mongo_model.PlayerStats, total int64, err error)
+
+	// Standing
+	FetchListStanding(ctx context.Context, options map[string]interface{}) (cur *mongo.Cursor, err error)
+	FetchOneStanding(ctx context.Context, options map[string]interface{}) (row *mongo_model.Standing, err error)
+	UpsertStanding(ctx context.Context, standing *mongo_model.Standing) (err error)
+	UpdateManyStandingPartial(ctx context.Context, options, field map[string]interface{}) (err error)
+
+	// Counter
+	IncrementCounter(ctx context.Context, key string) (value int64, err error)
 }
 
 type S3Repo interface {
@@ -135,3 +252,10 @@ type S3Repo interface {
 type XenditRepo interface {
 	GenereteSnapLink(ctx context.Context, purchase mongo_model.Purchase) (helpers.Response, error)
 }
+
+type OIDCRepo interface {
+	IsEnabled() bool
+	AuthorizationURL(ctx context.Context, state, nonce, codeChallenge string) (string, error)
+	ExchangeCode(ctx context.Context, code, codeVerifier string) (*oidc_model.TokenResponse, error)
+	VerifyIDToken(ctx context.Context, rawIDToken, nonce string) (*oidc_model.IDTokenClaims, error)
+}
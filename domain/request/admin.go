@@ -0,0 +1,19 @@
+package request
+
+type AdminCreateRequest struct {
+	Name     string `json:"name"`
+	Email    string `json:"email"`
+	Username string `json:"username"`
+	VenueID  string `json:"venueId"`
+}
+
+type AdminUpdateRequest struct {
+	Name     string `json:"name"`
+	Email    string `json:"email"`
+	Username string `json:"username"`
+	VenueID  string `json:"venueId"`
+}
+
+type AdminStatusUpdateRequest struct {
+	IsDisabled bool `json:"isDisabled"`
+}
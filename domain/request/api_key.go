@@ -0,0 +1,11 @@
+package request
+
+type APIKeyRequest struct {
+	Name        string   `json:"name"`
+	Description string   `json:"description"`
+	Scopes      []string `json:"scopes"`
+	// requests per minute, empty uses the default rate limit
+	RateLimit int `json:"rateLimit"`
+	// RFC3339, empty never expires
+	ExpiredAt string `json:"expiredAt"`
+}
@@ -28,3 +28,41 @@ type VerifyEmailRequest struct {
 type ResendEmailVerificationRequest struct {
 	Email string `json:"email"`
 }
+
+type ForgotPasswordRequest struct {
+	Email string `json:"email"`
+}
+
+type ResetPasswordRequest struct {
+	Token    string `json:"token"`
+	Password string `json:"password"`
+}
+
+type OIDCCallbackRequest struct {
+	Code  string `json:"code"`
+	State string `json:"state"`
+}
+
+type RefreshTokenRequest struct {
+	RefreshToken string `json:"refreshToken"`
+}
+
+type TwoFactorVerifyRequest struct {
+	ChallengeToken string `json:"challengeToken"`
+	// either the authenticator code or one of the recovery codes
+	Code         string `json:"code"`
+	RecoveryCode string `json:"recoveryCode"`
+}
+
+type TwoFactorChallengeRequest struct {
+	ChallengeToken string `json:"challengeToken"`
+}
+
+type TwoFactorCodeRequest struct {
+	Code string `json:"code"`
+}
+
+type TwoFactorDisableRequest struct {
+	Password string `json:"password"`
+	Code     string `json:"code"`
+}
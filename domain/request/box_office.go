@@ -0,0 +1,11 @@
+package request
+
+type BoxOfficeSaleRequest struct {
+	TicketID      string `json:"ticketId"`
+	Amount        int64  `json:"amount"`
+	PaymentMethod string `json:"paymentMethod"`
+	EdcReference  string `json:"edcReference"`
+	BuyerName     string `json:"buyerName"`
+	BuyerEmail    string `json:"buyerEmail"`
+	BuyerPhone    string `json:"buyerPhone"`
+}
@@ -16,6 +16,7 @@ type CandidateVoteRequest struct {
 	CandidateID string `json:"candidateId"`
 }
 
+// CandidatePerformanceRequest leave empty to pull the performance from the recorded matches of the voting series
 type CandidatePerformanceRequest struct {
 	TeamLeaderboard int64 `json:"teamLeaderboard"`
 	Goal            int64 `json:"goal"`
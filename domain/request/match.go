@@ -0,0 +1,23 @@
+package request
+
+type MatchRequest struct {
+	// optional on create, links the match to a ticket day, it can not be changed on update
+	TicketID         string `json:"ticketId"`
+	HomeSeasonTeamID string `json:"homeSeasonTeamId"`
+	AwaySeasonTeamID string `json:"awaySeasonTeamId"`
+	// optional, default the venue of the ticket day
+	VenueID string `json:"venueId"`
+	// RFC3339, must be on the ticket day when linked
+	KickoffAt string `json:"kickoffAt"`
+	// scheduled, live, finished or postponed, default scheduled
+	Status string `json:"status"`
+	// required when finished
+	Score *MatchScoreRequest `json:"score"`
+	// finished only, minutes played including stoppage time, default 90
+	Duration int `json:"duration"`
+}
+
+type MatchScoreRequest struct {
+	Home int `json:"home"`
+	Away int `json:"away"`
+}
@@ -0,0 +1,21 @@
+package request
+
+type MatchEventRequest struct {
+	// start, goal, assist, save, yellow_card, red_card, foul or substitution
+	Type string `json:"type"`
+	// 0 for start, a player of the starting lineup
+	Minute             int    `json:"minute"`
+	SeasonTeamPlayerID string `json:"seasonTeamPlayerId"`
+	// substitution only, the player going off, seasonTeamPlayerId is the player coming on
+	SubstitutedSeasonTeamPlayerID string `json:"substitutedSeasonTeamPlayerId"`
+	// goal only, counts for the other team
+	IsOwnGoal bool   `json:"isOwnGoal"`
+	Note      string `json:"note"`
+}
+
+type MatchStatusUpdateRequest struct {
+	// live or finished
+	Status string `json:"status"`
+	// finished only, minutes played including stoppage time, default 90
+	Duration int `json:"duration"`
+}
@@ -0,0 +1,28 @@
+package request
+
+type MemberProfileUpdateRequest struct {
+	Name   string `json:"name"`
+	Phone  string `json:"phone"`
+	Age    *int   `json:"age"`
+	Gender string `json:"gender"`
+}
+
+type ChangePasswordRequest struct {
+	CurrentPassword string `json:"currentPassword"`
+	NewPassword     string `json:"newPassword"`
+}
+
+type DeleteAccountRequest struct {
+	// not required for members who only sign in with social login
+	Password string `json:"password"`
+}
+
+type ChangeEmailRequest struct {
+	Email    string `json:"email"`
+	Password string `json:"password"`
+}
+
+type MemberImpersonateRequest struct {
+	// why support needs to view as the member, kept in the audit log
+	Reason string `json:"reason"`
+}
@@ -0,0 +1,12 @@
+package request
+
+import mongo_model "app/domain/model/mongo"
+
+type RescheduleRefundRequest struct {
+	TicketID string `json:"ticketId"`
+}
+
+type RefundStatusUpdateRequest struct {
+	Status mongo_model.RefundStatus `json:"status"`
+	Note   string                   `json:"note"`
+}
@@ -0,0 +1,12 @@
+package request
+
+type RoleRequest struct {
+	Name        string   `json:"name"`
+	Description string   `json:"description"`
+	Permissions []string `json:"permissions"`
+}
+
+type SuperadminRoleUpdateRequest struct {
+	// empty role id makes the user an owner with every permission
+	RoleID string `json:"roleId"`
+}
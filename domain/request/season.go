@@ -10,6 +10,12 @@ type SeasonUpdateRequest struct {
 	Name string `form:"name" validate:"required"`
 }
 
+type SeasonPassUpdateRequest struct {
+	IsEnabled *bool   `json:"isEnabled"`
+	Price     float64 `json:"price"`
+	Stock     int64   `json:"stock"`
+}
+
 type SeasonStatusUpdateRequest struct {
 	Status *mongo_model.SeasonStatus `json:"status"`
 }
@@ -8,3 +8,8 @@ type SeasonTeamManageRequest struct {
 	AddedTeamIds   []string `json:"addedTeamIds"`
 	RemovedTeamIds []string `json:"removedTeamIds"`
 }
+
+type SeasonTeamGroupUpdateRequest struct {
+	// empty removes the team from its group
+	Group string `json:"group"`
+}
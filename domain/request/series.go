@@ -3,11 +3,14 @@ package request
 import mongo_model "app/domain/model/mongo"
 
 type SeriesCreateRequest struct {
-	Name      string  `json:"name"`
-	VenueID   string  `json:"venueId"`
-	Price     float64 `json:"price"`
-	StartDate string  `json:"startDate"`
-	EndDate   string  `json:"endDate"`
+	Name        string  `json:"name"`
+	VenueID     string  `json:"venueId"`
+	Price       float64 `json:"price"`
+	StartDate   string  `json:"startDate"`
+	EndDate     string  `json:"endDate"`
+	PublishAt   string  `json:"publishAt"`
+	SaleStartAt string  `json:"saleStartAt"`
+	SaleEndAt   string  `json:"saleEndAt"`
 }
 
 type SeriesUpdateRequest struct {
@@ -17,4 +20,8 @@ type SeriesUpdateRequest struct {
 	StartDate string                    `json:"startDate"`
 	EndDate   string                    `json:"endDate"`
 	Status    *mongo_model.SeriesStatus `json:"status"`
+	// nil keeps the current value, empty string clears it
+	PublishAt   *string `json:"publishAt"`
+	SaleStartAt *string `json:"saleStartAt"`
+	SaleEndAt   *string `json:"saleEndAt"`
 }
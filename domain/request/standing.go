@@ -0,0 +1,13 @@
+package request
+
+type StandingRuleRequest struct {
+	PointsWin  int `json:"pointsWin"`
+	PointsDraw int `json:"pointsDraw"`
+	PointsLoss int `json:"pointsLoss"`
+	// applied in order after points: goal_difference, goals_for, goals_against, won or head_to_head
+	TieBreakers []string `json:"tieBreakers"`
+}
+
+type StandingRecomputeRequest struct {
+	SeasonID string `json:"seasonId"`
+}
@@ -6,16 +6,35 @@ type TicketCreateOrUpdateRequest struct {
 }
 
 type TicketRequest struct {
-	ID     string               `json:"id"`
-	Name   string               `json:"name"`
-	Date   string               `json:"date"`
-	Price  float64              `json:"price"`
-	Quota  int64                `json:"quota"`
-	Matchs []TicketMatchRequest `json:"matchs"`
+	ID      string               `json:"id"`
+	Name    string               `json:"name"`
+	Date    string               `json:"date"`
+	VenueID string               `json:"venueId"`
+	Price   float64              `json:"price"`
+	Quota   int64                `json:"quota"`
+	Matchs  []TicketMatchRequest `json:"matchs"`
+	// optional, narrows the series sale window for this ticket day
+	SaleStartAt string `json:"saleStartAt"`
+	SaleEndAt   string `json:"saleEndAt"`
 }
 
 type TicketMatchRequest struct {
+	// optional, keeps the existing match and its result, a match of the same teams is kept when empty
+	MatchID          string `json:"matchId"`
 	HomeSeasonTeamID string `json:"homeSeasonTeamId"`
 	AwaySeasonTeamID string `json:"awaySeasonTeamId"`
 	Time             string `json:"time"`
 }
+
+type TicketRescheduleRequest struct {
+	Date   string `json:"date"`
+	Reason string `json:"reason"`
+	// optional new kick off time of each match, in the same order as ticket matchs
+	MatchTimes []string `json:"matchTimes"`
+	// days holders can opt into a refund, default 7
+	RefundOptInDays int `json:"refundOptInDays"`
+}
+
+type TicketCancelRequest struct {
+	Reason string `json:"reason"`
+}
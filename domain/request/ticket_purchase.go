@@ -3,3 +3,7 @@ package request
 type ScanTicketPurchaseRequest struct {
 	Code string `json:"code"`
 }
+
+type TicketPurchaseReissueRequest struct {
+	Reason string `json:"reason"`
+}
@@ -11,9 +11,60 @@ import (
 
 type SuperadminAppUsecase interface {
 	// Auth
-	Login(ctx context.Context, payload request.SuperadminLoginRequest) helpers.Response
+	Login(ctx context.Context, payload request.SuperadminLoginRequest, clientIP string) helpers.Response
+	RefreshToken(ctx context.Context, payload request.RefreshTokenRequest) helpers.Response
+	Logout(ctx context.Context, claim jwt_helpers.SuperadminJWTClaims) helpers.Response
+	LogoutAllDevices(ctx context.Context, claim jwt_helpers.SuperadminJWTClaims) helpers.Response
 	GetProfile(ctx context.Context, claim jwt_helpers.SuperadminJWTClaims) helpers.Response
 
+	// Two Factor
+	VerifyTwoFactor(ctx context.Context, payload request.TwoFactorVerifyRequest, clientIP string) helpers.Response
+	SetupTwoFactorChallenge(ctx context.Context, payload request.TwoFactorChallengeRequest) helpers.Response
+	SetupTwoFactor(ctx context.Context, claim jwt_helpers.SuperadminJWTClaims) helpers.Response
+	EnableTwoFactor(ctx context.Context, claim jwt_helpers.SuperadminJWTClaims, payload request.TwoFactorCodeRequest) helpers.Response
+	DisableTwoFactor(ctx context.Context, claim jwt_helpers.SuperadminJWTClaims, payload request.TwoFactorDisableRequest) helpers.Response
+	RegenerateRecoveryCodes(ctx context.Context, claim jwt_helpers.SuperadminJWTClaims, payload request.TwoFactorCodeRequest) helpers.Response
+
+	// Admin
+	GetAdminsList(ctx context.Context, queryParam url.Values) helpers.Response
+	GetAdminDetail(ctx context.Context, id string) helpers.Response
+	CreateAdmin(ctx context.Context, payload request.AdminCreateRequest) helpers.Response
+	UpdateAdmin(ctx context.Context, id string, payload request.AdminUpdateRequest) helpers.Response
+	UpdateAdminStatus(ctx context.Context, id string, payload request.AdminStatusUpdateRequest) helpers.Response
+	ResendAdminInvite(ctx context.Context, id string) helpers.Response
+	DeleteAdmin(ctx context.Context, id string) helpers.Response
+
+	// Role
+	GetPermissionsList(ctx context.Context) helpers.Response
+	GetRolesList(ctx context.Context, queryParam url.Values) helpers.Response
+	GetRoleDetail(ctx context.Context, id string) helpers.Response
+	CreateRole(ctx context.Context, claim jwt_helpers.SuperadminJWTClaims, payload request.RoleRequest) helpers.Response
+	UpdateRole(ctx context.Context, claim jwt_helpers.SuperadminJWTClaims, id string, payload request.RoleRequest) helpers.Response
+	DeleteRole(ctx context.Context, id string) helpers.Response
+
+	// Back Office User
+	GetSuperadminsList(ctx context.Context, queryParam url.Values) helpers.Response
+	UpdateSuperadminRole(ctx context.Context, claim jwt_helpers.SuperadminJWTClaims, id string, payload request.SuperadminRoleUpdateRequest) helpers.Response
+
+	// Login Attempt
+	GetLoginAttemptsList(ctx context.Context, queryParam url.Values) helpers.Response
+	UnlockLoginAttempt(ctx context.Context, claim jwt_helpers.SuperadminJWTClaims, id string) helpers.Response
+
+	// Audit Log
+	GetAuditLogsList(ctx context.Context, queryParam url.Values) helpers.Response
+	GetAuditLogDetail(ctx context.Context, id string) helpers.Response
+
+	// API Key
+	GetAPIKeyScopesList(ctx context.Context) helpers.Response
+	GetAPIKeysList(ctx context.Context, queryParam url.Values) helpers.Response
+	GetAPIKeyDetail(ctx context.Context, id string) helpers.Response
+	CreateAPIKey(ctx context.Context, claim jwt_helpers.SuperadminJWTClaims, payload request.APIKeyRequest) helpers.Response
+	UpdateAPIKey(ctx context.Context, id string, payload request.APIKeyRequest) helpers.Response
+	RevokeAPIKey(ctx context.Context, id string) helpers.Response
+
+	// Member
+	ImpersonateMember(ctx context.Context, claim jwt_helpers.SuperadminJWTClaims, id string, payload request.MemberImpersonateRequest) helpers.Response
+
 	// Season
 	GetSeasonsList(ctx context.Context, query url.Values) helpers.Response
 	GetSeasonDetail(ctx context.Context, id string) helpers.Response
@@ -22,6 +73,7 @@ type SuperadminAppUsecase interface {
 	UpdateSeason(ctx context.Context, id string, payload request.SeasonUpdateRequest, request *http.Request) helpers.Response
 	DeleteSeason(ctx context.Context, id string) helpers.Response
 	UpdateSeasonStatus(ctx context.Context, id string, payload request.SeasonStatusUpdateRequest) helpers.Response
+	UpdateSeasonPass(ctx context.Context, id string, payload request.SeasonPassUpdateRequest) helpers.Response
 
 	// Venue
 	GetVenueList(ctx context.Context, query url.Values) helpers.Response
@@ -48,6 +100,7 @@ type SuperadminAppUsecase interface {
 	GetSeasonTeamsList(ctx context.Context, query url.Values) helpers.Response
 	GetSeasonTeamDetail(ctx context.Context, id string) helpers.Response
 	CreateSeasonTeam(ctx context.Context, payload request.SeasonTeamCreateRequest) helpers.Response
+	UpdateSeasonTeamGroup(ctx context.Context, id string, payload request.SeasonTeamGroupUpdateRequest) helpers.Response
 	DeleteSeasonTeam(ctx context.Context, id string) helpers.Response
 	ManageSeasonTeam(ctx context.Context, payload request.SeasonTeamManageRequest) helpers.Response
 
@@ -65,12 +118,38 @@ type SuperadminAppUsecase interface {
 	CreateSeries(ctx context.Context, payload request.SeriesCreateRequest) helpers.Response
 	UpdateSeries(ctx context.Context, id string, payload request.SeriesUpdateRequest) helpers.Response
 	DeleteSeries(ctx context.Context, id string) helpers.Response
+	PublishScheduledSeries(ctx context.Context)
 
 	// Ticket
 	GetTicketsList(ctx context.Context, queryParam url.Values) helpers.Response
 	GetTicketDetail(ctx context.Context, id string) helpers.Response
 	CreateOrUpdateTicket(ctx context.Context, payload request.TicketCreateOrUpdateRequest) helpers.Response
 	DeleteTicket(ctx context.Context, id string) helpers.Response
+	CancelTicket(ctx context.Context, claim jwt_helpers.SuperadminJWTClaims, id string, payload request.TicketCancelRequest) helpers.Response
+	RescheduleTicket(ctx context.Context, claim jwt_helpers.SuperadminJWTClaims, id string, payload request.TicketRescheduleRequest) helpers.Response
+	BackfillTicketMatches(ctx context.Context)
+
+	// Match
+	GetMatchesList(ctx context.Context, queryParam url.Values) helpers.Response
+	GetMatchDetail(ctx context.Context, id string) helpers.Response
+	CreateMatch(ctx context.Context, payload request.MatchRequest) helpers.Response
+	UpdateMatch(ctx context.Context, id string, payload request.MatchRequest) helpers.Response
+	DeleteMatch(ctx context.Context, id string) helpers.Response
+	GetMatchEventsList(ctx context.Context, id string) helpers.Response
+
+	// Standing
+	GetStandingsList(ctx context.Context, queryParam url.Values) helpers.Response
+	RecomputeStandings(ctx context.Context, payload request.StandingRecomputeRequest) helpers.Response
+	UpdateSeasonStandingRule(ctx context.Context, id string, payload request.StandingRuleRequest) helpers.Response
+
+	// Ticket Cancellation Job
+	GetTicketCancellationJobsList(ctx context.Context, queryParam url.Values) helpers.Response
+	GetTicketCancellationJobDetail(ctx context.Context, id string) helpers.Response
+	ResumeTicketCancellationJobs(ctx context.Context)
+
+	// Refund
+	GetRefundsList(ctx context.Context, queryParam url.Values) helpers.Response
+	UpdateRefundStatus(ctx context.Context, claim jwt_helpers.SuperadminJWTClaims, id string, payload request.RefundStatusUpdateRequest) helpers.Response
 
 	// Voting
 	GetVotingList(ctx context.Context, queryParam url.Values) helpers.Response
@@ -78,6 +157,7 @@ type SuperadminAppUsecase interface {
 	CreateVoting(ctx context.Context, payload request.VotingCreateRequest, request *http.Request) helpers.Response
 	UpdateVoting(ctx context.Context, id string, payload request.VotingUpdateRequest, request *http.Request) helpers.Response
 	DeleteVoting(ctx context.Context, id string) helpers.Response
+	RefreshVotingCandidates(ctx context.Context, id string) helpers.Response
 
 	// Candidate
 
@@ -90,18 +170,40 @@ type SuperadminAppUsecase interface {
 	// Purchase
 	GetPurchasesList(ctx context.Context, queryParam url.Values) helpers.Response
 
+	// Ticket Purchase
+	ReissueTicketPurchase(ctx context.Context, claim jwt_helpers.SuperadminJWTClaims, id string, payload request.TicketPurchaseReissueRequest) helpers.Response
+	GetTicketPurchaseReissueLogsList(ctx context.Context, queryParam url.Values) helpers.Response
+
 	// Dashboard
 	GetDashboard(ctx context.Context, queryParam url.Values) helpers.Response
 }
 
 type AdminAppUsecase interface {
 	// Auth
-	Login(ctx context.Context, payload request.AdminLoginRequest) helpers.Response
+	Login(ctx context.Context, payload request.AdminLoginRequest, clientIP string) helpers.Response
+	ForgotPassword(ctx context.Context, payload request.ForgotPasswordRequest) helpers.Response
+	ResetPassword(ctx context.Context, payload request.ResetPasswordRequest) helpers.Response
+	RefreshToken(ctx context.Context, payload request.RefreshTokenRequest) helpers.Response
+	Logout(ctx context.Context, claim jwt_helpers.AdminJWTClaims) helpers.Response
+	LogoutAllDevices(ctx context.Context, claim jwt_helpers.AdminJWTClaims) helpers.Response
 	GetProfile(ctx context.Context, claim jwt_helpers.AdminJWTClaims) helpers.Response
 
 	// Ticket Purchase
-	GetListTicketPurchasesIsUsedToday(ctx context.Context) helpers.Response
-	ScanTicketPurchase(ctx context.Context, payload request.ScanTicketPurchaseRequest) helpers.Response
+	GetListTicketPurchasesIsUsedToday(ctx context.Context, claim jwt_helpers.AdminJWTClaims) helpers.Response
+	ScanTicketPurchase(ctx context.Context, claim jwt_helpers.AdminJWTClaims, payload request.ScanTicketPurchaseRequest) helpers.Response
+
+	// Box Office
+	GetBoxOfficeTicketsList(ctx context.Context, claim jwt_helpers.AdminJWTClaims) helpers.Response
+	CreateBoxOfficeSale(ctx context.Context, claim jwt_helpers.AdminJWTClaims, payload request.BoxOfficeSaleRequest) helpers.Response
+	GetBoxOfficeCashUpReport(ctx context.Context, claim jwt_helpers.AdminJWTClaims, queryParam url.Values) helpers.Response
+
+	// Match
+	GetMatchesList(ctx context.Context, claim jwt_helpers.AdminJWTClaims) helpers.Response
+	UpdateMatchStatus(ctx context.Context, claim jwt_helpers.AdminJWTClaims, id string, payload request.MatchStatusUpdateRequest) helpers.Response
+	GetMatchEventsList(ctx context.Context, claim jwt_helpers.AdminJWTClaims, id string) helpers.Response
+	CreateMatchEvent(ctx context.Context, claim jwt_helpers.AdminJWTClaims, id string, payload request.MatchEventRequest) helpers.Response
+	UpdateMatchEvent(ctx context.Context, claim jwt_helpers.AdminJWTClaims, id, eventId string, payload request.MatchEventRequest) helpers.Response
+	DeleteMatchEvent(ctx context.Context, claim jwt_helpers.AdminJWTClaims, id, eventId string) helpers.Response
 }
 
 type MemberAppUsecase interface {
@@ -109,8 +211,22 @@ type MemberAppUsecase interface {
 	Register(ctx context.Context, payload request.MemberRegisterRequest) helpers.Response
 	VerifyEmail(ctx context.Context, payload request.VerifyEmailRequest) helpers.Response
 	ResendEmailVerification(ctx context.Context, payload request.ResendEmailVerificationRequest) helpers.Response
-	Login(ctx context.Context, payload request.MemberLoginRequest) helpers.Response
+	Login(ctx context.Context, payload request.MemberLoginRequest, clientIP string) helpers.Response
+	ForgotPassword(ctx context.Context, payload request.ForgotPasswordRequest) helpers.Response
+	ResetPassword(ctx context.Context, payload request.ResetPasswordRequest) helpers.Response
+	RefreshToken(ctx context.Context, payload request.RefreshTokenRequest) helpers.Response
+	Logout(ctx context.Context, claim jwt_helpers.MemberJWTClaims) helpers.Response
+	LogoutAllDevices(ctx context.Context, claim jwt_helpers.MemberJWTClaims) helpers.Response
 	GetProfile(ctx context.Context, claim jwt_helpers.MemberJWTClaims) helpers.Response
+	UpdateProfile(ctx context.Context, claim jwt_helpers.MemberJWTClaims, payload request.MemberProfileUpdateRequest) helpers.Response
+	ChangePassword(ctx context.Context, claim jwt_helpers.MemberJWTClaims, payload request.ChangePasswordRequest) helpers.Response
+	ChangeEmail(ctx context.Context, claim jwt_helpers.MemberJWTClaims, payload request.ChangeEmailRequest) helpers.Response
+	OIDCAuthorize(ctx context.Context) helpers.Response
+	OIDCCallback(ctx context.Context, payload request.OIDCCallbackRequest) helpers.Response
+
+	// Account
+	ExportAccountData(ctx context.Context, claim jwt_helpers.MemberJWTClaims) helpers.Response
+	DeleteAccount(ctx context.Context, claim jwt_helpers.MemberJWTClaims, payload request.DeleteAccountRequest) helpers.Response
 
 	// Voting
 	GetVotingList(ctx context.Context, queryParam url.Values) helpers.Response
@@ -125,6 +241,11 @@ type MemberAppUsecase interface {
 	GetPurchaseDetail(ctx context.Context, claim jwt_helpers.MemberJWTClaims, id string) helpers.Response
 	CreatePurchase(ctx context.Context, claim jwt_helpers.MemberJWTClaims, payload request.CreatePurchaseRequest) helpers.Response
 	CreatePackagePurchase(ctx context.Context, claim jwt_helpers.MemberJWTClaims, payload request.CreatePurchaseRequest) helpers.Response
+	CreateSeasonPassPurchase(ctx context.Context, claim jwt_helpers.MemberJWTClaims, payload request.CreatePurchaseRequest) helpers.Response
+	RequestRescheduleRefund(ctx context.Context, claim jwt_helpers.MemberJWTClaims, purchaseId string, payload request.RescheduleRefundRequest) helpers.Response
+
+	// Refund
+	GetRefundsList(ctx context.Context, claim jwt_helpers.MemberJWTClaims, queryParam url.Values) helpers.Response
 
 	// Ticket
 	GetTicketsList(ctx context.Context, queryParam url.Values) helpers.Response
@@ -138,10 +259,28 @@ type MemberAppUsecase interface {
 	GetSeriesDetail(ctx context.Context, id string) helpers.Response
 	GetSeriesListWithTickets(ctx context.Context, queryParam url.Values) helpers.Response
 
+	// Standing
+	GetStandingsList(ctx context.Context, queryParam url.Values) helpers.Response
+
+	// Player Stats
+	GetPlayerStatsList(ctx context.Context, queryParam url.Values) helpers.Response
+
 	// Ticket Purchase
 	GetTicketPurchasesList(ctx context.Context, claim jwt_helpers.MemberJWTClaims, queryParam url.Values) helpers.Response
+	ReissueTicketPurchase(ctx context.Context, claim jwt_helpers.MemberJWTClaims, id string, payload request.TicketPurchaseReissueRequest) helpers.Response
 }
 
 type WebhookAppUsecase interface {
 	HandleXenditWebhook(ctx context.Context, payload request.SnapWebhookRequest) helpers.Response
 }
+
+type PartnerAppUsecase interface {
+	// Fixture
+	GetFixturesList(ctx context.Context, queryParam url.Values) helpers.Response
+
+	// Attendance
+	GetAttendanceList(ctx context.Context, queryParam url.Values) helpers.Response
+
+	// Standing
+	GetStandingsList(ctx context.Context, queryParam url.Values) helpers.Response
+}
@@ -0,0 +1,119 @@
+package helpers
+
+import (
+	"context"
+	"reflect"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+type auditActorKey struct{}
+
+// AuditActor is who sent the request, set by the auth middleware and read when an audit log is written
+type AuditActor struct {
+	ID        string
+	Name      string
+	Role      string
+	IP        string
+	UserAgent string
+}
+
+// auditIgnoredFields are never compared, auditRedactedFields are compared but their value is hidden
+var (
+	auditIgnoredFields  = []string{"_id", "createdAt", "updatedAt"}
+	auditRedactedFields = []string{"password", "passwordToken", "emailToken", "twoFactor", "tokenVersion"}
+)
+
+func WithAuditActor(ctx context.Context, actor AuditActor) context.Context {
+	return context.WithValue(ctx, auditActorKey{}, actor)
+}
+
+func GetAuditActor(ctx context.Context) (AuditActor, bool) {
+	actor, ok := ctx.Value(auditActorKey{}).(AuditActor)
+	return actor, ok
+}
+
+// AuditSnapshot copy a document as stored in mongo, take it before the struct is changed in place
+func AuditSnapshot(document interface{}) bson.M {
+	if document == nil || (reflect.ValueOf(document).Kind() == reflect.Ptr && reflect.ValueOf(document).IsNil()) {
+		return nil
+	}
+	raw, err := bson.Marshal(document)
+	if err != nil {
+		logrus.Error("AuditSnapshot Marshal:", err)
+		return nil
+	}
+	snapshot := bson.M{}
+	if err := bson.Unmarshal(raw, &snapshot); err != nil {
+		logrus.Error("AuditSnapshot Unmarshal:", err)
+		return nil
+	}
+
+	return snapshot
+}
+
+// AuditApply returns a copy of the snapshot with the $set fields of a partial update applied, dotted keys included
+func AuditApply(snapshot bson.M, field map[string]interface{}) bson.M {
+	applied := bson.M{}
+	for key, value := range snapshot {
+		applied[key] = value
+	}
+
+	for key, value := range field {
+		path := strings.Split(key, ".")
+		document := applied
+		for _, name := range path[:len(path)-1] {
+			nested := bson.M{}
+			if current, ok := document[name].(bson.M); ok {
+				for k, v := range current {
+					nested[k] = v
+				}
+			}
+			document[name] = nested
+			document = nested
+		}
+		document[path[len(path)-1]] = value
+	}
+
+	return AuditSnapshot(applied)
+}
+
+// AuditDiff returns the top level fields that differ between two snapshots,
+// a nil snapshot on one side (create or hard delete) keeps every field of the other side
+func AuditDiff(before, after bson.M) (bson.M, bson.M) {
+	changedBefore := bson.M{}
+	changedAfter := bson.M{}
+
+	keys := map[string]bool{}
+	for key := range before {
+		keys[key] = true
+	}
+	for key := range after {
+		keys[key] = true
+	}
+
+	for key := range keys {
+		if InArrayString(auditIgnoredFields, key) {
+			continue
+		}
+		beforeValue, inBefore := before[key]
+		afterValue, inAfter := after[key]
+		if before != nil && after != nil && reflect.DeepEqual(beforeValue, afterValue) {
+			continue
+		}
+
+		if InArrayString(auditRedactedFields, key) {
+			beforeValue, afterValue = "[REDACTED]", "[REDACTED]"
+		}
+		if inBefore {
+			changedBefore[key] = beforeValue
+		}
+		if inAfter {
+			changedAfter[key] = afterValue
+		}
+	}
+
+	return changedBefore, changedAfter
+}
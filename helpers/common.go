@@ -2,7 +2,9 @@ package helpers
 
 import (
 	"fmt"
+	"math"
 	"regexp"
+	"strconv"
 	"strings"
 	"time"
 )
@@ -78,6 +80,17 @@ func SetToEndOfDayWIB(t time.Time) time.Time {
 	return time.Date(t.Year(), t.Month(), t.Day(), 23, 59, 59, int(time.Second-time.Nanosecond), loc)
 }
 
+// SetTimeOfDayWIB set the clock of a day in WIB, clock is formatted 15:04
+func SetTimeOfDayWIB(t time.Time, clock string) (time.Time, error) {
+	parsed, err := time.Parse("15:04", clock)
+	if err != nil {
+		return time.Time{}, err
+	}
+	loc, _ := time.LoadLocation("Asia/Jakarta")
+	t = t.In(loc)
+	return time.Date(t.Year(), t.Month(), t.Day(), parsed.Hour(), parsed.Minute(), 0, 0, loc), nil
+}
+
 func IsSameDateWIB(a, b time.Time) bool {
 	loc, _ := time.LoadLocation("Asia/Jakarta")
 	a = a.In(loc)
@@ -97,11 +110,12 @@ func FormatDateWIB(t time.Time, layout string) string {
 	return ConvertUTCToWIB(t).Format(layout)
 }
 
-func GenerateInvoiceExternalId(count int64) string {
+// GenerateInvoiceExternalId formats the sequence of the day into an invoice external ID
+func GenerateInvoiceExternalId(now time.Time, sequence int64) string {
 	prefix := "TRX"
 	randomChar, _ := GenerateSecureRandomChar(3)
-	timestamp := time.Now().Format("20060102")
-	counter := fmt.Sprintf("%03d", count+1)
+	timestamp := now.Format("20060102")
+	counter := fmt.Sprintf("%03d", sequence)
 
 	return fmt.Sprintf("%s-%s%s-%s", prefix, timestamp, randomChar, counter)
 }
@@ -110,3 +124,23 @@ func SanitizeString(str string) string {
 	re := regexp.MustCompile(`[^\w\d_-]`)
 	return re.ReplaceAllString(str, "_")
 }
+
+// FormatRupiah formats amount to rupiah with dot thousand separator, e.g. Rp 150.000
+func FormatRupiah(amount float64) string {
+	str := strconv.FormatInt(int64(math.Round(amount)), 10)
+	negative := strings.HasPrefix(str, "-")
+	str = strings.TrimPrefix(str, "-")
+
+	var result []byte
+	for i := range str {
+		if i > 0 && (len(str)-i)%3 == 0 {
+			result = append(result, '.')
+		}
+		result = append(result, str[i])
+	}
+
+	if negative {
+		return "-Rp " + string(result)
+	}
+	return "Rp " + string(result)
+}
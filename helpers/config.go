@@ -3,6 +3,7 @@ package helpers
 import (
 	"os"
 	"strconv"
+	"strings"
 )
 
 func GetFEUrl() string {
@@ -13,6 +14,18 @@ func GetFEUrl() string {
 	return feUrl
 }
 
+// GetTrustedProxies returns the proxies allowed to set the client ip headers, none are trusted by default
+// so the client ip is always the remote address
+func GetTrustedProxies() []string {
+	proxies := make([]string, 0)
+	for _, proxy := range strings.Split(os.Getenv("TRUSTED_PROXIES"), ",") {
+		if proxy = strings.TrimSpace(proxy); proxy != "" {
+			proxies = append(proxies, proxy)
+		}
+	}
+	return proxies
+}
+
 func GetMaxFileUploadSize() int64 {
 	maxFileUploadSize, _ := strconv.ParseInt(os.Getenv("MAX_FILE_SIZE"), 10, 64)
 	if maxFileUploadSize <= 0 {
@@ -20,3 +33,107 @@ func GetMaxFileUploadSize() int64 {
 	}
 	return maxFileUploadSize * 1024 * 1024
 }
+
+func GetPasswordResetTTL() int64 {
+	passwordResetTTL, _ := strconv.ParseInt(os.Getenv("PASSWORD_RESET_TTL"), 10, 64)
+	if passwordResetTTL <= 0 {
+		passwordResetTTL = 60 // default 60 minutes
+	}
+	return passwordResetTTL
+}
+
+func GetEmailVerificationTTL() int64 {
+	emailVerificationTTL, _ := strconv.ParseInt(os.Getenv("EMAIL_VERIFICATION_TTL"), 10, 64)
+	if emailVerificationTTL <= 0 {
+		emailVerificationTTL = 1440 // default 24 hours
+	}
+	return emailVerificationTTL
+}
+
+func GetEmailVerificationResendInterval() int64 {
+	resendInterval, _ := strconv.ParseInt(os.Getenv("EMAIL_VERIFICATION_RESEND_INTERVAL"), 10, 64)
+	if resendInterval <= 0 {
+		resendInterval = 60 // default 60 seconds
+	}
+	return resendInterval
+}
+
+func GetOIDCStateTTL() int64 {
+	oidcStateTTL, _ := strconv.ParseInt(os.Getenv("OIDC_STATE_TTL"), 10, 64)
+	if oidcStateTTL <= 0 {
+		oidcStateTTL = 10 // default 10 minutes
+	}
+	return oidcStateTTL
+}
+
+func GetAdminInviteTTL() int64 {
+	adminInviteTTL, _ := strconv.ParseInt(os.Getenv("ADMIN_INVITE_TTL"), 10, 64)
+	if adminInviteTTL <= 0 {
+		adminInviteTTL = 4320 // default 3 days in minutes
+	}
+	return adminInviteTTL
+}
+
+func GetLoginMaxAttempts() int {
+	maxAttempts, _ := strconv.Atoi(os.Getenv("LOGIN_MAX_ATTEMPTS"))
+	if maxAttempts <= 0 {
+		maxAttempts = 5 // default 5 failures per account
+	}
+	return maxAttempts
+}
+
+func GetLoginIPMaxAttempts() int {
+	maxAttempts, _ := strconv.Atoi(os.Getenv("LOGIN_IP_MAX_ATTEMPTS"))
+	if maxAttempts <= 0 {
+		maxAttempts = 20 // default 20 failures per ip
+	}
+	return maxAttempts
+}
+
+func GetLoginAttemptWindow() int64 {
+	window, _ := strconv.ParseInt(os.Getenv("LOGIN_ATTEMPT_WINDOW"), 10, 64)
+	if window <= 0 {
+		window = 15 // default 15 minutes
+	}
+	return window
+}
+
+func GetLoginLockoutDuration() int64 {
+	lockoutDuration, _ := strconv.ParseInt(os.Getenv("LOGIN_LOCKOUT_DURATION"), 10, 64)
+	if lockoutDuration <= 0 {
+		lockoutDuration = 15 // default 15 minutes
+	}
+	return lockoutDuration
+}
+
+func GetLoginBackoffBase() int64 {
+	backoffBase, _ := strconv.ParseInt(os.Getenv("LOGIN_BACKOFF_BASE"), 10, 64)
+	if backoffBase < 0 {
+		backoffBase = 0
+	}
+	if os.Getenv("LOGIN_BACKOFF_BASE") == "" {
+		backoffBase = 1 // default 1 second, doubled on every failure
+	}
+	return backoffBase
+}
+
+func GetSuperadminTwoFactorRequired() bool {
+	required, _ := strconv.ParseBool(os.Getenv("SUPERADMIN_2FA_REQUIRED"))
+	return required
+}
+
+func GetTwoFactorIssuer() string {
+	issuer := os.Getenv("TWO_FACTOR_ISSUER")
+	if issuer == "" {
+		issuer = "PFL"
+	}
+	return issuer
+}
+
+func GetAPIKeyDefaultRateLimit() int {
+	rateLimit, _ := strconv.Atoi(os.Getenv("API_KEY_DEFAULT_RATE_LIMIT"))
+	if rateLimit <= 0 {
+		rateLimit = 60 // default 60 requests per minute
+	}
+	return rateLimit
+}
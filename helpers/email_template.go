@@ -82,10 +82,18 @@ func GetEmailTicketPurchaseQRTemplate() (subject string, body string) {
 							href="{{ticket_purchase_url}}"
 							style="color: #2b51c0;">{{ticket_purchase_url}}</a></p>
 
+					<div style="padding: 0 0 20px 0;">
+						<h4 style="margin-top: 0;">Detail Tiket</h4>
+						<ul style="padding-left: 20px; font-size: 14px;">
+							{{ticket_list}}
+						</ul>
+					</div>
+
 					<div style="background-color: #FAFAFA; padding: 15px; border-radius: 8px;">
 						<h4 style="margin-top: 0;">Informasi Penting</h4>
 						<ul style="padding-left: 20px; font-size: 14px;">
 							<li>Setiap tiket hanya berlaku untuk satu hari pertandingan sesuai tanggal yang tertera</li>
+							<li>Pastikan datang ke venue sesuai yang tertera pada tiket</li>
 							<li>Pastikan untuk menyimpan tiket digital Anda dengan baik</li>
 							<li>Direkomendasikan hadir 30 menit sebelum pertandingan dimulai</li>
 							<li>Tiket tidak dapat dipindahkan atau dijual kembali</li>
@@ -107,3 +115,313 @@ func GetEmailTicketPurchaseQRTemplate() (subject string, body string) {
 
 	return subject, body
 }
+
+func GetEmailTicketPurchaseReissueTemplate() (subject string, body string) {
+	subject = "Tiket Pro Futsal League Anda Telah Diterbitkan Ulang"
+	body = `
+		<!DOCTYPE html>
+		<html lang="id">
+		<head>
+			<meta charset="UTF-8">
+			<meta name="viewport" content="width=device-width, initial-scale=1.0">
+			<title>QR Ticket Reissue - PFL</title>
+		</head>
+		<body style="font-family: Arial, Helvetica, sans-serif; margin: 0; padding: 0; background-color: #f7f7f7;">
+			<div style="max-width: 680px; margin: 0 auto; background-color: #ffffff;">
+				<div style="margin: 0 auto; padding: 20px; max-width: 624px;">
+					<div style="text-align: center; margin-bottom: 20px;">
+						<img src="logo-blue.png" alt="PFL Logo" style="height: 96px;">
+						<p style="font-size: 20px; font-weight: bold; margin: 10px 0;">QR Tiket Anda Telah Diperbarui</p>
+						<p style="font-size: 14px; margin: 0;">{{ticket_name}} - {{ticket_date}}</p>
+					</div>
+					<p style="font-size: 14px; margin-top: 20px;">QR tiket lama Anda telah dinonaktifkan dan tidak dapat digunakan lagi untuk masuk ke venue. Gunakan QR baru yang terlampir dalam email ini. Anda juga dapat melihat tiket Anda kapan saja melalui tautan berikut:</p>
+					<p style="font-size: 14px; text-align: center; padding: 20px 0;"><a
+							href="{{ticket_purchase_url}}"
+							style="color: #2b51c0;">{{ticket_purchase_url}}</a></p>
+
+					<div style="background-color: #FAFAFA; padding: 15px; border-radius: 8px;">
+						<h4 style="margin-top: 0;">Informasi Penting</h4>
+						<ul style="padding-left: 20px; font-size: 14px;">
+							<li>QR lama yang telah dinonaktifkan akan ditolak saat pemindaian</li>
+							<li>Jangan membagikan foto QR tiket Anda di media sosial</li>
+							<li>Jika Anda tidak merasa meminta penerbitan ulang, segera hubungi kami</li>
+						</ul>
+					</div>
+				</div>
+				<div
+					style="margin-top: 30px; text-align: center; font-size: 13px; background: linear-gradient(to right, #00009B, #000035); color: #fff; padding: 15px;">
+					Memunyai kendala terkait pembelian tiket?<br>
+					Hubungi kami via email: <a style="color:#fff;" href="mailto:cs@profutsalleague">cs@profutsalleague</a>
+				</div>
+			</div>
+		</body>
+
+		</html>
+	`
+
+	return subject, body
+}
+
+func GetEmailTicketDayCancelledTemplate() (subject string, body string) {
+	subject = "Pembatalan Hari Pertandingan Pro Futsal League"
+	body = `
+		<!DOCTYPE html>
+		<html lang="id">
+		<head>
+			<meta charset="UTF-8">
+			<meta name="viewport" content="width=device-width, initial-scale=1.0">
+			<title>Pembatalan Tiket - PFL</title>
+		</head>
+		<body style="font-family: Arial, Helvetica, sans-serif; margin: 0; padding: 0; background-color: #f7f7f7;">
+			<div style="max-width: 680px; margin: 0 auto; background-color: #ffffff;">
+				<div style="margin: 0 auto; padding: 20px; max-width: 624px;">
+					<div style="text-align: center; margin-bottom: 20px;">
+						<img src="logo-blue.png" alt="PFL Logo" style="height: 96px;">
+						<p style="font-size: 20px; font-weight: bold; margin: 10px 0;">Hari Pertandingan Dibatalkan</p>
+						<p style="font-size: 14px; margin: 0;">{{ticket_name}} - {{ticket_date}}</p>
+					</div>
+					<p style="font-size: 14px; margin-top: 20px;">Halo {{member_name}}, dengan berat hati kami informasikan bahwa hari pertandingan di atas dibatalkan dengan alasan berikut:</p>
+					<p style="font-size: 14px; text-align: center; padding: 10px 0; font-style: italic;">{{reason}}</p>
+					<p style="font-size: 14px;">Seluruh QR tiket Anda untuk hari pertandingan tersebut telah dinonaktifkan. Pengembalian dana sebesar <b>{{refund_amount}}</b> untuk transaksi <b>{{invoice_external_id}}</b> sedang kami proses.</p>
+
+					<div style="background-color: #FAFAFA; padding: 15px; border-radius: 8px;">
+						<h4 style="margin-top: 0;">Informasi Penting</h4>
+						<ul style="padding-left: 20px; font-size: 14px;">
+							<li>Untuk pembelian paket, pengembalian dana dihitung sesuai porsi hari pertandingan yang dibatalkan</li>
+							<li>Tiket untuk hari pertandingan lain tetap berlaku</li>
+							<li>Tim kami akan menghubungi Anda apabila diperlukan data tambahan untuk pengembalian dana</li>
+						</ul>
+					</div>
+				</div>
+				<div
+					style="margin-top: 30px; text-align: center; font-size: 13px; background: linear-gradient(to right, #00009B, #000035); color: #fff; padding: 15px;">
+					Memunyai kendala terkait pembelian tiket?<br>
+					Hubungi kami via email: <a style="color:#fff;" href="mailto:cs@profutsalleague">cs@profutsalleague</a>
+				</div>
+			</div>
+		</body>
+
+		</html>
+	`
+
+	return subject, body
+}
+
+func GetEmailTicketDayRescheduledTemplate() (subject string, body string) {
+	subject = "Perubahan Jadwal Pertandingan Pro Futsal League"
+	body = `
+		<!DOCTYPE html>
+		<html lang="id">
+		<head>
+			<meta charset="UTF-8">
+			<meta name="viewport" content="width=device-width, initial-scale=1.0">
+			<title>Perubahan Jadwal - PFL</title>
+		</head>
+		<body style="font-family: Arial, Helvetica, sans-serif; margin: 0; padding: 0; background-color: #f7f7f7;">
+			<div style="max-width: 680px; margin: 0 auto; background-color: #ffffff;">
+				<div style="margin: 0 auto; padding: 20px; max-width: 624px;">
+					<div style="text-align: center; margin-bottom: 20px;">
+						<img src="logo-blue.png" alt="PFL Logo" style="height: 96px;">
+						<p style="font-size: 20px; font-weight: bold; margin: 10px 0;">Jadwal Pertandingan Berubah</p>
+						<p style="font-size: 14px; margin: 0;">{{ticket_name}}</p>
+					</div>
+					<p style="font-size: 14px; margin-top: 20px;">Halo {{member_name}}, hari pertandingan pada tiket Anda telah dipindahkan dengan alasan berikut:</p>
+					<p style="font-size: 14px; text-align: center; padding: 10px 0; font-style: italic;">{{reason}}</p>
+					<p style="font-size: 14px; text-align: center;"><s>{{previous_date}}</s> &rarr; <b>{{new_date}}</b></p>
+					<p style="font-size: 14px; text-align: center;">Jam pertandingan: {{match_times}}</p>
+					<p style="font-size: 14px;">Tiket dan QR Anda tetap berlaku untuk jadwal baru. Jika Anda tidak dapat hadir, Anda dapat mengajukan pengembalian dana untuk hari pertandingan ini melalui halaman pembelian hingga <b>{{refund_opt_in_until}}</b>:</p>
+					<p style="font-size: 14px; text-align: center; padding: 20px 0;"><a
+							href="{{purchase_url}}"
+							style="color: #2b51c0;">{{purchase_url}}</a></p>
+
+					<div style="background-color: #FAFAFA; padding: 15px; border-radius: 8px;">
+						<h4 style="margin-top: 0;">Informasi Penting</h4>
+						<ul style="padding-left: 20px; font-size: 14px;">
+							<li>Pengembalian dana membatalkan QR tiket Anda untuk hari pertandingan ini</li>
+							<li>Untuk pembelian paket, pengembalian dana dihitung sesuai porsi hari pertandingan yang dipindahkan</li>
+							<li>Tiket untuk hari pertandingan lain tidak berubah</li>
+						</ul>
+					</div>
+				</div>
+				<div
+					style="margin-top: 30px; text-align: center; font-size: 13px; background: linear-gradient(to right, #00009B, #000035); color: #fff; padding: 15px;">
+					Memunyai kendala terkait pembelian tiket?<br>
+					Hubungi kami via email: <a style="color:#fff;" href="mailto:cs@profutsalleague">cs@profutsalleague</a>
+				</div>
+			</div>
+		</body>
+
+		</html>
+	`
+
+	return subject, body
+}
+
+func GetEmailResetPasswordTemplate() (subject string, body string) {
+	subject = "Reset Password Akun PFL"
+	body = `
+		<!DOCTYPE html>
+		<html lang="en">
+		<head>
+			<meta charset="UTF-8">
+			<meta name="viewport" content="width=device-width, initial-scale=1.0">
+			<title>PFL Reset Password</title>
+		</head>
+		<body style="font-family: Arial, Helvetica, sans-serif; margin: 0; padding: 0; background-color: #f7f7f7;">
+
+			<div style="width: 100%; max-width: 600px; margin: 0 auto; background-color: #ffffff; padding: 20px;">
+
+				<!-- PFL Text -->
+				<div style="font-size: 32px; color: #00009C; font-weight: bold; text-align: center; margin-bottom: 20px;">
+					PFL
+				</div>
+
+				<!-- Greeting -->
+				<h1 style="font-size: 24px; font-weight: 600; text-align: center; margin-bottom: 20px;">
+					Halo, {{user_name}}
+				</h1>
+
+				<!-- Message -->
+				<p style="font-size: 18px; text-align: center; margin-bottom: 30px;">
+					Kami menerima permintaan untuk mengatur ulang password akun Anda
+				</p>
+
+				<!-- Instructions -->
+				<div style="background-color: #f9f9f9; padding: 20px; border-radius: 8px; text-align: center;">
+					<h2 style="font-size: 20px; font-weight: bold; margin-bottom: 15px;">Petunjuk Selanjutnya:</h2>
+					<p style="font-size: 16px; margin-bottom: 20px;">
+						Klik tombol di bawah ini untuk membuat password baru. Link ini hanya dapat digunakan satu kali dan berlaku selama {{expired_in}} menit.
+					</p>
+					<a href="{{link_reset_password}}" style="display: inline-block; background-color: #0000aa; color: white; padding: 12px 24px; text-decoration: none; border-radius: 4px; font-weight: 500; font-size: 16px;">
+						Reset Password
+					</a>
+				</div>
+
+				<!-- Disclaimer -->
+				<p style="font-size: 14px; text-align: center; margin-top: 30px;">
+					Jika Anda tidak merasa meminta reset password, silakan abaikan email ini. Password Anda tidak akan berubah.
+				</p>
+
+				<!-- Footer -->
+				<div style="background-image: linear-gradient(to right, #00009C, #000022); color: white; text-align: center; padding: 20px;">
+					<p>Mempunyai kendala terkait akun?</p>
+					<p>Silahkan kontak email CS kami di <a href="mailto:cs@profutsaleague" style="color: white;">cs@profutsaleague</a></p>
+				</div>
+
+			</div>
+
+		</body>
+		</html>
+	`
+	return
+}
+
+func GetEmailAdminInviteTemplate() (subject string, body string) {
+	subject = "Undangan Akun Admin PFL"
+	body = `
+		<!DOCTYPE html>
+		<html lang="en">
+		<head>
+			<meta charset="UTF-8">
+			<meta name="viewport" content="width=device-width, initial-scale=1.0">
+			<title>PFL Admin Invitation</title>
+		</head>
+		<body style="font-family: Arial, Helvetica, sans-serif; margin: 0; padding: 0; background-color: #f7f7f7;">
+
+			<div style="width: 100%; max-width: 600px; margin: 0 auto; background-color: #ffffff; padding: 20px;">
+
+				<!-- PFL Text -->
+				<div style="font-size: 32px; color: #00009C; font-weight: bold; text-align: center; margin-bottom: 20px;">
+					PFL
+				</div>
+
+				<!-- Greeting -->
+				<h1 style="font-size: 24px; font-weight: 600; text-align: center; margin-bottom: 20px;">
+					Halo, {{user_name}}
+				</h1>
+
+				<!-- Message -->
+				<p style="font-size: 18px; text-align: center; margin-bottom: 30px;">
+					Anda telah didaftarkan sebagai admin gate Pro Futsal League di venue <b>{{venue_name}}</b>
+				</p>
+
+				<!-- Instructions -->
+				<div style="background-color: #f9f9f9; padding: 20px; border-radius: 8px; text-align: center;">
+					<h2 style="font-size: 20px; font-weight: bold; margin-bottom: 15px;">Petunjuk Selanjutnya:</h2>
+					<p style="font-size: 16px; margin-bottom: 10px;">
+						Username Anda: <b>{{username}}</b>
+					</p>
+					<p style="font-size: 16px; margin-bottom: 20px;">
+						Klik tombol di bawah ini untuk membuat password akun Anda. Link ini berlaku selama {{expired_in}} jam.
+					</p>
+					<a href="{{link_setup_password}}" style="display: inline-block; background-color: #0000aa; color: white; padding: 12px 24px; text-decoration: none; border-radius: 4px; font-weight: 500; font-size: 16px;">
+						Buat Password
+					</a>
+				</div>
+
+				<!-- Footer -->
+				<div style="background-image: linear-gradient(to right, #00009C, #000022); color: white; text-align: center; padding: 20px; margin-top: 30px;">
+					<p>Mempunyai kendala terkait akun?</p>
+					<p>Silahkan kontak email CS kami di <a href="mailto:cs@profutsaleague" style="color: white;">cs@profutsaleague</a></p>
+				</div>
+
+			</div>
+
+		</body>
+		</html>
+	`
+	return
+}
+
+func GetEmailAccountLockedTemplate() (subject string, body string) {
+	subject = "Akun PFL Anda Dikunci Sementara"
+	body = `
+		<!DOCTYPE html>
+		<html lang="en">
+		<head>
+			<meta charset="UTF-8">
+			<meta name="viewport" content="width=device-width, initial-scale=1.0">
+			<title>PFL Account Locked</title>
+		</head>
+		<body style="font-family: Arial, Helvetica, sans-serif; margin: 0; padding: 0; background-color: #f7f7f7;">
+
+			<div style="width: 100%; max-width: 600px; margin: 0 auto; background-color: #ffffff; padding: 20px;">
+
+				<!-- PFL Text -->
+				<div style="font-size: 32px; color: #00009C; font-weight: bold; text-align: center; margin-bottom: 20px;">
+					PFL
+				</div>
+
+				<!-- Greeting -->
+				<h1 style="font-size: 24px; font-weight: 600; text-align: center; margin-bottom: 20px;">
+					Halo, {{user_name}}
+				</h1>
+
+				<!-- Message -->
+				<p style="font-size: 18px; text-align: center; margin-bottom: 30px;">
+					Kami mendeteksi beberapa kali percobaan login yang gagal ke akun Anda
+				</p>
+
+				<!-- Info -->
+				<div style="background-color: #f9f9f9; padding: 20px; border-radius: 8px; text-align: center;">
+					<p style="font-size: 16px; margin-bottom: 10px;">
+						Untuk keamanan, akun Anda dikunci sementara hingga <b>{{locked_until}}</b>.
+					</p>
+					<p style="font-size: 16px; margin-bottom: 0;">
+						Jika bukan Anda yang mencoba login, segera ganti password Anda setelah akun dapat digunakan kembali.
+					</p>
+				</div>
+
+				<!-- Footer -->
+				<div style="background-image: linear-gradient(to right, #00009C, #000022); color: white; text-align: center; padding: 20px; margin-top: 30px;">
+					<p>Mempunyai kendala terkait akun?</p>
+					<p>Silahkan kontak email CS kami di <a href="mailto:cs@profutsaleague" style="color: white;">cs@profutsaleague</a></p>
+				</div>
+
+			</div>
+
+		</body>
+		</html>
+	`
+	return
+}
@@ -3,16 +3,35 @@ package jwt_helpers
 import "github.com/golang-jwt/jwt/v5"
 
 type SuperadminJWTClaims struct {
+	UserID       string   `json:"userID"`
+	SessionID    string   `json:"sessionID"`
+	TokenVersion int      `json:"tokenVersion"`
+	Permissions  []string `json:"permissions"`
+	jwt.RegisteredClaims
+}
+
+// SuperadminChallengeJWTClaims is issued after a correct password when the second factor is still needed
+type SuperadminChallengeJWTClaims struct {
 	UserID string `json:"userID"`
 	jwt.RegisteredClaims
 }
 
 type AdminJWTClaims struct {
-	UserID string `json:"userID"`
+	UserID       string `json:"userID"`
+	SessionID    string `json:"sessionID"`
+	TokenVersion int    `json:"tokenVersion"`
 	jwt.RegisteredClaims
 }
 
 type MemberJWTClaims struct {
-	UserID string `json:"userID"`
+	UserID       string `json:"userID"`
+	SessionID    string `json:"sessionID"`
+	TokenVersion int    `json:"tokenVersion"`
+	// ImpersonatorID is the superadmin viewing as the member, the token is read only when set
+	ImpersonatorID string `json:"impersonatorID,omitempty"`
 	jwt.RegisteredClaims
 }
+
+func (c MemberJWTClaims) IsImpersonated() bool {
+	return c.ImpersonatorID != ""
+}
@@ -24,3 +24,19 @@ func GetJWTTTL() int {
 	}
 	return ttl
 }
+
+func GetJWTImpersonationTTL() int {
+	ttl, _ := strconv.Atoi(os.Getenv("JWT_IMPERSONATION_TTL"))
+	if ttl == 0 {
+		ttl = 15 //default value 15 minutes
+	}
+	return ttl
+}
+
+func GetJWTRefreshTTL() int {
+	ttl, _ := strconv.Atoi(os.Getenv("JWT_REFRESH_TTL"))
+	if ttl == 0 {
+		ttl = 43200 //default value 30 days in minutes
+	}
+	return ttl
+}
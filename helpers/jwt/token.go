@@ -39,3 +39,17 @@ func GenerateJWTTokenMember(claims jwt.Claims) (string, error) {
 
 	return tokenString, nil
 }
+
+func ParseJWTTokenSuperadmin(tokenString string, claims jwt.Claims) error {
+	token, err := jwt.ParseWithClaims(tokenString, claims, func(token *jwt.Token) (interface{}, error) {
+		return []byte(GetJWTSecretKeySuperadmin()), nil
+	})
+	if err != nil {
+		return err
+	}
+	if !token.Valid {
+		return jwt.ErrTokenInvalidClaims
+	}
+
+	return nil
+}
@@ -0,0 +1,87 @@
+package mailing_helpers
+
+import (
+	mongo_model "app/domain/model/mongo"
+	"app/helpers"
+	"html"
+	"strconv"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+func SendResetPassword(name, email, resetLink string) {
+	mailer := helpers.NewSMTPMailer()
+
+	// get template
+	subject, body := helpers.GetEmailResetPasswordTemplate()
+
+	// replace string template
+	dataReplace := map[string]string{
+		"user_name":           name,
+		"link_reset_password": resetLink,
+		"expired_in":          strconv.FormatInt(helpers.GetPasswordResetTTL(), 10),
+	}
+	finalBody := helpers.StringReplacer(body, dataReplace)
+
+	// setup mail content
+	mailer.To([]string{email})
+	mailer.Subject(subject)
+	mailer.Body(finalBody)
+
+	// send
+	if err := mailer.Send(); err != nil {
+		logrus.Errorf("Send Email to %s error %v", email, err)
+	}
+}
+
+func SendAdminInvite(admin *mongo_model.Admin, setupLink string) {
+	mailer := helpers.NewSMTPMailer()
+
+	// get template
+	subject, body := helpers.GetEmailAdminInviteTemplate()
+
+	// replace string template
+	dataReplace := map[string]string{
+		"user_name":           html.EscapeString(admin.Name),
+		"username":            html.EscapeString(admin.Username),
+		"venue_name":          html.EscapeString(admin.Venue.Name),
+		"link_setup_password": setupLink,
+		"expired_in":          strconv.FormatInt(helpers.GetAdminInviteTTL()/60, 10),
+	}
+	finalBody := helpers.StringReplacer(body, dataReplace)
+
+	// setup mail content
+	mailer.To([]string{admin.Email})
+	mailer.Subject(subject)
+	mailer.Body(finalBody)
+
+	// send
+	if err := mailer.Send(); err != nil {
+		logrus.Errorf("Send Email to %s error %v", admin.Email, err)
+	}
+}
+
+func SendAccountLocked(name, email string, lockedUntil time.Time) {
+	mailer := helpers.NewSMTPMailer()
+
+	// get template
+	subject, body := helpers.GetEmailAccountLockedTemplate()
+
+	// replace string template
+	dataReplace := map[string]string{
+		"user_name":    html.EscapeString(name),
+		"locked_until": helpers.FormatDateWIB(lockedUntil, "02 January 2006 15:04") + " WIB",
+	}
+	finalBody := helpers.StringReplacer(body, dataReplace)
+
+	// setup mail content
+	mailer.To([]string{email})
+	mailer.Subject(subject)
+	mailer.Body(finalBody)
+
+	// send
+	if err := mailer.Send(); err != nil {
+		logrus.Errorf("Send Email to %s error %v", email, err)
+	}
+}
@@ -4,12 +4,16 @@ import (
 	mongo_model "app/domain/model/mongo"
 	"app/helpers"
 	"fmt"
+	"html"
+	"strings"
 
 	"github.com/sirupsen/logrus"
 )
 
 func SendTicketPurchase(ticketPurchases []*mongo_model.TicketPurchase) {
 	ticketQrByEmail := make(map[string]map[string][]byte)
+	ticketListByEmail := make(map[string][]string)
+	ticketSetByEmail := make(map[string]map[string]struct{})
 
 	for count, ticketPurchase := range ticketPurchases {
 		// generate qr png
@@ -31,6 +35,20 @@ func SendTicketPurchase(ticketPurchases []*mongo_model.TicketPurchase) {
 		}
 
 		ticketQrByEmail[ticketPurchase.Member.Email][filename] = qrCodePng
+
+		// list each ticket day once with its venue
+		if _, ok := ticketSetByEmail[ticketPurchase.Member.Email]; !ok {
+			ticketSetByEmail[ticketPurchase.Member.Email] = make(map[string]struct{})
+		}
+		if _, ok := ticketSetByEmail[ticketPurchase.Member.Email][ticketPurchase.Ticket.ID]; !ok {
+			ticketSetByEmail[ticketPurchase.Member.Email][ticketPurchase.Ticket.ID] = struct{}{}
+			ticketListByEmail[ticketPurchase.Member.Email] = append(ticketListByEmail[ticketPurchase.Member.Email], fmt.Sprintf(
+				"<li><b>%s</b> - %s - %s</li>",
+				html.EscapeString(ticketPurchase.Ticket.Name),
+				helpers.FormatDateWIB(ticketPurchase.Ticket.Date, "02 January 2006"),
+				html.EscapeString(ticketPurchase.Venue.Name),
+			))
+		}
 	}
 
 	// get email template
@@ -45,6 +63,7 @@ func SendTicketPurchase(ticketPurchases []*mongo_model.TicketPurchase) {
 		// replace string template
 		dataReplace := map[string]string{
 			"ticket_purchase_url": ticketPurchaseUrl,
+			"ticket_list":         strings.Join(ticketListByEmail[email], ""),
 		}
 
 		finalBody := helpers.StringReplacer(body, dataReplace)
@@ -65,3 +84,111 @@ func SendTicketPurchase(ticketPurchases []*mongo_model.TicketPurchase) {
 		}
 	}
 }
+
+func SendTicketPurchaseReissue(ticketPurchase *mongo_model.TicketPurchase) {
+	// generate qr png
+	qrCodePng, err := helpers.GenerateQRCodePNG(ticketPurchase.Code)
+	if err != nil {
+		logrus.Error("Failed to generate QR code:", err)
+		return
+	}
+
+	// format date
+	date := helpers.FormatDateWIB(ticketPurchase.Ticket.Date, "02-01-2006")
+
+	// filename
+	filename := fmt.Sprintf("QR_%s_%s.png", helpers.SanitizeString(ticketPurchase.Ticket.Name), helpers.SanitizeString(date))
+
+	// get email template
+	subject, body := helpers.GetEmailTicketPurchaseReissueTemplate()
+
+	// replace string template
+	dataReplace := map[string]string{
+		"ticket_name":         ticketPurchase.Ticket.Name,
+		"ticket_date":         helpers.FormatDateWIB(ticketPurchase.Ticket.Date, "02 January 2006"),
+		"ticket_purchase_url": fmt.Sprintf("%s/member/ticket-purchases", helpers.GetFEUrl()),
+	}
+	finalBody := helpers.StringReplacer(body, dataReplace)
+
+	// setup mail content
+	mailer := helpers.NewSMTPMailer()
+	mailer.To([]string{ticketPurchase.Member.Email})
+	mailer.Subject(subject)
+	mailer.Body(finalBody)
+	mailer.Attachment(qrCodePng, filename, "image/png")
+
+	// send
+	if err := mailer.Send(); err != nil {
+		logrus.Errorf("Send Email to %s error %v", ticketPurchase.Member.Email, err)
+	}
+}
+
+func SendTicketDayCancelled(refund *mongo_model.Refund, reason string) {
+	if refund.Member.Email == "" {
+		return
+	}
+
+	// get email template
+	subject, body := helpers.GetEmailTicketDayCancelledTemplate()
+
+	// replace string template
+	dataReplace := map[string]string{
+		"member_name":         html.EscapeString(refund.Member.Name),
+		"ticket_name":         html.EscapeString(refund.Ticket.Name),
+		"ticket_date":         helpers.FormatDateWIB(refund.Ticket.Date, "02 January 2006"),
+		"reason":              html.EscapeString(reason),
+		"refund_amount":       helpers.FormatRupiah(refund.Amount),
+		"invoice_external_id": refund.Invoice.InvoiceExternalID,
+	}
+	finalBody := helpers.StringReplacer(body, dataReplace)
+
+	// setup mail content
+	mailer := helpers.NewSMTPMailer()
+	mailer.To([]string{refund.Member.Email})
+	mailer.Subject(subject)
+	mailer.Body(finalBody)
+
+	// send
+	if err := mailer.Send(); err != nil {
+		logrus.Errorf("Send Email to %s error %v", refund.Member.Email, err)
+	}
+}
+
+func SendTicketDayRescheduled(purchase *mongo_model.Purchase, ticket *mongo_model.Ticket) {
+	if purchase.Member.Email == "" || ticket.Reschedule == nil {
+		return
+	}
+
+	// match times
+	var matchTimes []string
+	for _, match := range ticket.Matchs {
+		matchTimes = append(matchTimes, match.Time)
+	}
+
+	// get email template
+	subject, body := helpers.GetEmailTicketDayRescheduledTemplate()
+
+	// replace string template
+	dataReplace := map[string]string{
+		"member_name":         html.EscapeString(purchase.Member.Name),
+		"ticket_name":         html.EscapeString(ticket.Name),
+		"reason":              html.EscapeString(ticket.Reschedule.Reason),
+		"previous_date":       helpers.FormatDateWIB(ticket.Reschedule.PreviousDate, "02 January 2006"),
+		"new_date":            helpers.FormatDateWIB(ticket.Date, "02 January 2006"),
+		"match_times":         strings.Join(matchTimes, ", ") + " WIB",
+		"refund_opt_in_until": helpers.FormatDateWIB(ticket.Reschedule.RefundOptInUntil, "02 January 2006 15:04") + " WIB",
+		"purchase_url":        fmt.Sprintf("%s/member/purchases/%s", helpers.GetFEUrl(), purchase.ID.Hex()),
+	}
+	finalBody := helpers.StringReplacer(body, dataReplace)
+
+	// setup mail content
+	mailer := helpers.NewSMTPMailer()
+	mailer.To([]string{purchase.Member.Email})
+	mailer.Subject(subject)
+	mailer.Body(finalBody)
+
+	// send
+	if err := mailer.Send(); err != nil {
+		logrus.Errorf("Send Email to %s error %v", purchase.Member.Email, err)
+	}
+}
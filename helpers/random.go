@@ -2,6 +2,9 @@ package helpers
 
 import (
 	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
 	"math/big"
 )
 
@@ -17,3 +20,15 @@ func GenerateSecureRandomChar(length int) (string, error) {
 	}
 	return string(result), nil
 }
+
+// HashToken hash a secret token before it is stored, so a leaked database can not be used to reset passwords
+func HashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// PKCECodeChallenge returns the S256 code challenge of a pkce code verifier
+func PKCECodeChallenge(codeVerifier string) string {
+	sum := sha256.Sum256([]byte(codeVerifier))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
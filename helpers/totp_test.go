@@ -0,0 +1,74 @@
+package helpers
+
+import (
+	"testing"
+	"time"
+)
+
+// RFC 6238 Appendix B, SHA1 with the ascii secret "12345678901234567890".
+// The codes are the last six digits of the eight digit values of the rfc
+var totpTestVectors = []struct {
+	unix int64
+	code string
+}{
+	{59, "287082"},
+	{1111111109, "081804"},
+	{1111111111, "050471"},
+	{1234567890, "005924"},
+	{2000000000, "279037"},
+	{20000000000, "353130"},
+}
+
+var totpTestSecret = totpEncoding.EncodeToString([]byte("12345678901234567890"))
+
+func TestTOTPCode(t *testing.T) {
+	for _, vector := range totpTestVectors {
+		if code := totpCode([]byte("12345678901234567890"), vector.unix/totpPeriod); code != vector.code {
+			t.Errorf("totpCode at %d = %s, want %s", vector.unix, code, vector.code)
+		}
+	}
+}
+
+func TestValidateTOTP(t *testing.T) {
+	for _, vector := range totpTestVectors {
+		now := time.Unix(vector.unix, 0)
+		step, ok := ValidateTOTP(totpTestSecret, vector.code, now)
+		if !ok {
+			t.Errorf("ValidateTOTP at %d refused %s", vector.unix, vector.code)
+			continue
+		}
+		if step != vector.unix/totpPeriod {
+			t.Errorf("ValidateTOTP at %d step = %d, want %d", vector.unix, step, vector.unix/totpPeriod)
+		}
+	}
+}
+
+func TestValidateTOTPWindow(t *testing.T) {
+	at := time.Unix(1111111109, 0)
+
+	// one step of clock drift either way is accepted
+	for _, drift := range []time.Duration{-totpPeriod * time.Second, totpPeriod * time.Second} {
+		if _, ok := ValidateTOTP(totpTestSecret, "081804", at.Add(drift)); !ok {
+			t.Errorf("ValidateTOTP refused a code drifted by %s", drift)
+		}
+	}
+
+	// two steps away is refused
+	for _, drift := range []time.Duration{-2 * totpPeriod * time.Second, 2 * totpPeriod * time.Second} {
+		if _, ok := ValidateTOTP(totpTestSecret, "081804", at.Add(drift)); ok {
+			t.Errorf("ValidateTOTP accepted a code drifted by %s", drift)
+		}
+	}
+}
+
+func TestValidateTOTPInvalid(t *testing.T) {
+	at := time.Unix(59, 0)
+	for _, code := range []string{"", "28708", "2870820", "000000"} {
+		if _, ok := ValidateTOTP(totpTestSecret, code, at); ok {
+			t.Errorf("ValidateTOTP accepted %q", code)
+		}
+	}
+	if _, ok := ValidateTOTP("not base32!", "287082", at); ok {
+		t.Error("ValidateTOTP accepted an invalid secret")
+	}
+}
@@ -1,6 +1,9 @@
 package helpers
 
-import "regexp"
+import (
+	"regexp"
+	"strings"
+)
 
 func IsValidEmail(email string) bool {
 	re := regexp.MustCompile(`^[a-zA-Z0-9._%+-]+@[a-zA-Z0-9.-]+\.[a-zA-Z]{2,}$`)
@@ -22,7 +25,22 @@ func IsStrongPassword(password string) bool {
 	return hasUpper && hasLower && hasNumber
 }
 
+// IsValidPhoneNumber accept indonesian mobile number starting with 08, 628 or +628
 func IsValidPhoneNumber(phone string) bool {
-	re := regexp.MustCompile(`^\[0-9]{9,12}$`)
-	return re.MatchString(phone)
+	re := regexp.MustCompile(`^(\+62|62|0)8[0-9]{7,11}$`)
+	return re.MatchString(NormalizePhoneNumber(phone))
+}
+
+// NormalizePhoneNumber strip separators and format the number as +62, the format expected by xendit
+func NormalizePhoneNumber(phone string) string {
+	phone = strings.NewReplacer(" ", "", "-", "", "(", "", ")", "").Replace(phone)
+	switch {
+	case strings.HasPrefix(phone, "+"):
+		return phone
+	case strings.HasPrefix(phone, "62"):
+		return "+" + phone
+	case strings.HasPrefix(phone, "0"):
+		return "+62" + strings.TrimPrefix(phone, "0")
+	}
+	return phone
 }
@@ -0,0 +1,38 @@
+package helpers
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/json"
+	"sort"
+)
+
+// ZipJSONFiles write every value as an indented json file into one zip archive
+func ZipJSONFiles(files map[string]interface{}) ([]byte, error) {
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	buf := new(bytes.Buffer)
+	writer := zip.NewWriter(buf)
+	for _, name := range names {
+		content, err := json.MarshalIndent(files[name], "", "  ")
+		if err != nil {
+			return nil, err
+		}
+		file, err := writer.Create(name)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := file.Write(content); err != nil {
+			return nil, err
+		}
+	}
+	if err := writer.Close(); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
@@ -4,17 +4,21 @@ import (
 	admin_http "app/app/delivery/http/admin"
 	member_http "app/app/delivery/http/member"
 	"app/app/delivery/http/middleware"
+	partner_http "app/app/delivery/http/partner"
 	superadmin_http "app/app/delivery/http/superadmin"
 	webhook_http "app/app/delivery/http/webhook"
 	mongo_repository "app/app/repository/mongo"
+	oidc_repository "app/app/repository/oidc"
 	s3_repository "app/app/repository/s3"
 	xendit_repository "app/app/repository/xendit"
 	admin_usecase "app/app/usecase/admin"
 	member_usecase "app/app/usecase/member"
+	partner_usecase "app/app/usecase/partner"
 	superadmin_usecase "app/app/usecase/superadmin"
 	webhook_usecase "app/app/usecase/webhook"
 	"app/docs"
 	"app/helpers"
+	"context"
 	"io"
 	"net/http"
 	"os"
@@ -36,6 +40,10 @@ func init() {
 // @securityDefinitions.apikey	BearerAuth
 // @in							header
 // @name						Authorization
+
+// @securityDefinitions.apikey	ApiKeyAuth
+// @in							header
+// @name						X-API-Key
 func main() {
 	// programmatically set swagger info
 	appName := os.Getenv("APP_NAME")
@@ -73,6 +81,14 @@ func main() {
 
 	// init mongo repository
 	mongoDbRepo := mongo_repository.NewMongoDbRepo(mongo)
+	func() {
+		ctx, cancel := context.WithTimeout(context.Background(), timeoutContext)
+		defer cancel()
+
+		if err := mongoDbRepo.EnsureIndexes(ctx); err != nil {
+			logrus.Error("EnsureIndexes:", err)
+		}
+	}()
 
 	// init s3 repository
 	s3Repo := s3_repository.NewS3Repository(timeoutContext)
@@ -80,6 +96,9 @@ func main() {
 	// init xendit repository
 	xenditRepo := xendit_repository.NewXenditRepo()
 
+	// init oidc repository
+	oidcRepo := oidc_repository.NewOIDCRepo()
+
 	// init superadmin usecase
 	superadminUsecase := superadmin_usecase.NewSuperadminAppUsecase(superadmin_usecase.RepoInjection{
 		MongoDbRepo: mongoDbRepo,
@@ -95,6 +114,7 @@ func main() {
 	memberUsecase := member_usecase.NewMemberAppUsecase(member_usecase.RepoInjection{
 		MongoDbRepo: mongoDbRepo,
 		XenditRepo:  xenditRepo,
+		OIDCRepo:    oidcRepo,
 	}, timeoutContext)
 
 	// init webhook usecase
@@ -103,8 +123,27 @@ func main() {
 		XenditRepo:  xenditRepo,
 	}, timeoutContext)
 
+	// init partner usecase
+	partnerUsecase := partner_usecase.NewPartnerAppUsecase(partner_usecase.RepoInjection{
+		MongoDbRepo: mongoDbRepo,
+	}, timeoutContext)
+
+	// backfill ticket matches, publish scheduled series and resume interrupted ticket cancellation jobs in bg
+	go func() {
+		superadminUsecase.BackfillTicketMatches(context.Background())
+		superadminUsecase.ResumeTicketCancellationJobs(context.Background())
+
+		ticker := time.NewTicker(time.Minute)
+		defer ticker.Stop()
+
+		for range ticker.C {
+			superadminUsecase.PublishScheduledSeries(context.Background())
+			superadminUsecase.ResumeTicketCancellationJobs(context.Background())
+		}
+	}()
+
 	// init middleware
-	middleware := middleware.NewAppMiddleware()
+	middleware := middleware.NewAppMiddleware(mongoDbRepo)
 
 	// gin mode realease when go env is production
 	if os.Getenv("GO_ENV") == "production" || os.Getenv("GO_ENV") == "prod" {
@@ -114,6 +153,11 @@ func main() {
 	// init gin
 	ginEngine := gin.New()
 
+	// client ip headers are only read from trusted proxies, login protection and audit logs rely on it
+	if err := ginEngine.SetTrustedProxies(helpers.GetTrustedProxies()); err != nil {
+		logrus.Fatal("SetTrustedProxies:", err)
+	}
+
 	// panic recovery
 	ginEngine.Use(middleware.Recovery())
 
@@ -124,7 +168,7 @@ func main() {
 	ginEngine.Use(cors.New(cors.Config{
 		AllowAllOrigins:  true,
 		AllowMethods:     []string{"GET", "POST", "PUT", "PATCH", "DELETE", "HEAD", "OPTIONS"},
-		AllowHeaders:     []string{"Origin", "Content-Length", "Content-Type", "Authorization", "X-Ticket-Token"},
+		AllowHeaders:     []string{"Origin", "Content-Length", "Content-Type", "Authorization", "X-Ticket-Token", "X-API-Key"},
 		AllowCredentials: true,
 		ExposeHeaders:    []string{"Content-Length"},
 		MaxAge:           12 * time.Hour,
@@ -135,6 +179,7 @@ func main() {
 	admin_http.NewAdminRouteHandler(adminUsecase, ginEngine, middleware)
 	member_http.NewMemberRouteHandler(memberUsecase, ginEngine, middleware)
 	webhook_http.NewWebhookRouteHandler(webhookUsecase, ginEngine, middleware)
+	partner_http.NewPartnerRouteHandler(partnerUsecase, ginEngine, middleware)
 
 	// default route
 	ginEngine.GET("/", func(c *gin.Context) {